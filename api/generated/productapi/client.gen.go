@@ -0,0 +1,9150 @@
+// Package productapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package productapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	ApiKeyScopes = "apiKey.Scopes"
+)
+
+// Defines values for AttributeType.
+const (
+	Enum   AttributeType = "enum"
+	Number AttributeType = "number"
+	Text   AttributeType = "text"
+)
+
+// Defines values for ImportRowAction.
+const (
+	ImportRowActionCreate ImportRowAction = "create"
+	ImportRowActionError  ImportRowAction = "error"
+	ImportRowActionSkip   ImportRowAction = "skip"
+	ImportRowActionUpdate ImportRowAction = "update"
+)
+
+// Defines values for PriceChangeStatus.
+const (
+	PriceChangeStatusApplied   PriceChangeStatus = "applied"
+	PriceChangeStatusCancelled PriceChangeStatus = "cancelled"
+	PriceChangeStatusScheduled PriceChangeStatus = "scheduled"
+)
+
+// Defines values for ProductRevisionAction.
+const (
+	ProductRevisionActionCreate           ProductRevisionAction = "create"
+	ProductRevisionActionDelete           ProductRevisionAction = "delete"
+	ProductRevisionActionImageChange      ProductRevisionAction = "image_change"
+	ProductRevisionActionPriceChange      ProductRevisionAction = "price_change"
+	ProductRevisionActionRollback         ProductRevisionAction = "rollback"
+	ProductRevisionActionUpdate           ProductRevisionAction = "update"
+	ProductRevisionActionVisibilityChange ProductRevisionAction = "visibility_change"
+)
+
+// Defines values for ProductStatus.
+const (
+	ProductStatusActive   ProductStatus = "active"
+	ProductStatusCreating ProductStatus = "creating"
+	ProductStatusDeleted  ProductStatus = "deleted"
+	ProductStatusDeleting ProductStatus = "deleting"
+	ProductStatusPending  ProductStatus = "pending"
+)
+
+// Defines values for ProductVisibility.
+const (
+	ProductVisibilityDraft     ProductVisibility = "draft"
+	ProductVisibilityHidden    ProductVisibility = "hidden"
+	ProductVisibilityPublished ProductVisibility = "published"
+	ProductVisibilityScheduled ProductVisibility = "scheduled"
+)
+
+// Defines values for RecommendationSource.
+const (
+	Manual  RecommendationSource = "manual"
+	Similar RecommendationSource = "similar"
+)
+
+// Defines values for RelationKind.
+const (
+	Accessory   RelationKind = "accessory"
+	Alternative RelationKind = "alternative"
+	Related     RelationKind = "related"
+)
+
+// Defines values for ReviewModerationInputStatus.
+const (
+	Approved ReviewModerationInputStatus = "approved"
+	Rejected ReviewModerationInputStatus = "rejected"
+)
+
+// Defines values for ReviewStatus.
+const (
+	ReviewStatusApproved ReviewStatus = "approved"
+	ReviewStatusPending  ReviewStatus = "pending"
+	ReviewStatusRejected ReviewStatus = "rejected"
+)
+
+// Defines values for StockMovementKind.
+const (
+	Adjustment  StockMovementKind = "adjustment"
+	Receipt     StockMovementKind = "receipt"
+	Release     StockMovementKind = "release"
+	Reservation StockMovementKind = "reservation"
+	Shipment    StockMovementKind = "shipment"
+)
+
+// Defines values for StockReservationStatus.
+const (
+	Active    StockReservationStatus = "active"
+	Confirmed StockReservationStatus = "confirmed"
+	Expired   StockReservationStatus = "expired"
+	Released  StockReservationStatus = "released"
+)
+
+// Defines values for TaxClass.
+const (
+	Exempt   TaxClass = "exempt"
+	Reduced  TaxClass = "reduced"
+	Standard TaxClass = "standard"
+)
+
+// Defines values for ValidationErrorDetailsIn.
+const (
+	Body   ValidationErrorDetailsIn = "body"
+	Header ValidationErrorDetailsIn = "header"
+	Path   ValidationErrorDetailsIn = "path"
+	Query  ValidationErrorDetailsIn = "query"
+)
+
+// Defines values for Order.
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// Defines values for Sort.
+const (
+	SortCreatedAt Sort = "created_at"
+	SortId        Sort = "id"
+	SortName      Sort = "name"
+	SortPrice     Sort = "price"
+)
+
+// Defines values for ListProductsParamsSort.
+const (
+	ListProductsParamsSortCreatedAt ListProductsParamsSort = "created_at"
+	ListProductsParamsSortId        ListProductsParamsSort = "id"
+	ListProductsParamsSortName      ListProductsParamsSort = "name"
+	ListProductsParamsSortPrice     ListProductsParamsSort = "price"
+)
+
+// Defines values for ListProductsParamsOrder.
+const (
+	ListProductsParamsOrderAsc  ListProductsParamsOrder = "asc"
+	ListProductsParamsOrderDesc ListProductsParamsOrder = "desc"
+)
+
+// Defines values for ExportCatalogParamsFormat.
+const (
+	Csv  ExportCatalogParamsFormat = "csv"
+	Xlsx ExportCatalogParamsFormat = "xlsx"
+)
+
+// Defines values for ImportCatalogMultipartBodyApply.
+const (
+	ImportCatalogMultipartBodyApplyN0 ImportCatalogMultipartBodyApply = "0"
+	ImportCatalogMultipartBodyApplyN1 ImportCatalogMultipartBodyApply = "1"
+)
+
+// Defines values for ImportCatalogMultipartBodyHasImages.
+const (
+	ImportCatalogMultipartBodyHasImagesN0 ImportCatalogMultipartBodyHasImages = "0"
+	ImportCatalogMultipartBodyHasImagesN1 ImportCatalogMultipartBodyHasImages = "1"
+)
+
+// Attribute defines model for Attribute.
+type Attribute struct {
+	CategoryIds *[]int32      `json:"category_ids"`
+	Code        string        `json:"code"`
+	CreatedAt   time.Time     `json:"created_at"`
+	Id          int32         `json:"id"`
+	Name        string        `json:"name"`
+	Options     *[]string     `json:"options"`
+	Position    int32         `json:"position"`
+	Type        AttributeType `json:"type"`
+	Unit        string        `json:"unit"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+// AttributeInput defines model for AttributeInput.
+type AttributeInput struct {
+	// CategoryIds Разделы, где характеристика видна в фильтрах; пусто - во всех
+	CategoryIds *[]ID  `json:"category_ids"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+
+	// Options Допустимые значения списка
+	Options  *[]string     `json:"options"`
+	Position *int32        `json:"position,omitempty"`
+	Type     AttributeType `json:"type"`
+	Unit     *string       `json:"unit,omitempty"`
+}
+
+// AttributeType defines model for AttributeType.
+type AttributeType string
+
+// AttributeValueInput defines model for AttributeValueInput.
+type AttributeValueInput struct {
+	AttributeId ID `json:"attribute_id"`
+
+	// Value Числа передаются строкой, как из формы
+	Value string `json:"value"`
+}
+
+// Category defines model for Category.
+type Category struct {
+	// Children Подразделы; только в ответе ?tree=1
+	Children       *[]Category `json:"children,omitempty"`
+	CreatedAt      time.Time   `json:"created_at"`
+	Description    string      `json:"description"`
+	Id             int32       `json:"id"`
+	Name           string      `json:"name"`
+	ParentId       *int32      `json:"parent_id"`
+	Position       int32       `json:"position"`
+	SeoDescription string      `json:"seo_description"`
+	SeoTitle       string      `json:"seo_title"`
+	Slug           string      `json:"slug"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
+
+// CategoryInput defines model for CategoryInput.
+type CategoryInput struct {
+	Description    *string `json:"description,omitempty"`
+	Name           string  `json:"name"`
+	ParentId       *ID     `json:"parent_id"`
+	Position       *int32  `json:"position,omitempty"`
+	SeoDescription *string `json:"seo_description,omitempty"`
+	SeoTitle       *string `json:"seo_title,omitempty"`
+	Slug           *string `json:"slug,omitempty"`
+}
+
+// Decimal Десятичное число строкой
+type Decimal = string
+
+// Error defines model for Error.
+type Error struct {
+	Error string `json:"error"`
+}
+
+// ID defines model for ID.
+type ID = int32
+
+// ImageInput defines model for ImageInput.
+type ImageInput struct {
+	Alt       *string `json:"alt,omitempty"`
+	Id        ID      `json:"id"`
+	IsPrimary *bool   `json:"is_primary,omitempty"`
+	Position  *int32  `json:"position,omitempty"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	Applied bool         `json:"applied"`
+	Created int          `json:"created"`
+	Failed  int          `json:"failed"`
+	Rows    *[]ImportRow `json:"rows"`
+	Skipped int          `json:"skipped"`
+	Updated int          `json:"updated"`
+}
+
+// ImportRow defines model for ImportRow.
+type ImportRow struct {
+	Action ImportRowAction `json:"action"`
+
+	// Changes Изменённые поля в виде «поле - было → стало»
+	Changes *[]string `json:"changes,omitempty"`
+	Errors  *[]string `json:"errors,omitempty"`
+
+	// Images Файлы из архива изображений, которые нужно загрузить товару
+	Images    *[]string `json:"images,omitempty"`
+	Line      int       `json:"line"`
+	Name      string    `json:"name"`
+	ProductId *int32    `json:"product_id,omitempty"`
+	Slug      *string   `json:"slug,omitempty"`
+}
+
+// ImportRowAction defines model for ImportRow.Action.
+type ImportRowAction string
+
+// Money Сумма в рублях строкой, не больше двух знаков после точки
+type Money = string
+
+// NullString sql.NullString как есть - Valid == false означает отсутствие значения
+type NullString struct {
+	String string `json:"String"`
+	Valid  bool   `json:"Valid"`
+}
+
+// PriceChange defines model for PriceChange.
+type PriceChange struct {
+	AppliedAt   *time.Time `json:"applied_at"`
+	Comment     string     `json:"comment"`
+	CreatedAt   time.Time  `json:"created_at"`
+	EffectiveAt time.Time  `json:"effective_at"`
+	Id          int32      `json:"id"`
+
+	// Price Десятичное число строкой
+	Price     Decimal           `json:"price"`
+	ProductId int32             `json:"product_id"`
+	Status    PriceChangeStatus `json:"status"`
+	UserId    *int64            `json:"user_id"`
+}
+
+// PriceChangeStatus defines model for PriceChange.Status.
+type PriceChangeStatus string
+
+// PriceChangeInput defines model for PriceChangeInput.
+type PriceChangeInput struct {
+	Comment     *string   `json:"comment,omitempty"`
+	EffectiveAt time.Time `json:"effective_at"`
+
+	// Price Сумма в рублях строкой, не больше двух знаков после точки
+	Price Money `json:"price"`
+}
+
+// PriceHistoryEntry defines model for PriceHistoryEntry.
+type PriceHistoryEntry struct {
+	EffectiveFrom time.Time `json:"effective_from"`
+	Id            int32     `json:"id"`
+
+	// Price Десятичное число строкой
+	Price     Decimal `json:"price"`
+	ProductId int32   `json:"product_id"`
+}
+
+// PriceQuote defines model for PriceQuote.
+type PriceQuote struct {
+	// MinQuantity Порог оптовой цены, по которой посчитана партия
+	MinQuantity int32 `json:"min_quantity"`
+	ProductId   int32 `json:"product_id"`
+	Quantity    int32 `json:"quantity"`
+
+	// Total Десятичное число строкой
+	Total Decimal `json:"total"`
+
+	// TotalVat НДС в сумме партии; vat_rate 0 - без НДС
+	TotalVat Decimal `json:"total_vat"`
+
+	// UnitPrice Десятичное число строкой
+	UnitPrice Decimal `json:"unit_price"`
+	VariantId *int32  `json:"variant_id,omitempty"`
+	VatRate   int32   `json:"vat_rate"`
+}
+
+// PriceTier defines model for PriceTier.
+type PriceTier struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Id          int32     `json:"id"`
+	MinQuantity int32     `json:"min_quantity"`
+
+	// Pricing Разбивка цены на НДС по режиму продавца
+	Pricing   *Pricing `json:"pricing,omitempty"`
+	ProductId int32    `json:"product_id"`
+
+	// UnitPrice Десятичное число строкой
+	UnitPrice Decimal `json:"unit_price"`
+}
+
+// PriceTierInput defines model for PriceTierInput.
+type PriceTierInput struct {
+	MinQuantity int32 `json:"min_quantity"`
+
+	// UnitPrice Сумма в рублях строкой, не больше двух знаков после точки
+	UnitPrice Money `json:"unit_price"`
+}
+
+// Pricing Разбивка цены на НДС по режиму продавца
+type Pricing struct {
+	// Gross Десятичное число строкой
+	Gross Decimal `json:"gross"`
+
+	// Net Десятичное число строкой
+	Net Decimal `json:"net"`
+
+	// Vat Десятичное число строкой
+	Vat     Decimal `json:"vat"`
+	VatRate int32   `json:"vat_rate"`
+}
+
+// Product defines model for Product.
+type Product struct {
+	Attributes *[]ProductAttributeValue `json:"attributes,omitempty"`
+
+	// CategoryIds Разделы товара; только в списках товаров
+	CategoryIds *[]int32  `json:"category_ids,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+	Id          int32     `json:"id"`
+
+	// ImageUrl sql.NullString как есть - Valid == false означает отсутствие значения
+	ImageUrl NullString      `json:"image_url"`
+	Images   *[]ProductImage `json:"images,omitempty"`
+	Name     string          `json:"name"`
+
+	// Price Десятичное число строкой
+	Price      Decimal      `json:"price"`
+	PriceTiers *[]PriceTier `json:"price_tiers,omitempty"`
+
+	// Pricing Разбивка цены на НДС по режиму продавца
+	Pricing   *Pricing   `json:"pricing,omitempty"`
+	PublishAt *time.Time `json:"publish_at"`
+
+	// Rating Средняя оценка по одобренным отзывам; нет, пока отзывов нет
+	Rating      *ProductRating    `json:"rating,omitempty"`
+	Slug        string            `json:"slug"`
+	Status      ProductStatus     `json:"status"`
+	Stock       *[]StockItem      `json:"stock,omitempty"`
+	TaxClass    TaxClass          `json:"tax_class"`
+	UnpublishAt *time.Time        `json:"unpublish_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	Variants    *[]ProductVariant `json:"variants,omitempty"`
+	Version     int32             `json:"version"`
+
+	// Visibility Состояние витрины, не связанное со статусом саги
+	Visibility ProductVisibility `json:"visibility"`
+}
+
+// ProductAttributeValue defines model for ProductAttributeValue.
+type ProductAttributeValue struct {
+	AttributeId int32  `json:"attribute_id"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+
+	// Number Значение числовой характеристики
+	Number *Decimal      `json:"number"`
+	Text   string        `json:"text"`
+	Type   AttributeType `json:"type"`
+	Unit   string        `json:"unit"`
+}
+
+// ProductImage defines model for ProductImage.
+type ProductImage struct {
+	Alt       string    `json:"alt"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        int32     `json:"id"`
+	IsPrimary bool      `json:"is_primary"`
+	Position  int32     `json:"position"`
+	ProductId int32     `json:"product_id"`
+	Url       string    `json:"url"`
+}
+
+// ProductInput Тело POST и PUT /products. Варианты и оптовые цены заменяются целиком
+type ProductInput struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+
+	// Price Сумма в рублях строкой, не больше двух знаков после точки
+	Price      Money             `json:"price"`
+	PriceTiers *[]PriceTierInput `json:"price_tiers"`
+	Slug       *string           `json:"slug,omitempty"`
+	TaxClass   *TaxClass         `json:"tax_class,omitempty"`
+	Variants   *[]VariantInput   `json:"variants"`
+	Version    *int32            `json:"version,omitempty"`
+}
+
+// ProductPage defines model for ProductPage.
+type ProductPage struct {
+	Items *[]Product `json:"items"`
+	Limit int        `json:"limit"`
+
+	// Next Ссылка на следующую страницу, если она есть
+	Next   *string `json:"next,omitempty"`
+	Offset int     `json:"offset"`
+	Prev   *string `json:"prev,omitempty"`
+	Total  int     `json:"total"`
+}
+
+// ProductPatch Тело PATCH /products/{id}; пропущенные поля не меняются
+type ProductPatch struct {
+	Description *string `json:"description,omitempty"`
+
+	// ImageUrl Пустая строка убирает изображение
+	ImageUrl *string `json:"image_url,omitempty"`
+	Name     *string `json:"name,omitempty"`
+
+	// Price Сумма в рублях строкой, не больше двух знаков после точки
+	Price *Money `json:"price,omitempty"`
+
+	// PriceTiers Полный новый набор оптовых цен; без поля ступени не меняются
+	PriceTiers *[]PriceTierInput `json:"price_tiers,omitempty"`
+
+	// RegenerateSlug Построить адрес заново из названия
+	RegenerateSlug *bool     `json:"regenerate_slug,omitempty"`
+	Slug           *string   `json:"slug,omitempty"`
+	TaxClass       *TaxClass `json:"tax_class,omitempty"`
+
+	// Variants Полный новый набор вариантов; без поля варианты не меняются
+	Variants *[]VariantInput `json:"variants,omitempty"`
+	Version  int32           `json:"version"`
+}
+
+// ProductRating Средняя оценка по одобренным отзывам; нет, пока отзывов нет
+type ProductRating struct {
+	Average float64 `json:"average"`
+	Count   int32   `json:"count"`
+}
+
+// ProductRelation defines model for ProductRelation.
+type ProductRelation struct {
+	// Kind related - похожая модель, accessory - берут вместе, alternative - замена
+	Kind      RelationKind  `json:"kind"`
+	Name      string        `json:"name"`
+	Position  int32         `json:"position"`
+	RelatedId int32         `json:"related_id"`
+	Slug      string        `json:"slug"`
+	Status    ProductStatus `json:"status"`
+
+	// Visibility Состояние витрины, не связанное со статусом саги
+	Visibility ProductVisibility `json:"visibility"`
+}
+
+// ProductReplace defines model for ProductReplace.
+type ProductReplace struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+
+	// Price Сумма в рублях строкой, не больше двух знаков после точки
+	Price      Money             `json:"price"`
+	PriceTiers *[]PriceTierInput `json:"price_tiers"`
+	Slug       *string           `json:"slug,omitempty"`
+	TaxClass   *TaxClass         `json:"tax_class,omitempty"`
+	Variants   *[]VariantInput   `json:"variants"`
+	Version    int32             `json:"version"`
+}
+
+// ProductRevision defines model for ProductRevision.
+type ProductRevision struct {
+	Action ProductRevisionAction `json:"action"`
+	After  *Product              `json:"after"`
+
+	// Before Снимок товара до изменения; старые снимки могут не содержать части полей Product
+	Before    *Product  `json:"before"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        int32     `json:"id"`
+	ProductId int32     `json:"product_id"`
+	UserId    *int64    `json:"user_id"`
+}
+
+// ProductRevisionAction defines model for ProductRevision.Action.
+type ProductRevisionAction string
+
+// ProductSearchPage defines model for ProductSearchPage.
+type ProductSearchPage struct {
+	Items  *[]ProductSearchResult `json:"items"`
+	Limit  int                    `json:"limit"`
+	Next   *string                `json:"next,omitempty"`
+	Offset int                    `json:"offset"`
+	Prev   *string                `json:"prev,omitempty"`
+	Total  int                    `json:"total"`
+}
+
+// ProductSearchResult defines model for ProductSearchResult.
+type ProductSearchResult struct {
+	Attributes *[]ProductAttributeValue `json:"attributes,omitempty"`
+
+	// CategoryIds Разделы товара; только в списках товаров
+	CategoryIds *[]int32  `json:"category_ids,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+	Id          int32     `json:"id"`
+
+	// ImageUrl sql.NullString как есть - Valid == false означает отсутствие значения
+	ImageUrl NullString      `json:"image_url"`
+	Images   *[]ProductImage `json:"images,omitempty"`
+	Name     string          `json:"name"`
+
+	// NameHighlight Название с маркерами совпадений U+E000 и U+E001
+	NameHighlight string `json:"name_highlight"`
+
+	// Price Десятичное число строкой
+	Price      Decimal      `json:"price"`
+	PriceTiers *[]PriceTier `json:"price_tiers,omitempty"`
+
+	// Pricing Разбивка цены на НДС по режиму продавца
+	Pricing   *Pricing   `json:"pricing,omitempty"`
+	PublishAt *time.Time `json:"publish_at"`
+	Rank      float64    `json:"rank"`
+
+	// Rating Средняя оценка по одобренным отзывам; нет, пока отзывов нет
+	Rating      *ProductRating    `json:"rating,omitempty"`
+	Slug        string            `json:"slug"`
+	Snippet     string            `json:"snippet"`
+	Status      ProductStatus     `json:"status"`
+	Stock       *[]StockItem      `json:"stock,omitempty"`
+	TaxClass    TaxClass          `json:"tax_class"`
+	UnpublishAt *time.Time        `json:"unpublish_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	Variants    *[]ProductVariant `json:"variants,omitempty"`
+	Version     int32             `json:"version"`
+
+	// Visibility Состояние витрины, не связанное со статусом саги
+	Visibility ProductVisibility `json:"visibility"`
+}
+
+// ProductStatus defines model for ProductStatus.
+type ProductStatus string
+
+// ProductVariant defines model for ProductVariant.
+type ProductVariant struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        int32              `json:"id"`
+	IsActive  bool               `json:"is_active"`
+	Options   *map[string]string `json:"options"`
+	Position  int32              `json:"position"`
+
+	// Price null - цена товара
+	Price *Decimal `json:"price"`
+
+	// Pricing Разбивка цены на НДС по режиму продавца
+	Pricing     *Pricing  `json:"pricing,omitempty"`
+	ProductId   int32     `json:"product_id"`
+	Sku         string    `json:"sku"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	WeightGrams *int32    `json:"weight_grams"`
+}
+
+// ProductVisibility Состояние витрины, не связанное со статусом саги
+type ProductVisibility string
+
+// Recommendation defines model for Recommendation.
+type Recommendation struct {
+	// Kind related - похожая модель, accessory - берут вместе, alternative - замена
+	Kind    RelationKind `json:"kind"`
+	Product Product      `json:"product"`
+
+	// Source manual - ручная связь, similar - подобран автоматически
+	Source RecommendationSource `json:"source"`
+}
+
+// RecommendationSource manual - ручная связь, similar - подобран автоматически
+type RecommendationSource string
+
+// RelationInput defines model for RelationInput.
+type RelationInput struct {
+	// Kind related - похожая модель, accessory - берут вместе, alternative - замена
+	Kind      RelationKind `json:"kind"`
+	RelatedId ID           `json:"related_id"`
+}
+
+// RelationKind related - похожая модель, accessory - берут вместе, alternative - замена
+type RelationKind string
+
+// Review defines model for Review.
+type Review struct {
+	AuthorName  string     `json:"author_name"`
+	Company     string     `json:"company"`
+	CreatedAt   time.Time  `json:"created_at"`
+	Id          int32      `json:"id"`
+	ModeratedAt *time.Time `json:"moderated_at"`
+	ModeratedBy *int64     `json:"moderated_by"`
+	ProductId   int32      `json:"product_id"`
+	ProductName string     `json:"product_name"`
+	Rating      int32      `json:"rating"`
+	RepliedAt   *time.Time `json:"replied_at"`
+	Reply       string     `json:"reply"`
+
+	// Status pending - ждёт модерации; покупателям показываются только approved
+	Status ReviewStatus `json:"status"`
+	Text   string       `json:"text"`
+}
+
+// ReviewInput defines model for ReviewInput.
+type ReviewInput struct {
+	// AuthorKey Непрозрачный ключ отправителя, например хеш адреса
+	AuthorKey  *string `json:"author_key,omitempty"`
+	AuthorName string  `json:"author_name"`
+	Company    *string `json:"company,omitempty"`
+	Rating     int32   `json:"rating"`
+	Text       string  `json:"text"`
+}
+
+// ReviewModerationInput defines model for ReviewModerationInput.
+type ReviewModerationInput struct {
+	// Reply Ответ магазина; пустая строка снимает ответ
+	Reply  *string                     `json:"reply,omitempty"`
+	Status ReviewModerationInputStatus `json:"status"`
+}
+
+// ReviewModerationInputStatus defines model for ReviewModerationInput.Status.
+type ReviewModerationInputStatus string
+
+// ReviewPage defines model for ReviewPage.
+type ReviewPage struct {
+	Items  *[]Review `json:"items"`
+	Limit  int       `json:"limit"`
+	Offset int       `json:"offset"`
+	Total  int       `json:"total"`
+}
+
+// ReviewStatus pending - ждёт модерации; покупателям показываются только approved
+type ReviewStatus string
+
+// StockItem defines model for StockItem.
+type StockItem struct {
+	Id                int32     `json:"id"`
+	LowStockThreshold int32     `json:"low_stock_threshold"`
+	OnHand            int32     `json:"on_hand"`
+	ProductId         int32     `json:"product_id"`
+	Reserved          int32     `json:"reserved"`
+	UpdatedAt         time.Time `json:"updated_at"`
+
+	// VariantId null - остаток товара без вариантов
+	VariantId *int32 `json:"variant_id"`
+}
+
+// StockMovement defines model for StockMovement.
+type StockMovement struct {
+	Comment       string            `json:"comment"`
+	CreatedAt     time.Time         `json:"created_at"`
+	Id            int32             `json:"id"`
+	Kind          StockMovementKind `json:"kind"`
+	OnHandAfter   int32             `json:"on_hand_after"`
+	ProductId     int32             `json:"product_id"`
+	Quantity      int32             `json:"quantity"`
+	Reference     string            `json:"reference"`
+	ReservedAfter int32             `json:"reserved_after"`
+	StockItemId   int32             `json:"stock_item_id"`
+	UserId        *int64            `json:"user_id"`
+	VariantId     *int32            `json:"variant_id"`
+}
+
+// StockMovementInput defines model for StockMovementInput.
+type StockMovementInput struct {
+	Comment *string           `json:"comment,omitempty"`
+	Kind    StockMovementKind `json:"kind"`
+
+	// Quantity Для корректировки может быть отрицательным
+	Quantity  int32   `json:"quantity"`
+	Reference *string `json:"reference,omitempty"`
+	VariantId *ID     `json:"variant_id"`
+}
+
+// StockMovementKind defines model for StockMovementKind.
+type StockMovementKind string
+
+// StockReservation defines model for StockReservation.
+type StockReservation struct {
+	ClosedAt    *time.Time             `json:"closed_at"`
+	CreatedAt   time.Time              `json:"created_at"`
+	ExpiresAt   time.Time              `json:"expires_at"`
+	Id          int32                  `json:"id"`
+	ProductId   int32                  `json:"product_id"`
+	Quantity    int32                  `json:"quantity"`
+	Reference   string                 `json:"reference"`
+	Status      StockReservationStatus `json:"status"`
+	StockItemId int32                  `json:"stock_item_id"`
+	UserId      *int64                 `json:"user_id"`
+	VariantId   *int32                 `json:"variant_id"`
+}
+
+// StockReservationInput defines model for StockReservationInput.
+type StockReservationInput struct {
+	Quantity  int32   `json:"quantity"`
+	Reference *string `json:"reference,omitempty"`
+	VariantId *ID     `json:"variant_id"`
+}
+
+// StockReservationStatus defines model for StockReservationStatus.
+type StockReservationStatus string
+
+// StockThresholdInput defines model for StockThresholdInput.
+type StockThresholdInput struct {
+	Threshold int32 `json:"threshold"`
+	VariantId *ID   `json:"variant_id"`
+}
+
+// TaxClass defines model for TaxClass.
+type TaxClass string
+
+// ValidationError defines model for ValidationError.
+type ValidationError struct {
+	Details *[]struct {
+		// Field Параметр или путь поля тела через точку, например variants.0.price
+		Field   *string                  `json:"field,omitempty"`
+		In      ValidationErrorDetailsIn `json:"in"`
+		Message string                   `json:"message"`
+	} `json:"details,omitempty"`
+	Error string `json:"error"`
+}
+
+// ValidationErrorDetailsIn defines model for ValidationError.Details.In.
+type ValidationErrorDetailsIn string
+
+// VariantInput defines model for VariantInput.
+type VariantInput struct {
+	// Id Существующий вариант; без id вариант создаётся
+	Id *int32 `json:"id,omitempty"`
+
+	// IsActive По умолчанию вариант доступен для заказа
+	IsActive    *bool              `json:"is_active"`
+	Options     *map[string]string `json:"options"`
+	Price       *Money             `json:"price"`
+	Sku         string             `json:"sku"`
+	WeightGrams *int32             `json:"weight_grams"`
+}
+
+// VersionInput defines model for VersionInput.
+type VersionInput struct {
+	Version int32 `json:"version"`
+}
+
+// VisibilityInput defines model for VisibilityInput.
+type VisibilityInput struct {
+	// PublishAt Обязательна для scheduled, у остальных состояний сбрасывается
+	PublishAt *time.Time `json:"publish_at"`
+
+	// UnpublishAt Когда скрыть товар; допустима для scheduled и published
+	UnpublishAt *time.Time `json:"unpublish_at"`
+	Version     int32      `json:"version"`
+
+	// Visibility Состояние витрины, не связанное со статусом саги
+	Visibility ProductVisibility `json:"visibility"`
+}
+
+// CategoryID defines model for CategoryID.
+type CategoryID = ID
+
+// CategoryPathID defines model for CategoryPathID.
+type CategoryPathID = ID
+
+// Limit defines model for Limit.
+type Limit = int
+
+// MaxPrice Сумма в рублях строкой, не больше двух знаков после точки
+type MaxPrice = Money
+
+// MinPrice Сумма в рублях строкой, не больше двух знаков после точки
+type MinPrice = Money
+
+// Offset defines model for Offset.
+type Offset = int
+
+// Order defines model for Order.
+type Order string
+
+// ProductID defines model for ProductID.
+type ProductID = ID
+
+// Published defines model for Published.
+type Published = bool
+
+// ReservationID defines model for ReservationID.
+type ReservationID = ID
+
+// Slug defines model for Slug.
+type Slug = string
+
+// Sort defines model for Sort.
+type Sort string
+
+// Status defines model for Status.
+type Status = []string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ValidationError
+
+// Conflict defines model for Conflict.
+type Conflict = Error
+
+// NotFound defines model for NotFound.
+type NotFound = Error
+
+// Unprocessable defines model for Unprocessable.
+type Unprocessable = Error
+
+// ListCategoriesParams defines parameters for ListCategories.
+type ListCategoriesParams struct {
+	// Tree Любое непустое значение - разделы деревом, иначе плоским списком
+	Tree *string `form:"tree,omitempty" json:"tree,omitempty"`
+}
+
+// ListProductsParams defines parameters for ListProducts.
+type ListProductsParams struct {
+	// Status Статусы товара; можно повторять параметр или перечислять через запятую
+	Status *Status `form:"status,omitempty" json:"status,omitempty"`
+
+	// Published true - только товары, которые сейчас видны покупателям
+	Published *Published `form:"published,omitempty" json:"published,omitempty"`
+	MinPrice  *MinPrice  `form:"min_price,omitempty" json:"min_price,omitempty"`
+	MaxPrice  *MaxPrice  `form:"max_price,omitempty" json:"max_price,omitempty"`
+
+	// CategoryId Раздел вместе с подразделами
+	CategoryId *CategoryID              `form:"category_id,omitempty" json:"category_id,omitempty"`
+	Sort       *ListProductsParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
+	Order      *ListProductsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	Limit      *Limit                   `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset     *Offset                  `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListProductsParamsSort defines parameters for ListProducts.
+type ListProductsParamsSort string
+
+// ListProductsParamsOrder defines parameters for ListProducts.
+type ListProductsParamsOrder string
+
+// ExportCatalogParams defines parameters for ExportCatalog.
+type ExportCatalogParams struct {
+	Format *ExportCatalogParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportCatalogParamsFormat defines parameters for ExportCatalog.
+type ExportCatalogParamsFormat string
+
+// ImportCatalogMultipartBody defines parameters for ImportCatalog.
+type ImportCatalogMultipartBody struct {
+	Apply      *ImportCatalogMultipartBodyApply     `json:"apply,omitempty"`
+	File       openapi_types.File                   `json:"file"`
+	HasImages  *ImportCatalogMultipartBodyHasImages `json:"has_images,omitempty"`
+	ImageNames *[]string                            `json:"image_names,omitempty"`
+}
+
+// ImportCatalogMultipartBodyApply defines parameters for ImportCatalog.
+type ImportCatalogMultipartBodyApply string
+
+// ImportCatalogMultipartBodyHasImages defines parameters for ImportCatalog.
+type ImportCatalogMultipartBodyHasImages string
+
+// SearchProductsParams defines parameters for SearchProducts.
+type SearchProductsParams struct {
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Status Статусы товара; можно повторять параметр или перечислять через запятую
+	Status *Status `form:"status,omitempty" json:"status,omitempty"`
+
+	// Published true - только товары, которые сейчас видны покупателям
+	Published *Published `form:"published,omitempty" json:"published,omitempty"`
+	MinPrice  *MinPrice  `form:"min_price,omitempty" json:"min_price,omitempty"`
+	MaxPrice  *MaxPrice  `form:"max_price,omitempty" json:"max_price,omitempty"`
+
+	// CategoryId Раздел вместе с подразделами
+	CategoryId *CategoryID `form:"category_id,omitempty" json:"category_id,omitempty"`
+	Limit      *Limit      `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset     *Offset     `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// SetProductAttributesJSONBody defines parameters for SetProductAttributes.
+type SetProductAttributesJSONBody struct {
+	Attributes *[]AttributeValueInput `json:"attributes"`
+
+	// Version Версия товара, по которой строилась форма; при расхождении - 409
+	Version *int32 `json:"version,omitempty"`
+}
+
+// SetProductCategoriesJSONBody defines parameters for SetProductCategories.
+type SetProductCategoriesJSONBody struct {
+	CategoryIds *[]ID `json:"category_ids"`
+
+	// Version Версия товара, по которой строилась форма; при расхождении - 409
+	Version *int32 `json:"version,omitempty"`
+}
+
+// ArrangeProductImagesJSONBody defines parameters for ArrangeProductImages.
+type ArrangeProductImagesJSONBody struct {
+	Images *[]ImageInput `json:"images"`
+}
+
+// GetPriceAtParams defines parameters for GetPriceAt.
+type GetPriceAtParams struct {
+	// At Время RFC 3339 или дата YYYY-MM-DD; по умолчанию - сейчас
+	At *string `form:"at,omitempty" json:"at,omitempty"`
+}
+
+// SetPriceTiersJSONBody defines parameters for SetPriceTiers.
+type SetPriceTiersJSONBody struct {
+	PriceTiers *[]PriceTierInput `json:"price_tiers"`
+}
+
+// QuoteProductParams defines parameters for QuoteProduct.
+type QuoteProductParams struct {
+	Quantity  int32 `form:"quantity" json:"quantity"`
+	VariantId *ID   `form:"variant_id,omitempty" json:"variant_id,omitempty"`
+}
+
+// GetProductRecommendationsParams defines parameters for GetProductRecommendations.
+type GetProductRecommendationsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// SetProductRelationsJSONBody defines parameters for SetProductRelations.
+type SetProductRelationsJSONBody struct {
+	Relations *[]RelationInput `json:"relations"`
+}
+
+// GetStockMovementsParams defines parameters for GetStockMovements.
+type GetStockMovementsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// SetProductVariantsJSONBody defines parameters for SetProductVariants.
+type SetProductVariantsJSONBody struct {
+	Variants *[]VariantInput `json:"variants"`
+}
+
+// ListReviewsParams defines parameters for ListReviews.
+type ListReviewsParams struct {
+	Status    *ReviewStatus `form:"status,omitempty" json:"status,omitempty"`
+	ProductId *ID           `form:"product_id,omitempty" json:"product_id,omitempty"`
+	Limit     *int          `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset    *Offset       `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CreateAttributeJSONRequestBody defines body for CreateAttribute for application/json ContentType.
+type CreateAttributeJSONRequestBody = AttributeInput
+
+// UpdateAttributeJSONRequestBody defines body for UpdateAttribute for application/json ContentType.
+type UpdateAttributeJSONRequestBody = AttributeInput
+
+// CreateCategoryJSONRequestBody defines body for CreateCategory for application/json ContentType.
+type CreateCategoryJSONRequestBody = CategoryInput
+
+// UpdateCategoryJSONRequestBody defines body for UpdateCategory for application/json ContentType.
+type UpdateCategoryJSONRequestBody = CategoryInput
+
+// CreateProductJSONRequestBody defines body for CreateProduct for application/json ContentType.
+type CreateProductJSONRequestBody = ProductInput
+
+// ImportCatalogMultipartRequestBody defines body for ImportCatalog for multipart/form-data ContentType.
+type ImportCatalogMultipartRequestBody ImportCatalogMultipartBody
+
+// UpdateProductJSONRequestBody defines body for UpdateProduct for application/json ContentType.
+type UpdateProductJSONRequestBody = ProductPatch
+
+// ReplaceProductJSONRequestBody defines body for ReplaceProduct for application/json ContentType.
+type ReplaceProductJSONRequestBody = ProductReplace
+
+// SetProductAttributesJSONRequestBody defines body for SetProductAttributes for application/json ContentType.
+type SetProductAttributesJSONRequestBody SetProductAttributesJSONBody
+
+// SetProductCategoriesJSONRequestBody defines body for SetProductCategories for application/json ContentType.
+type SetProductCategoriesJSONRequestBody SetProductCategoriesJSONBody
+
+// ArrangeProductImagesJSONRequestBody defines body for ArrangeProductImages for application/json ContentType.
+type ArrangeProductImagesJSONRequestBody ArrangeProductImagesJSONBody
+
+// SchedulePriceChangeJSONRequestBody defines body for SchedulePriceChange for application/json ContentType.
+type SchedulePriceChangeJSONRequestBody = PriceChangeInput
+
+// SetPriceTiersJSONRequestBody defines body for SetPriceTiers for application/json ContentType.
+type SetPriceTiersJSONRequestBody SetPriceTiersJSONBody
+
+// SetProductRelationsJSONRequestBody defines body for SetProductRelations for application/json ContentType.
+type SetProductRelationsJSONRequestBody SetProductRelationsJSONBody
+
+// CreateProductReviewJSONRequestBody defines body for CreateProductReview for application/json ContentType.
+type CreateProductReviewJSONRequestBody = ReviewInput
+
+// RestoreProductRevisionJSONRequestBody defines body for RestoreProductRevision for application/json ContentType.
+type RestoreProductRevisionJSONRequestBody = VersionInput
+
+// CreateStockMovementJSONRequestBody defines body for CreateStockMovement for application/json ContentType.
+type CreateStockMovementJSONRequestBody = StockMovementInput
+
+// CreateStockReservationJSONRequestBody defines body for CreateStockReservation for application/json ContentType.
+type CreateStockReservationJSONRequestBody = StockReservationInput
+
+// SetStockThresholdJSONRequestBody defines body for SetStockThreshold for application/json ContentType.
+type SetStockThresholdJSONRequestBody = StockThresholdInput
+
+// SetProductVariantsJSONRequestBody defines body for SetProductVariants for application/json ContentType.
+type SetProductVariantsJSONRequestBody SetProductVariantsJSONBody
+
+// SetProductVisibilityJSONRequestBody defines body for SetProductVisibility for application/json ContentType.
+type SetProductVisibilityJSONRequestBody = VisibilityInput
+
+// ModerateReviewJSONRequestBody defines body for ModerateReview for application/json ContentType.
+type ModerateReviewJSONRequestBody = ReviewModerationInput
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListAttributes request
+	ListAttributes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAttributeWithBody request with any body
+	CreateAttributeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAttribute(ctx context.Context, body CreateAttributeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAttribute request
+	DeleteAttribute(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAttribute request
+	GetAttribute(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAttributeWithBody request with any body
+	UpdateAttributeWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAttribute(ctx context.Context, id ID, body UpdateAttributeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCategories request
+	ListCategories(ctx context.Context, params *ListCategoriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCategoryWithBody request with any body
+	CreateCategoryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCategory(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCategoryBySlug request
+	GetCategoryBySlug(ctx context.Context, slug Slug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCategory request
+	DeleteCategory(ctx context.Context, id CategoryPathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCategory request
+	GetCategory(ctx context.Context, id CategoryPathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCategoryWithBody request with any body
+	UpdateCategoryWithBody(ctx context.Context, id CategoryPathID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCategory(ctx context.Context, id CategoryPathID, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProducts request
+	ListProducts(ctx context.Context, params *ListProductsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProductWithBody request with any body
+	CreateProductWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProduct(ctx context.Context, body CreateProductJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportCatalog request
+	ExportCatalog(ctx context.Context, params *ExportCatalogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportCatalogWithBody request with any body
+	ImportCatalogWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchProducts request
+	SearchProducts(ctx context.Context, params *SearchProductsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProductBySlug request
+	GetProductBySlug(ctx context.Context, slug Slug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProduct request
+	DeleteProduct(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProduct request
+	GetProduct(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProductWithBody request with any body
+	UpdateProductWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProduct(ctx context.Context, id ProductID, body UpdateProductJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplaceProductWithBody request with any body
+	ReplaceProductWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReplaceProduct(ctx context.Context, id ProductID, body ReplaceProductJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProductAttributes request
+	GetProductAttributes(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetProductAttributesWithBody request with any body
+	SetProductAttributesWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetProductAttributes(ctx context.Context, id ProductID, body SetProductAttributesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProductCategories request
+	GetProductCategories(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetProductCategoriesWithBody request with any body
+	SetProductCategoriesWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetProductCategories(ctx context.Context, id ProductID, body SetProductCategoriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProductImages request
+	GetProductImages(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArrangeProductImagesWithBody request with any body
+	ArrangeProductImagesWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ArrangeProductImages(ctx context.Context, id ProductID, body ArrangeProductImagesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPriceAt request
+	GetPriceAt(ctx context.Context, id ProductID, params *GetPriceAtParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPriceChanges request
+	GetPriceChanges(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SchedulePriceChangeWithBody request with any body
+	SchedulePriceChangeWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SchedulePriceChange(ctx context.Context, id ProductID, body SchedulePriceChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelPriceChange request
+	CancelPriceChange(ctx context.Context, id ProductID, changeID ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPriceHistory request
+	GetPriceHistory(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPriceTiers request
+	GetPriceTiers(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetPriceTiersWithBody request with any body
+	SetPriceTiersWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetPriceTiers(ctx context.Context, id ProductID, body SetPriceTiersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// QuoteProduct request
+	QuoteProduct(ctx context.Context, id ProductID, params *QuoteProductParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProductRecommendations request
+	GetProductRecommendations(ctx context.Context, id ProductID, params *GetProductRecommendationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProductRelations request
+	GetProductRelations(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetProductRelationsWithBody request with any body
+	SetProductRelationsWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetProductRelations(ctx context.Context, id ProductID, body SetProductRelationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProductReviewWithBody request with any body
+	CreateProductReviewWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProductReview(ctx context.Context, id ProductID, body CreateProductReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProductRevisions request
+	GetProductRevisions(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreProductRevisionWithBody request with any body
+	RestoreProductRevisionWithBody(ctx context.Context, id ProductID, revisionID ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RestoreProductRevision(ctx context.Context, id ProductID, revisionID ID, body RestoreProductRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStock request
+	GetStock(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockMovements request
+	GetStockMovements(ctx context.Context, id ProductID, params *GetStockMovementsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateStockMovementWithBody request with any body
+	CreateStockMovementWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateStockMovement(ctx context.Context, id ProductID, body CreateStockMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockReservations request
+	GetStockReservations(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateStockReservationWithBody request with any body
+	CreateStockReservationWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateStockReservation(ctx context.Context, id ProductID, body CreateStockReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmStockReservation request
+	ConfirmStockReservation(ctx context.Context, id ProductID, reservationID ReservationID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReleaseStockReservation request
+	ReleaseStockReservation(ctx context.Context, id ProductID, reservationID ReservationID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetStockThresholdWithBody request with any body
+	SetStockThresholdWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetStockThreshold(ctx context.Context, id ProductID, body SetStockThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProductVariants request
+	GetProductVariants(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetProductVariantsWithBody request with any body
+	SetProductVariantsWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetProductVariants(ctx context.Context, id ProductID, body SetProductVariantsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetProductVisibilityWithBody request with any body
+	SetProductVisibilityWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetProductVisibility(ctx context.Context, id ProductID, body SetProductVisibilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListReviews request
+	ListReviews(ctx context.Context, params *ListReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ModerateReviewWithBody request with any body
+	ModerateReviewWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ModerateReview(ctx context.Context, id ID, body ModerateReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListAttributes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAttributesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAttributeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAttributeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAttribute(ctx context.Context, body CreateAttributeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAttributeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAttribute(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAttributeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAttribute(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAttributeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAttributeWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAttributeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAttribute(ctx context.Context, id ID, body UpdateAttributeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAttributeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCategories(ctx context.Context, params *ListCategoriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCategoriesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCategoryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCategoryRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCategory(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCategoryRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCategoryBySlug(ctx context.Context, slug Slug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCategoryBySlugRequest(c.Server, slug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteCategory(ctx context.Context, id CategoryPathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCategoryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCategory(ctx context.Context, id CategoryPathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCategoryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCategoryWithBody(ctx context.Context, id CategoryPathID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCategoryRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCategory(ctx context.Context, id CategoryPathID, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCategoryRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListProducts(ctx context.Context, params *ListProductsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProductsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProductWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProductRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProduct(ctx context.Context, body CreateProductJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProductRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportCatalog(ctx context.Context, params *ExportCatalogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportCatalogRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportCatalogWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportCatalogRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchProducts(ctx context.Context, params *SearchProductsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchProductsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProductBySlug(ctx context.Context, slug Slug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProductBySlugRequest(c.Server, slug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteProduct(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProductRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProduct(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProductRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProductWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProductRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProduct(ctx context.Context, id ProductID, body UpdateProductJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProductRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReplaceProductWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceProductRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReplaceProduct(ctx context.Context, id ProductID, body ReplaceProductJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceProductRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProductAttributes(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProductAttributesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetProductAttributesWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProductAttributesRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetProductAttributes(ctx context.Context, id ProductID, body SetProductAttributesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProductAttributesRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProductCategories(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProductCategoriesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetProductCategoriesWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProductCategoriesRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetProductCategories(ctx context.Context, id ProductID, body SetProductCategoriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProductCategoriesRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProductImages(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProductImagesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArrangeProductImagesWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArrangeProductImagesRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArrangeProductImages(ctx context.Context, id ProductID, body ArrangeProductImagesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArrangeProductImagesRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPriceAt(ctx context.Context, id ProductID, params *GetPriceAtParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPriceAtRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPriceChanges(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPriceChangesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SchedulePriceChangeWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSchedulePriceChangeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SchedulePriceChange(ctx context.Context, id ProductID, body SchedulePriceChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSchedulePriceChangeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelPriceChange(ctx context.Context, id ProductID, changeID ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelPriceChangeRequest(c.Server, id, changeID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPriceHistory(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPriceHistoryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPriceTiers(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPriceTiersRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetPriceTiersWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetPriceTiersRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetPriceTiers(ctx context.Context, id ProductID, body SetPriceTiersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetPriceTiersRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) QuoteProduct(ctx context.Context, id ProductID, params *QuoteProductParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQuoteProductRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProductRecommendations(ctx context.Context, id ProductID, params *GetProductRecommendationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProductRecommendationsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProductRelations(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProductRelationsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetProductRelationsWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProductRelationsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetProductRelations(ctx context.Context, id ProductID, body SetProductRelationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProductRelationsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProductReviewWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProductReviewRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProductReview(ctx context.Context, id ProductID, body CreateProductReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProductReviewRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProductRevisions(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProductRevisionsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreProductRevisionWithBody(ctx context.Context, id ProductID, revisionID ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreProductRevisionRequestWithBody(c.Server, id, revisionID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreProductRevision(ctx context.Context, id ProductID, revisionID ID, body RestoreProductRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreProductRevisionRequest(c.Server, id, revisionID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStock(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockMovements(ctx context.Context, id ProductID, params *GetStockMovementsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockMovementsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStockMovementWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStockMovementRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStockMovement(ctx context.Context, id ProductID, body CreateStockMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStockMovementRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockReservations(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockReservationsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStockReservationWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStockReservationRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStockReservation(ctx context.Context, id ProductID, body CreateStockReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStockReservationRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmStockReservation(ctx context.Context, id ProductID, reservationID ReservationID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmStockReservationRequest(c.Server, id, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReleaseStockReservation(ctx context.Context, id ProductID, reservationID ReservationID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReleaseStockReservationRequest(c.Server, id, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetStockThresholdWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetStockThresholdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetStockThreshold(ctx context.Context, id ProductID, body SetStockThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetStockThresholdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProductVariants(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProductVariantsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetProductVariantsWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProductVariantsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetProductVariants(ctx context.Context, id ProductID, body SetProductVariantsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProductVariantsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetProductVisibilityWithBody(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProductVisibilityRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetProductVisibility(ctx context.Context, id ProductID, body SetProductVisibilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProductVisibilityRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListReviews(ctx context.Context, params *ListReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListReviewsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ModerateReviewWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewModerateReviewRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ModerateReview(ctx context.Context, id ID, body ModerateReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewModerateReviewRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListAttributesRequest generates requests for ListAttributes
+func NewListAttributesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/attributes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateAttributeRequest calls the generic CreateAttribute builder with application/json body
+func NewCreateAttributeRequest(server string, body CreateAttributeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAttributeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateAttributeRequestWithBody generates requests for CreateAttribute with any type of body
+func NewCreateAttributeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/attributes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAttributeRequest generates requests for DeleteAttribute
+func NewDeleteAttributeRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/attributes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAttributeRequest generates requests for GetAttribute
+func NewGetAttributeRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/attributes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateAttributeRequest calls the generic UpdateAttribute builder with application/json body
+func NewUpdateAttributeRequest(server string, id ID, body UpdateAttributeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAttributeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateAttributeRequestWithBody generates requests for UpdateAttribute with any type of body
+func NewUpdateAttributeRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/attributes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListCategoriesRequest generates requests for ListCategories
+func NewListCategoriesRequest(server string, params *ListCategoriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/categories")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Tree != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tree", runtime.ParamLocationQuery, *params.Tree); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateCategoryRequest calls the generic CreateCategory builder with application/json body
+func NewCreateCategoryRequest(server string, body CreateCategoryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCategoryRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateCategoryRequestWithBody generates requests for CreateCategory with any type of body
+func NewCreateCategoryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/categories")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCategoryBySlugRequest generates requests for GetCategoryBySlug
+func NewGetCategoryBySlugRequest(server string, slug Slug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slug", runtime.ParamLocationPath, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/categories/slug/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteCategoryRequest generates requests for DeleteCategory
+func NewDeleteCategoryRequest(server string, id CategoryPathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/categories/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCategoryRequest generates requests for GetCategory
+func NewGetCategoryRequest(server string, id CategoryPathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/categories/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCategoryRequest calls the generic UpdateCategory builder with application/json body
+func NewUpdateCategoryRequest(server string, id CategoryPathID, body UpdateCategoryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCategoryRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateCategoryRequestWithBody generates requests for UpdateCategory with any type of body
+func NewUpdateCategoryRequestWithBody(server string, id CategoryPathID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/categories/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListProductsRequest generates requests for ListProducts
+func NewListProductsRequest(server string, params *ListProductsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Published != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "published", runtime.ParamLocationQuery, *params.Published); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "min_price", runtime.ParamLocationQuery, *params.MinPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_price", runtime.ParamLocationQuery, *params.MaxPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CategoryId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_id", runtime.ParamLocationQuery, *params.CategoryId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProductRequest calls the generic CreateProduct builder with application/json body
+func NewCreateProductRequest(server string, body CreateProductJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProductRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateProductRequestWithBody generates requests for CreateProduct with any type of body
+func NewCreateProductRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExportCatalogRequest generates requests for ExportCatalog
+func NewExportCatalogRequest(server string, params *ExportCatalogParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportCatalogRequestWithBody generates requests for ImportCatalog with any type of body
+func NewImportCatalogRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchProductsRequest generates requests for SearchProducts
+func NewSearchProductsRequest(server string, params *SearchProductsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Published != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "published", runtime.ParamLocationQuery, *params.Published); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "min_price", runtime.ParamLocationQuery, *params.MinPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_price", runtime.ParamLocationQuery, *params.MaxPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CategoryId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_id", runtime.ParamLocationQuery, *params.CategoryId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProductBySlugRequest generates requests for GetProductBySlug
+func NewGetProductBySlugRequest(server string, slug Slug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slug", runtime.ParamLocationPath, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/slug/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteProductRequest generates requests for DeleteProduct
+func NewDeleteProductRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProductRequest generates requests for GetProduct
+func NewGetProductRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProductRequest calls the generic UpdateProduct builder with application/json body
+func NewUpdateProductRequest(server string, id ProductID, body UpdateProductJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProductRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateProductRequestWithBody generates requests for UpdateProduct with any type of body
+func NewUpdateProductRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReplaceProductRequest calls the generic ReplaceProduct builder with application/json body
+func NewReplaceProductRequest(server string, id ProductID, body ReplaceProductJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplaceProductRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewReplaceProductRequestWithBody generates requests for ReplaceProduct with any type of body
+func NewReplaceProductRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProductAttributesRequest generates requests for GetProductAttributes
+func NewGetProductAttributesRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/attributes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetProductAttributesRequest calls the generic SetProductAttributes builder with application/json body
+func NewSetProductAttributesRequest(server string, id ProductID, body SetProductAttributesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetProductAttributesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetProductAttributesRequestWithBody generates requests for SetProductAttributes with any type of body
+func NewSetProductAttributesRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/attributes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProductCategoriesRequest generates requests for GetProductCategories
+func NewGetProductCategoriesRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/categories", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetProductCategoriesRequest calls the generic SetProductCategories builder with application/json body
+func NewSetProductCategoriesRequest(server string, id ProductID, body SetProductCategoriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetProductCategoriesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetProductCategoriesRequestWithBody generates requests for SetProductCategories with any type of body
+func NewSetProductCategoriesRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/categories", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProductImagesRequest generates requests for GetProductImages
+func NewGetProductImagesRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/images", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewArrangeProductImagesRequest calls the generic ArrangeProductImages builder with application/json body
+func NewArrangeProductImagesRequest(server string, id ProductID, body ArrangeProductImagesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewArrangeProductImagesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewArrangeProductImagesRequestWithBody generates requests for ArrangeProductImages with any type of body
+func NewArrangeProductImagesRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/images", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPriceAtRequest generates requests for GetPriceAt
+func NewGetPriceAtRequest(server string, id ProductID, params *GetPriceAtParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/price", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.At != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "at", runtime.ParamLocationQuery, *params.At); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPriceChangesRequest generates requests for GetPriceChanges
+func NewGetPriceChangesRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/price-changes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSchedulePriceChangeRequest calls the generic SchedulePriceChange builder with application/json body
+func NewSchedulePriceChangeRequest(server string, id ProductID, body SchedulePriceChangeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSchedulePriceChangeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSchedulePriceChangeRequestWithBody generates requests for SchedulePriceChange with any type of body
+func NewSchedulePriceChangeRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/price-changes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelPriceChangeRequest generates requests for CancelPriceChange
+func NewCancelPriceChangeRequest(server string, id ProductID, changeID ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "changeID", runtime.ParamLocationPath, changeID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/price-changes/%s/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPriceHistoryRequest generates requests for GetPriceHistory
+func NewGetPriceHistoryRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/price-history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPriceTiersRequest generates requests for GetPriceTiers
+func NewGetPriceTiersRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/price-tiers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetPriceTiersRequest calls the generic SetPriceTiers builder with application/json body
+func NewSetPriceTiersRequest(server string, id ProductID, body SetPriceTiersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetPriceTiersRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetPriceTiersRequestWithBody generates requests for SetPriceTiers with any type of body
+func NewSetPriceTiersRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/price-tiers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewQuoteProductRequest generates requests for QuoteProduct
+func NewQuoteProductRequest(server string, id ProductID, params *QuoteProductParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/quote", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "quantity", runtime.ParamLocationQuery, params.Quantity); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.VariantId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "variant_id", runtime.ParamLocationQuery, *params.VariantId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProductRecommendationsRequest generates requests for GetProductRecommendations
+func NewGetProductRecommendationsRequest(server string, id ProductID, params *GetProductRecommendationsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/recommendations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProductRelationsRequest generates requests for GetProductRelations
+func NewGetProductRelationsRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/relations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetProductRelationsRequest calls the generic SetProductRelations builder with application/json body
+func NewSetProductRelationsRequest(server string, id ProductID, body SetProductRelationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetProductRelationsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetProductRelationsRequestWithBody generates requests for SetProductRelations with any type of body
+func NewSetProductRelationsRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/relations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateProductReviewRequest calls the generic CreateProductReview builder with application/json body
+func NewCreateProductReviewRequest(server string, id ProductID, body CreateProductReviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProductReviewRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateProductReviewRequestWithBody generates requests for CreateProductReview with any type of body
+func NewCreateProductReviewRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/reviews", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProductRevisionsRequest generates requests for GetProductRevisions
+func NewGetProductRevisionsRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/revisions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreProductRevisionRequest calls the generic RestoreProductRevision builder with application/json body
+func NewRestoreProductRevisionRequest(server string, id ProductID, revisionID ID, body RestoreProductRevisionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRestoreProductRevisionRequestWithBody(server, id, revisionID, "application/json", bodyReader)
+}
+
+// NewRestoreProductRevisionRequestWithBody generates requests for RestoreProductRevision with any type of body
+func NewRestoreProductRevisionRequestWithBody(server string, id ProductID, revisionID ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revisionID", runtime.ParamLocationPath, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/revisions/%s/restore", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetStockRequest generates requests for GetStock
+func NewGetStockRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/stock", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStockMovementsRequest generates requests for GetStockMovements
+func NewGetStockMovementsRequest(server string, id ProductID, params *GetStockMovementsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/stock/movements", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateStockMovementRequest calls the generic CreateStockMovement builder with application/json body
+func NewCreateStockMovementRequest(server string, id ProductID, body CreateStockMovementJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateStockMovementRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateStockMovementRequestWithBody generates requests for CreateStockMovement with any type of body
+func NewCreateStockMovementRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/stock/movements", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetStockReservationsRequest generates requests for GetStockReservations
+func NewGetStockReservationsRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/stock/reservations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateStockReservationRequest calls the generic CreateStockReservation builder with application/json body
+func NewCreateStockReservationRequest(server string, id ProductID, body CreateStockReservationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateStockReservationRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateStockReservationRequestWithBody generates requests for CreateStockReservation with any type of body
+func NewCreateStockReservationRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/stock/reservations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConfirmStockReservationRequest generates requests for ConfirmStockReservation
+func NewConfirmStockReservationRequest(server string, id ProductID, reservationID ReservationID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "reservationID", runtime.ParamLocationPath, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/stock/reservations/%s/confirm", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReleaseStockReservationRequest generates requests for ReleaseStockReservation
+func NewReleaseStockReservationRequest(server string, id ProductID, reservationID ReservationID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "reservationID", runtime.ParamLocationPath, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/stock/reservations/%s/release", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetStockThresholdRequest calls the generic SetStockThreshold builder with application/json body
+func NewSetStockThresholdRequest(server string, id ProductID, body SetStockThresholdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetStockThresholdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetStockThresholdRequestWithBody generates requests for SetStockThreshold with any type of body
+func NewSetStockThresholdRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/stock/threshold", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProductVariantsRequest generates requests for GetProductVariants
+func NewGetProductVariantsRequest(server string, id ProductID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/variants", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetProductVariantsRequest calls the generic SetProductVariants builder with application/json body
+func NewSetProductVariantsRequest(server string, id ProductID, body SetProductVariantsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetProductVariantsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetProductVariantsRequestWithBody generates requests for SetProductVariants with any type of body
+func NewSetProductVariantsRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/variants", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetProductVisibilityRequest calls the generic SetProductVisibility builder with application/json body
+func NewSetProductVisibilityRequest(server string, id ProductID, body SetProductVisibilityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetProductVisibilityRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetProductVisibilityRequestWithBody generates requests for SetProductVisibility with any type of body
+func NewSetProductVisibilityRequestWithBody(server string, id ProductID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products/%s/visibility", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListReviewsRequest generates requests for ListReviews
+func NewListReviewsRequest(server string, params *ListReviewsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProductId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "product_id", runtime.ParamLocationQuery, *params.ProductId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewModerateReviewRequest calls the generic ModerateReview builder with application/json body
+func NewModerateReviewRequest(server string, id ID, body ModerateReviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewModerateReviewRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewModerateReviewRequestWithBody generates requests for ModerateReview with any type of body
+func NewModerateReviewRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reviews/%s/moderation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListAttributesWithResponse request
+	ListAttributesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAttributesResponse, error)
+
+	// CreateAttributeWithBodyWithResponse request with any body
+	CreateAttributeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAttributeResponse, error)
+
+	CreateAttributeWithResponse(ctx context.Context, body CreateAttributeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAttributeResponse, error)
+
+	// DeleteAttributeWithResponse request
+	DeleteAttributeWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteAttributeResponse, error)
+
+	// GetAttributeWithResponse request
+	GetAttributeWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetAttributeResponse, error)
+
+	// UpdateAttributeWithBodyWithResponse request with any body
+	UpdateAttributeWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAttributeResponse, error)
+
+	UpdateAttributeWithResponse(ctx context.Context, id ID, body UpdateAttributeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAttributeResponse, error)
+
+	// ListCategoriesWithResponse request
+	ListCategoriesWithResponse(ctx context.Context, params *ListCategoriesParams, reqEditors ...RequestEditorFn) (*ListCategoriesResponse, error)
+
+	// CreateCategoryWithBodyWithResponse request with any body
+	CreateCategoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+
+	CreateCategoryWithResponse(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+
+	// GetCategoryBySlugWithResponse request
+	GetCategoryBySlugWithResponse(ctx context.Context, slug Slug, reqEditors ...RequestEditorFn) (*GetCategoryBySlugResponse, error)
+
+	// DeleteCategoryWithResponse request
+	DeleteCategoryWithResponse(ctx context.Context, id CategoryPathID, reqEditors ...RequestEditorFn) (*DeleteCategoryResponse, error)
+
+	// GetCategoryWithResponse request
+	GetCategoryWithResponse(ctx context.Context, id CategoryPathID, reqEditors ...RequestEditorFn) (*GetCategoryResponse, error)
+
+	// UpdateCategoryWithBodyWithResponse request with any body
+	UpdateCategoryWithBodyWithResponse(ctx context.Context, id CategoryPathID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error)
+
+	UpdateCategoryWithResponse(ctx context.Context, id CategoryPathID, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error)
+
+	// ListProductsWithResponse request
+	ListProductsWithResponse(ctx context.Context, params *ListProductsParams, reqEditors ...RequestEditorFn) (*ListProductsResponse, error)
+
+	// CreateProductWithBodyWithResponse request with any body
+	CreateProductWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProductResponse, error)
+
+	CreateProductWithResponse(ctx context.Context, body CreateProductJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProductResponse, error)
+
+	// ExportCatalogWithResponse request
+	ExportCatalogWithResponse(ctx context.Context, params *ExportCatalogParams, reqEditors ...RequestEditorFn) (*ExportCatalogResponse, error)
+
+	// ImportCatalogWithBodyWithResponse request with any body
+	ImportCatalogWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportCatalogResponse, error)
+
+	// SearchProductsWithResponse request
+	SearchProductsWithResponse(ctx context.Context, params *SearchProductsParams, reqEditors ...RequestEditorFn) (*SearchProductsResponse, error)
+
+	// GetProductBySlugWithResponse request
+	GetProductBySlugWithResponse(ctx context.Context, slug Slug, reqEditors ...RequestEditorFn) (*GetProductBySlugResponse, error)
+
+	// DeleteProductWithResponse request
+	DeleteProductWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*DeleteProductResponse, error)
+
+	// GetProductWithResponse request
+	GetProductWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductResponse, error)
+
+	// UpdateProductWithBodyWithResponse request with any body
+	UpdateProductWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProductResponse, error)
+
+	UpdateProductWithResponse(ctx context.Context, id ProductID, body UpdateProductJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProductResponse, error)
+
+	// ReplaceProductWithBodyWithResponse request with any body
+	ReplaceProductWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceProductResponse, error)
+
+	ReplaceProductWithResponse(ctx context.Context, id ProductID, body ReplaceProductJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceProductResponse, error)
+
+	// GetProductAttributesWithResponse request
+	GetProductAttributesWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductAttributesResponse, error)
+
+	// SetProductAttributesWithBodyWithResponse request with any body
+	SetProductAttributesWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProductAttributesResponse, error)
+
+	SetProductAttributesWithResponse(ctx context.Context, id ProductID, body SetProductAttributesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProductAttributesResponse, error)
+
+	// GetProductCategoriesWithResponse request
+	GetProductCategoriesWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductCategoriesResponse, error)
+
+	// SetProductCategoriesWithBodyWithResponse request with any body
+	SetProductCategoriesWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProductCategoriesResponse, error)
+
+	SetProductCategoriesWithResponse(ctx context.Context, id ProductID, body SetProductCategoriesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProductCategoriesResponse, error)
+
+	// GetProductImagesWithResponse request
+	GetProductImagesWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductImagesResponse, error)
+
+	// ArrangeProductImagesWithBodyWithResponse request with any body
+	ArrangeProductImagesWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ArrangeProductImagesResponse, error)
+
+	ArrangeProductImagesWithResponse(ctx context.Context, id ProductID, body ArrangeProductImagesJSONRequestBody, reqEditors ...RequestEditorFn) (*ArrangeProductImagesResponse, error)
+
+	// GetPriceAtWithResponse request
+	GetPriceAtWithResponse(ctx context.Context, id ProductID, params *GetPriceAtParams, reqEditors ...RequestEditorFn) (*GetPriceAtResponse, error)
+
+	// GetPriceChangesWithResponse request
+	GetPriceChangesWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetPriceChangesResponse, error)
+
+	// SchedulePriceChangeWithBodyWithResponse request with any body
+	SchedulePriceChangeWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SchedulePriceChangeResponse, error)
+
+	SchedulePriceChangeWithResponse(ctx context.Context, id ProductID, body SchedulePriceChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*SchedulePriceChangeResponse, error)
+
+	// CancelPriceChangeWithResponse request
+	CancelPriceChangeWithResponse(ctx context.Context, id ProductID, changeID ID, reqEditors ...RequestEditorFn) (*CancelPriceChangeResponse, error)
+
+	// GetPriceHistoryWithResponse request
+	GetPriceHistoryWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetPriceHistoryResponse, error)
+
+	// GetPriceTiersWithResponse request
+	GetPriceTiersWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetPriceTiersResponse, error)
+
+	// SetPriceTiersWithBodyWithResponse request with any body
+	SetPriceTiersWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetPriceTiersResponse, error)
+
+	SetPriceTiersWithResponse(ctx context.Context, id ProductID, body SetPriceTiersJSONRequestBody, reqEditors ...RequestEditorFn) (*SetPriceTiersResponse, error)
+
+	// QuoteProductWithResponse request
+	QuoteProductWithResponse(ctx context.Context, id ProductID, params *QuoteProductParams, reqEditors ...RequestEditorFn) (*QuoteProductResponse, error)
+
+	// GetProductRecommendationsWithResponse request
+	GetProductRecommendationsWithResponse(ctx context.Context, id ProductID, params *GetProductRecommendationsParams, reqEditors ...RequestEditorFn) (*GetProductRecommendationsResponse, error)
+
+	// GetProductRelationsWithResponse request
+	GetProductRelationsWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductRelationsResponse, error)
+
+	// SetProductRelationsWithBodyWithResponse request with any body
+	SetProductRelationsWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProductRelationsResponse, error)
+
+	SetProductRelationsWithResponse(ctx context.Context, id ProductID, body SetProductRelationsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProductRelationsResponse, error)
+
+	// CreateProductReviewWithBodyWithResponse request with any body
+	CreateProductReviewWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProductReviewResponse, error)
+
+	CreateProductReviewWithResponse(ctx context.Context, id ProductID, body CreateProductReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProductReviewResponse, error)
+
+	// GetProductRevisionsWithResponse request
+	GetProductRevisionsWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductRevisionsResponse, error)
+
+	// RestoreProductRevisionWithBodyWithResponse request with any body
+	RestoreProductRevisionWithBodyWithResponse(ctx context.Context, id ProductID, revisionID ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestoreProductRevisionResponse, error)
+
+	RestoreProductRevisionWithResponse(ctx context.Context, id ProductID, revisionID ID, body RestoreProductRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*RestoreProductRevisionResponse, error)
+
+	// GetStockWithResponse request
+	GetStockWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetStockResponse, error)
+
+	// GetStockMovementsWithResponse request
+	GetStockMovementsWithResponse(ctx context.Context, id ProductID, params *GetStockMovementsParams, reqEditors ...RequestEditorFn) (*GetStockMovementsResponse, error)
+
+	// CreateStockMovementWithBodyWithResponse request with any body
+	CreateStockMovementWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStockMovementResponse, error)
+
+	CreateStockMovementWithResponse(ctx context.Context, id ProductID, body CreateStockMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStockMovementResponse, error)
+
+	// GetStockReservationsWithResponse request
+	GetStockReservationsWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetStockReservationsResponse, error)
+
+	// CreateStockReservationWithBodyWithResponse request with any body
+	CreateStockReservationWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStockReservationResponse, error)
+
+	CreateStockReservationWithResponse(ctx context.Context, id ProductID, body CreateStockReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStockReservationResponse, error)
+
+	// ConfirmStockReservationWithResponse request
+	ConfirmStockReservationWithResponse(ctx context.Context, id ProductID, reservationID ReservationID, reqEditors ...RequestEditorFn) (*ConfirmStockReservationResponse, error)
+
+	// ReleaseStockReservationWithResponse request
+	ReleaseStockReservationWithResponse(ctx context.Context, id ProductID, reservationID ReservationID, reqEditors ...RequestEditorFn) (*ReleaseStockReservationResponse, error)
+
+	// SetStockThresholdWithBodyWithResponse request with any body
+	SetStockThresholdWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetStockThresholdResponse, error)
+
+	SetStockThresholdWithResponse(ctx context.Context, id ProductID, body SetStockThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*SetStockThresholdResponse, error)
+
+	// GetProductVariantsWithResponse request
+	GetProductVariantsWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductVariantsResponse, error)
+
+	// SetProductVariantsWithBodyWithResponse request with any body
+	SetProductVariantsWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProductVariantsResponse, error)
+
+	SetProductVariantsWithResponse(ctx context.Context, id ProductID, body SetProductVariantsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProductVariantsResponse, error)
+
+	// SetProductVisibilityWithBodyWithResponse request with any body
+	SetProductVisibilityWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProductVisibilityResponse, error)
+
+	SetProductVisibilityWithResponse(ctx context.Context, id ProductID, body SetProductVisibilityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProductVisibilityResponse, error)
+
+	// ListReviewsWithResponse request
+	ListReviewsWithResponse(ctx context.Context, params *ListReviewsParams, reqEditors ...RequestEditorFn) (*ListReviewsResponse, error)
+
+	// ModerateReviewWithBodyWithResponse request with any body
+	ModerateReviewWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModerateReviewResponse, error)
+
+	ModerateReviewWithResponse(ctx context.Context, id ID, body ModerateReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*ModerateReviewResponse, error)
+}
+
+type ListAttributesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Attribute
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAttributesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAttributesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAttributeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Attribute
+	JSON400      *BadRequest
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAttributeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAttributeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAttributeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAttributeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAttributeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAttributeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Attribute
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAttributeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAttributeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAttributeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Attribute
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAttributeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAttributeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCategoriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Category
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCategoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCategoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Category
+	JSON400      *BadRequest
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCategoryBySlugResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Category
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCategoryBySlugResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCategoryBySlugResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Category
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Category
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProductsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProductPage
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProductsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProductsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateProductResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Product
+	JSON400      *BadRequest
+	JSON409      *Conflict
+	JSON422      *Unprocessable
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProductResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProductResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportCatalogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportCatalogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportCatalogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportCatalogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportReport
+	JSON400      *BadRequest
+	JSON422      *ImportReport
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportCatalogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportCatalogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchProductsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProductSearchPage
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchProductsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchProductsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProductBySlugResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Product
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProductBySlugResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProductBySlugResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProductResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Product
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON502      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProductResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProductResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProductResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Product
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProductResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProductResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateProductResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Product
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON422      *Unprocessable
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProductResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProductResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplaceProductResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Product
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON422      *Unprocessable
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplaceProductResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplaceProductResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProductAttributesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProductAttributeValue
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProductAttributesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProductAttributesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetProductAttributesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProductAttributeValue
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r SetProductAttributesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetProductAttributesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProductCategoriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Category
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProductCategoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProductCategoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetProductCategoriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Category
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON422      *Unprocessable
+}
+
+// Status returns HTTPResponse.Status
+func (r SetProductCategoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetProductCategoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProductImagesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProductImage
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProductImagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProductImagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ArrangeProductImagesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProductImage
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ArrangeProductImagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArrangeProductImagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPriceAtResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PriceHistoryEntry
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPriceAtResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPriceAtResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPriceChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PriceChange
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPriceChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPriceChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SchedulePriceChangeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PriceChange
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r SchedulePriceChangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SchedulePriceChangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelPriceChangeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PriceChange
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelPriceChangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelPriceChangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPriceHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PriceHistoryEntry
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPriceHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPriceHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPriceTiersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PriceTier
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPriceTiersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPriceTiersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetPriceTiersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PriceTier
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r SetPriceTiersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetPriceTiersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QuoteProductResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PriceQuote
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r QuoteProductResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QuoteProductResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProductRecommendationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Recommendation
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProductRecommendationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProductRecommendationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProductRelationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProductRelation
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProductRelationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProductRelationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetProductRelationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProductRelation
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON422      *Unprocessable
+}
+
+// Status returns HTTPResponse.Status
+func (r SetProductRelationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetProductRelationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateProductReviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Review
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON422      *Unprocessable
+	JSON429      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProductReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProductReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProductRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProductRevision
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProductRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProductRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreProductRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Product
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON422      *Unprocessable
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreProductRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreProductRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]StockItem
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockMovementsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]StockMovement
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockMovementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockMovementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateStockMovementResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *StockMovement
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateStockMovementResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateStockMovementResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockReservationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]StockReservation
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockReservationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockReservationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateStockReservationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *StockReservation
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateStockReservationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateStockReservationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmStockReservationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StockReservation
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmStockReservationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmStockReservationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReleaseStockReservationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StockReservation
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r ReleaseStockReservationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReleaseStockReservationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetStockThresholdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StockItem
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r SetStockThresholdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetStockThresholdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProductVariantsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProductVariant
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProductVariantsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProductVariantsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetProductVariantsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProductVariant
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r SetProductVariantsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetProductVariantsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetProductVisibilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Product
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON422      *Unprocessable
+}
+
+// Status returns HTTPResponse.Status
+func (r SetProductVisibilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetProductVisibilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListReviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReviewPage
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ListReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ModerateReviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Review
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON422      *Unprocessable
+}
+
+// Status returns HTTPResponse.Status
+func (r ModerateReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ModerateReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListAttributesWithResponse request returning *ListAttributesResponse
+func (c *ClientWithResponses) ListAttributesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAttributesResponse, error) {
+	rsp, err := c.ListAttributes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAttributesResponse(rsp)
+}
+
+// CreateAttributeWithBodyWithResponse request with arbitrary body returning *CreateAttributeResponse
+func (c *ClientWithResponses) CreateAttributeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAttributeResponse, error) {
+	rsp, err := c.CreateAttributeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAttributeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAttributeWithResponse(ctx context.Context, body CreateAttributeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAttributeResponse, error) {
+	rsp, err := c.CreateAttribute(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAttributeResponse(rsp)
+}
+
+// DeleteAttributeWithResponse request returning *DeleteAttributeResponse
+func (c *ClientWithResponses) DeleteAttributeWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteAttributeResponse, error) {
+	rsp, err := c.DeleteAttribute(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAttributeResponse(rsp)
+}
+
+// GetAttributeWithResponse request returning *GetAttributeResponse
+func (c *ClientWithResponses) GetAttributeWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetAttributeResponse, error) {
+	rsp, err := c.GetAttribute(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAttributeResponse(rsp)
+}
+
+// UpdateAttributeWithBodyWithResponse request with arbitrary body returning *UpdateAttributeResponse
+func (c *ClientWithResponses) UpdateAttributeWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAttributeResponse, error) {
+	rsp, err := c.UpdateAttributeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAttributeResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAttributeWithResponse(ctx context.Context, id ID, body UpdateAttributeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAttributeResponse, error) {
+	rsp, err := c.UpdateAttribute(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAttributeResponse(rsp)
+}
+
+// ListCategoriesWithResponse request returning *ListCategoriesResponse
+func (c *ClientWithResponses) ListCategoriesWithResponse(ctx context.Context, params *ListCategoriesParams, reqEditors ...RequestEditorFn) (*ListCategoriesResponse, error) {
+	rsp, err := c.ListCategories(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCategoriesResponse(rsp)
+}
+
+// CreateCategoryWithBodyWithResponse request with arbitrary body returning *CreateCategoryResponse
+func (c *ClientWithResponses) CreateCategoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
+	rsp, err := c.CreateCategoryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCategoryResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCategoryWithResponse(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
+	rsp, err := c.CreateCategory(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCategoryResponse(rsp)
+}
+
+// GetCategoryBySlugWithResponse request returning *GetCategoryBySlugResponse
+func (c *ClientWithResponses) GetCategoryBySlugWithResponse(ctx context.Context, slug Slug, reqEditors ...RequestEditorFn) (*GetCategoryBySlugResponse, error) {
+	rsp, err := c.GetCategoryBySlug(ctx, slug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCategoryBySlugResponse(rsp)
+}
+
+// DeleteCategoryWithResponse request returning *DeleteCategoryResponse
+func (c *ClientWithResponses) DeleteCategoryWithResponse(ctx context.Context, id CategoryPathID, reqEditors ...RequestEditorFn) (*DeleteCategoryResponse, error) {
+	rsp, err := c.DeleteCategory(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCategoryResponse(rsp)
+}
+
+// GetCategoryWithResponse request returning *GetCategoryResponse
+func (c *ClientWithResponses) GetCategoryWithResponse(ctx context.Context, id CategoryPathID, reqEditors ...RequestEditorFn) (*GetCategoryResponse, error) {
+	rsp, err := c.GetCategory(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCategoryResponse(rsp)
+}
+
+// UpdateCategoryWithBodyWithResponse request with arbitrary body returning *UpdateCategoryResponse
+func (c *ClientWithResponses) UpdateCategoryWithBodyWithResponse(ctx context.Context, id CategoryPathID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error) {
+	rsp, err := c.UpdateCategoryWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCategoryResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCategoryWithResponse(ctx context.Context, id CategoryPathID, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error) {
+	rsp, err := c.UpdateCategory(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCategoryResponse(rsp)
+}
+
+// ListProductsWithResponse request returning *ListProductsResponse
+func (c *ClientWithResponses) ListProductsWithResponse(ctx context.Context, params *ListProductsParams, reqEditors ...RequestEditorFn) (*ListProductsResponse, error) {
+	rsp, err := c.ListProducts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProductsResponse(rsp)
+}
+
+// CreateProductWithBodyWithResponse request with arbitrary body returning *CreateProductResponse
+func (c *ClientWithResponses) CreateProductWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProductResponse, error) {
+	rsp, err := c.CreateProductWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProductResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProductWithResponse(ctx context.Context, body CreateProductJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProductResponse, error) {
+	rsp, err := c.CreateProduct(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProductResponse(rsp)
+}
+
+// ExportCatalogWithResponse request returning *ExportCatalogResponse
+func (c *ClientWithResponses) ExportCatalogWithResponse(ctx context.Context, params *ExportCatalogParams, reqEditors ...RequestEditorFn) (*ExportCatalogResponse, error) {
+	rsp, err := c.ExportCatalog(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportCatalogResponse(rsp)
+}
+
+// ImportCatalogWithBodyWithResponse request with arbitrary body returning *ImportCatalogResponse
+func (c *ClientWithResponses) ImportCatalogWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportCatalogResponse, error) {
+	rsp, err := c.ImportCatalogWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportCatalogResponse(rsp)
+}
+
+// SearchProductsWithResponse request returning *SearchProductsResponse
+func (c *ClientWithResponses) SearchProductsWithResponse(ctx context.Context, params *SearchProductsParams, reqEditors ...RequestEditorFn) (*SearchProductsResponse, error) {
+	rsp, err := c.SearchProducts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchProductsResponse(rsp)
+}
+
+// GetProductBySlugWithResponse request returning *GetProductBySlugResponse
+func (c *ClientWithResponses) GetProductBySlugWithResponse(ctx context.Context, slug Slug, reqEditors ...RequestEditorFn) (*GetProductBySlugResponse, error) {
+	rsp, err := c.GetProductBySlug(ctx, slug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProductBySlugResponse(rsp)
+}
+
+// DeleteProductWithResponse request returning *DeleteProductResponse
+func (c *ClientWithResponses) DeleteProductWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*DeleteProductResponse, error) {
+	rsp, err := c.DeleteProduct(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProductResponse(rsp)
+}
+
+// GetProductWithResponse request returning *GetProductResponse
+func (c *ClientWithResponses) GetProductWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductResponse, error) {
+	rsp, err := c.GetProduct(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProductResponse(rsp)
+}
+
+// UpdateProductWithBodyWithResponse request with arbitrary body returning *UpdateProductResponse
+func (c *ClientWithResponses) UpdateProductWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProductResponse, error) {
+	rsp, err := c.UpdateProductWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProductResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProductWithResponse(ctx context.Context, id ProductID, body UpdateProductJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProductResponse, error) {
+	rsp, err := c.UpdateProduct(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProductResponse(rsp)
+}
+
+// ReplaceProductWithBodyWithResponse request with arbitrary body returning *ReplaceProductResponse
+func (c *ClientWithResponses) ReplaceProductWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceProductResponse, error) {
+	rsp, err := c.ReplaceProductWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceProductResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReplaceProductWithResponse(ctx context.Context, id ProductID, body ReplaceProductJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceProductResponse, error) {
+	rsp, err := c.ReplaceProduct(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceProductResponse(rsp)
+}
+
+// GetProductAttributesWithResponse request returning *GetProductAttributesResponse
+func (c *ClientWithResponses) GetProductAttributesWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductAttributesResponse, error) {
+	rsp, err := c.GetProductAttributes(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProductAttributesResponse(rsp)
+}
+
+// SetProductAttributesWithBodyWithResponse request with arbitrary body returning *SetProductAttributesResponse
+func (c *ClientWithResponses) SetProductAttributesWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProductAttributesResponse, error) {
+	rsp, err := c.SetProductAttributesWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProductAttributesResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetProductAttributesWithResponse(ctx context.Context, id ProductID, body SetProductAttributesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProductAttributesResponse, error) {
+	rsp, err := c.SetProductAttributes(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProductAttributesResponse(rsp)
+}
+
+// GetProductCategoriesWithResponse request returning *GetProductCategoriesResponse
+func (c *ClientWithResponses) GetProductCategoriesWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductCategoriesResponse, error) {
+	rsp, err := c.GetProductCategories(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProductCategoriesResponse(rsp)
+}
+
+// SetProductCategoriesWithBodyWithResponse request with arbitrary body returning *SetProductCategoriesResponse
+func (c *ClientWithResponses) SetProductCategoriesWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProductCategoriesResponse, error) {
+	rsp, err := c.SetProductCategoriesWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProductCategoriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetProductCategoriesWithResponse(ctx context.Context, id ProductID, body SetProductCategoriesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProductCategoriesResponse, error) {
+	rsp, err := c.SetProductCategories(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProductCategoriesResponse(rsp)
+}
+
+// GetProductImagesWithResponse request returning *GetProductImagesResponse
+func (c *ClientWithResponses) GetProductImagesWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductImagesResponse, error) {
+	rsp, err := c.GetProductImages(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProductImagesResponse(rsp)
+}
+
+// ArrangeProductImagesWithBodyWithResponse request with arbitrary body returning *ArrangeProductImagesResponse
+func (c *ClientWithResponses) ArrangeProductImagesWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ArrangeProductImagesResponse, error) {
+	rsp, err := c.ArrangeProductImagesWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArrangeProductImagesResponse(rsp)
+}
+
+func (c *ClientWithResponses) ArrangeProductImagesWithResponse(ctx context.Context, id ProductID, body ArrangeProductImagesJSONRequestBody, reqEditors ...RequestEditorFn) (*ArrangeProductImagesResponse, error) {
+	rsp, err := c.ArrangeProductImages(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArrangeProductImagesResponse(rsp)
+}
+
+// GetPriceAtWithResponse request returning *GetPriceAtResponse
+func (c *ClientWithResponses) GetPriceAtWithResponse(ctx context.Context, id ProductID, params *GetPriceAtParams, reqEditors ...RequestEditorFn) (*GetPriceAtResponse, error) {
+	rsp, err := c.GetPriceAt(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPriceAtResponse(rsp)
+}
+
+// GetPriceChangesWithResponse request returning *GetPriceChangesResponse
+func (c *ClientWithResponses) GetPriceChangesWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetPriceChangesResponse, error) {
+	rsp, err := c.GetPriceChanges(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPriceChangesResponse(rsp)
+}
+
+// SchedulePriceChangeWithBodyWithResponse request with arbitrary body returning *SchedulePriceChangeResponse
+func (c *ClientWithResponses) SchedulePriceChangeWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SchedulePriceChangeResponse, error) {
+	rsp, err := c.SchedulePriceChangeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSchedulePriceChangeResponse(rsp)
+}
+
+func (c *ClientWithResponses) SchedulePriceChangeWithResponse(ctx context.Context, id ProductID, body SchedulePriceChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*SchedulePriceChangeResponse, error) {
+	rsp, err := c.SchedulePriceChange(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSchedulePriceChangeResponse(rsp)
+}
+
+// CancelPriceChangeWithResponse request returning *CancelPriceChangeResponse
+func (c *ClientWithResponses) CancelPriceChangeWithResponse(ctx context.Context, id ProductID, changeID ID, reqEditors ...RequestEditorFn) (*CancelPriceChangeResponse, error) {
+	rsp, err := c.CancelPriceChange(ctx, id, changeID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelPriceChangeResponse(rsp)
+}
+
+// GetPriceHistoryWithResponse request returning *GetPriceHistoryResponse
+func (c *ClientWithResponses) GetPriceHistoryWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetPriceHistoryResponse, error) {
+	rsp, err := c.GetPriceHistory(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPriceHistoryResponse(rsp)
+}
+
+// GetPriceTiersWithResponse request returning *GetPriceTiersResponse
+func (c *ClientWithResponses) GetPriceTiersWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetPriceTiersResponse, error) {
+	rsp, err := c.GetPriceTiers(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPriceTiersResponse(rsp)
+}
+
+// SetPriceTiersWithBodyWithResponse request with arbitrary body returning *SetPriceTiersResponse
+func (c *ClientWithResponses) SetPriceTiersWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetPriceTiersResponse, error) {
+	rsp, err := c.SetPriceTiersWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetPriceTiersResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetPriceTiersWithResponse(ctx context.Context, id ProductID, body SetPriceTiersJSONRequestBody, reqEditors ...RequestEditorFn) (*SetPriceTiersResponse, error) {
+	rsp, err := c.SetPriceTiers(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetPriceTiersResponse(rsp)
+}
+
+// QuoteProductWithResponse request returning *QuoteProductResponse
+func (c *ClientWithResponses) QuoteProductWithResponse(ctx context.Context, id ProductID, params *QuoteProductParams, reqEditors ...RequestEditorFn) (*QuoteProductResponse, error) {
+	rsp, err := c.QuoteProduct(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuoteProductResponse(rsp)
+}
+
+// GetProductRecommendationsWithResponse request returning *GetProductRecommendationsResponse
+func (c *ClientWithResponses) GetProductRecommendationsWithResponse(ctx context.Context, id ProductID, params *GetProductRecommendationsParams, reqEditors ...RequestEditorFn) (*GetProductRecommendationsResponse, error) {
+	rsp, err := c.GetProductRecommendations(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProductRecommendationsResponse(rsp)
+}
+
+// GetProductRelationsWithResponse request returning *GetProductRelationsResponse
+func (c *ClientWithResponses) GetProductRelationsWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductRelationsResponse, error) {
+	rsp, err := c.GetProductRelations(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProductRelationsResponse(rsp)
+}
+
+// SetProductRelationsWithBodyWithResponse request with arbitrary body returning *SetProductRelationsResponse
+func (c *ClientWithResponses) SetProductRelationsWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProductRelationsResponse, error) {
+	rsp, err := c.SetProductRelationsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProductRelationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetProductRelationsWithResponse(ctx context.Context, id ProductID, body SetProductRelationsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProductRelationsResponse, error) {
+	rsp, err := c.SetProductRelations(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProductRelationsResponse(rsp)
+}
+
+// CreateProductReviewWithBodyWithResponse request with arbitrary body returning *CreateProductReviewResponse
+func (c *ClientWithResponses) CreateProductReviewWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProductReviewResponse, error) {
+	rsp, err := c.CreateProductReviewWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProductReviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProductReviewWithResponse(ctx context.Context, id ProductID, body CreateProductReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProductReviewResponse, error) {
+	rsp, err := c.CreateProductReview(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProductReviewResponse(rsp)
+}
+
+// GetProductRevisionsWithResponse request returning *GetProductRevisionsResponse
+func (c *ClientWithResponses) GetProductRevisionsWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductRevisionsResponse, error) {
+	rsp, err := c.GetProductRevisions(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProductRevisionsResponse(rsp)
+}
+
+// RestoreProductRevisionWithBodyWithResponse request with arbitrary body returning *RestoreProductRevisionResponse
+func (c *ClientWithResponses) RestoreProductRevisionWithBodyWithResponse(ctx context.Context, id ProductID, revisionID ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestoreProductRevisionResponse, error) {
+	rsp, err := c.RestoreProductRevisionWithBody(ctx, id, revisionID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreProductRevisionResponse(rsp)
+}
+
+func (c *ClientWithResponses) RestoreProductRevisionWithResponse(ctx context.Context, id ProductID, revisionID ID, body RestoreProductRevisionJSONRequestBody, reqEditors ...RequestEditorFn) (*RestoreProductRevisionResponse, error) {
+	rsp, err := c.RestoreProductRevision(ctx, id, revisionID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreProductRevisionResponse(rsp)
+}
+
+// GetStockWithResponse request returning *GetStockResponse
+func (c *ClientWithResponses) GetStockWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetStockResponse, error) {
+	rsp, err := c.GetStock(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockResponse(rsp)
+}
+
+// GetStockMovementsWithResponse request returning *GetStockMovementsResponse
+func (c *ClientWithResponses) GetStockMovementsWithResponse(ctx context.Context, id ProductID, params *GetStockMovementsParams, reqEditors ...RequestEditorFn) (*GetStockMovementsResponse, error) {
+	rsp, err := c.GetStockMovements(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockMovementsResponse(rsp)
+}
+
+// CreateStockMovementWithBodyWithResponse request with arbitrary body returning *CreateStockMovementResponse
+func (c *ClientWithResponses) CreateStockMovementWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStockMovementResponse, error) {
+	rsp, err := c.CreateStockMovementWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStockMovementResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateStockMovementWithResponse(ctx context.Context, id ProductID, body CreateStockMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStockMovementResponse, error) {
+	rsp, err := c.CreateStockMovement(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStockMovementResponse(rsp)
+}
+
+// GetStockReservationsWithResponse request returning *GetStockReservationsResponse
+func (c *ClientWithResponses) GetStockReservationsWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetStockReservationsResponse, error) {
+	rsp, err := c.GetStockReservations(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockReservationsResponse(rsp)
+}
+
+// CreateStockReservationWithBodyWithResponse request with arbitrary body returning *CreateStockReservationResponse
+func (c *ClientWithResponses) CreateStockReservationWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStockReservationResponse, error) {
+	rsp, err := c.CreateStockReservationWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStockReservationResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateStockReservationWithResponse(ctx context.Context, id ProductID, body CreateStockReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStockReservationResponse, error) {
+	rsp, err := c.CreateStockReservation(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStockReservationResponse(rsp)
+}
+
+// ConfirmStockReservationWithResponse request returning *ConfirmStockReservationResponse
+func (c *ClientWithResponses) ConfirmStockReservationWithResponse(ctx context.Context, id ProductID, reservationID ReservationID, reqEditors ...RequestEditorFn) (*ConfirmStockReservationResponse, error) {
+	rsp, err := c.ConfirmStockReservation(ctx, id, reservationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmStockReservationResponse(rsp)
+}
+
+// ReleaseStockReservationWithResponse request returning *ReleaseStockReservationResponse
+func (c *ClientWithResponses) ReleaseStockReservationWithResponse(ctx context.Context, id ProductID, reservationID ReservationID, reqEditors ...RequestEditorFn) (*ReleaseStockReservationResponse, error) {
+	rsp, err := c.ReleaseStockReservation(ctx, id, reservationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReleaseStockReservationResponse(rsp)
+}
+
+// SetStockThresholdWithBodyWithResponse request with arbitrary body returning *SetStockThresholdResponse
+func (c *ClientWithResponses) SetStockThresholdWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetStockThresholdResponse, error) {
+	rsp, err := c.SetStockThresholdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetStockThresholdResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetStockThresholdWithResponse(ctx context.Context, id ProductID, body SetStockThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*SetStockThresholdResponse, error) {
+	rsp, err := c.SetStockThreshold(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetStockThresholdResponse(rsp)
+}
+
+// GetProductVariantsWithResponse request returning *GetProductVariantsResponse
+func (c *ClientWithResponses) GetProductVariantsWithResponse(ctx context.Context, id ProductID, reqEditors ...RequestEditorFn) (*GetProductVariantsResponse, error) {
+	rsp, err := c.GetProductVariants(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProductVariantsResponse(rsp)
+}
+
+// SetProductVariantsWithBodyWithResponse request with arbitrary body returning *SetProductVariantsResponse
+func (c *ClientWithResponses) SetProductVariantsWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProductVariantsResponse, error) {
+	rsp, err := c.SetProductVariantsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProductVariantsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetProductVariantsWithResponse(ctx context.Context, id ProductID, body SetProductVariantsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProductVariantsResponse, error) {
+	rsp, err := c.SetProductVariants(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProductVariantsResponse(rsp)
+}
+
+// SetProductVisibilityWithBodyWithResponse request with arbitrary body returning *SetProductVisibilityResponse
+func (c *ClientWithResponses) SetProductVisibilityWithBodyWithResponse(ctx context.Context, id ProductID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProductVisibilityResponse, error) {
+	rsp, err := c.SetProductVisibilityWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProductVisibilityResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetProductVisibilityWithResponse(ctx context.Context, id ProductID, body SetProductVisibilityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProductVisibilityResponse, error) {
+	rsp, err := c.SetProductVisibility(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProductVisibilityResponse(rsp)
+}
+
+// ListReviewsWithResponse request returning *ListReviewsResponse
+func (c *ClientWithResponses) ListReviewsWithResponse(ctx context.Context, params *ListReviewsParams, reqEditors ...RequestEditorFn) (*ListReviewsResponse, error) {
+	rsp, err := c.ListReviews(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListReviewsResponse(rsp)
+}
+
+// ModerateReviewWithBodyWithResponse request with arbitrary body returning *ModerateReviewResponse
+func (c *ClientWithResponses) ModerateReviewWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModerateReviewResponse, error) {
+	rsp, err := c.ModerateReviewWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModerateReviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) ModerateReviewWithResponse(ctx context.Context, id ID, body ModerateReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*ModerateReviewResponse, error) {
+	rsp, err := c.ModerateReview(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModerateReviewResponse(rsp)
+}
+
+// ParseListAttributesResponse parses an HTTP response from a ListAttributesWithResponse call
+func ParseListAttributesResponse(rsp *http.Response) (*ListAttributesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAttributesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Attribute
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAttributeResponse parses an HTTP response from a CreateAttributeWithResponse call
+func ParseCreateAttributeResponse(rsp *http.Response) (*CreateAttributeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAttributeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Attribute
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAttributeResponse parses an HTTP response from a DeleteAttributeWithResponse call
+func ParseDeleteAttributeResponse(rsp *http.Response) (*DeleteAttributeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAttributeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAttributeResponse parses an HTTP response from a GetAttributeWithResponse call
+func ParseGetAttributeResponse(rsp *http.Response) (*GetAttributeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAttributeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Attribute
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAttributeResponse parses an HTTP response from a UpdateAttributeWithResponse call
+func ParseUpdateAttributeResponse(rsp *http.Response) (*UpdateAttributeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAttributeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Attribute
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCategoriesResponse parses an HTTP response from a ListCategoriesWithResponse call
+func ParseListCategoriesResponse(rsp *http.Response) (*ListCategoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCategoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCategoryResponse parses an HTTP response from a CreateCategoryWithResponse call
+func ParseCreateCategoryResponse(rsp *http.Response) (*CreateCategoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCategoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCategoryBySlugResponse parses an HTTP response from a GetCategoryBySlugWithResponse call
+func ParseGetCategoryBySlugResponse(rsp *http.Response) (*GetCategoryBySlugResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCategoryBySlugResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCategoryResponse parses an HTTP response from a DeleteCategoryWithResponse call
+func ParseDeleteCategoryResponse(rsp *http.Response) (*DeleteCategoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCategoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCategoryResponse parses an HTTP response from a GetCategoryWithResponse call
+func ParseGetCategoryResponse(rsp *http.Response) (*GetCategoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCategoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCategoryResponse parses an HTTP response from a UpdateCategoryWithResponse call
+func ParseUpdateCategoryResponse(rsp *http.Response) (*UpdateCategoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCategoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProductsResponse parses an HTTP response from a ListProductsWithResponse call
+func ParseListProductsResponse(rsp *http.Response) (*ListProductsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProductsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProductPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProductResponse parses an HTTP response from a CreateProductWithResponse call
+func ParseCreateProductResponse(rsp *http.Response) (*CreateProductResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProductResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Unprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportCatalogResponse parses an HTTP response from a ExportCatalogWithResponse call
+func ParseExportCatalogResponse(rsp *http.Response) (*ExportCatalogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportCatalogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportCatalogResponse parses an HTTP response from a ImportCatalogWithResponse call
+func ParseImportCatalogResponse(rsp *http.Response) (*ImportCatalogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportCatalogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchProductsResponse parses an HTTP response from a SearchProductsWithResponse call
+func ParseSearchProductsResponse(rsp *http.Response) (*SearchProductsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchProductsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProductSearchPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProductBySlugResponse parses an HTTP response from a GetProductBySlugWithResponse call
+func ParseGetProductBySlugResponse(rsp *http.Response) (*GetProductBySlugResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProductBySlugResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProductResponse parses an HTTP response from a DeleteProductWithResponse call
+func ParseDeleteProductResponse(rsp *http.Response) (*DeleteProductResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProductResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProductResponse parses an HTTP response from a GetProductWithResponse call
+func ParseGetProductResponse(rsp *http.Response) (*GetProductResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProductResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProductResponse parses an HTTP response from a UpdateProductWithResponse call
+func ParseUpdateProductResponse(rsp *http.Response) (*UpdateProductResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProductResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Unprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplaceProductResponse parses an HTTP response from a ReplaceProductWithResponse call
+func ParseReplaceProductResponse(rsp *http.Response) (*ReplaceProductResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplaceProductResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Unprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProductAttributesResponse parses an HTTP response from a GetProductAttributesWithResponse call
+func ParseGetProductAttributesResponse(rsp *http.Response) (*GetProductAttributesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProductAttributesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProductAttributeValue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetProductAttributesResponse parses an HTTP response from a SetProductAttributesWithResponse call
+func ParseSetProductAttributesResponse(rsp *http.Response) (*SetProductAttributesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetProductAttributesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProductAttributeValue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProductCategoriesResponse parses an HTTP response from a GetProductCategoriesWithResponse call
+func ParseGetProductCategoriesResponse(rsp *http.Response) (*GetProductCategoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProductCategoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetProductCategoriesResponse parses an HTTP response from a SetProductCategoriesWithResponse call
+func ParseSetProductCategoriesResponse(rsp *http.Response) (*SetProductCategoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetProductCategoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Unprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProductImagesResponse parses an HTTP response from a GetProductImagesWithResponse call
+func ParseGetProductImagesResponse(rsp *http.Response) (*GetProductImagesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProductImagesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProductImage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseArrangeProductImagesResponse parses an HTTP response from a ArrangeProductImagesWithResponse call
+func ParseArrangeProductImagesResponse(rsp *http.Response) (*ArrangeProductImagesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ArrangeProductImagesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProductImage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPriceAtResponse parses an HTTP response from a GetPriceAtWithResponse call
+func ParseGetPriceAtResponse(rsp *http.Response) (*GetPriceAtResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPriceAtResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PriceHistoryEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPriceChangesResponse parses an HTTP response from a GetPriceChangesWithResponse call
+func ParseGetPriceChangesResponse(rsp *http.Response) (*GetPriceChangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPriceChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PriceChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSchedulePriceChangeResponse parses an HTTP response from a SchedulePriceChangeWithResponse call
+func ParseSchedulePriceChangeResponse(rsp *http.Response) (*SchedulePriceChangeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SchedulePriceChangeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PriceChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelPriceChangeResponse parses an HTTP response from a CancelPriceChangeWithResponse call
+func ParseCancelPriceChangeResponse(rsp *http.Response) (*CancelPriceChangeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelPriceChangeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PriceChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPriceHistoryResponse parses an HTTP response from a GetPriceHistoryWithResponse call
+func ParseGetPriceHistoryResponse(rsp *http.Response) (*GetPriceHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPriceHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PriceHistoryEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPriceTiersResponse parses an HTTP response from a GetPriceTiersWithResponse call
+func ParseGetPriceTiersResponse(rsp *http.Response) (*GetPriceTiersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPriceTiersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PriceTier
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetPriceTiersResponse parses an HTTP response from a SetPriceTiersWithResponse call
+func ParseSetPriceTiersResponse(rsp *http.Response) (*SetPriceTiersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetPriceTiersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PriceTier
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQuoteProductResponse parses an HTTP response from a QuoteProductWithResponse call
+func ParseQuoteProductResponse(rsp *http.Response) (*QuoteProductResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QuoteProductResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PriceQuote
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProductRecommendationsResponse parses an HTTP response from a GetProductRecommendationsWithResponse call
+func ParseGetProductRecommendationsResponse(rsp *http.Response) (*GetProductRecommendationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProductRecommendationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Recommendation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProductRelationsResponse parses an HTTP response from a GetProductRelationsWithResponse call
+func ParseGetProductRelationsResponse(rsp *http.Response) (*GetProductRelationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProductRelationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProductRelation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetProductRelationsResponse parses an HTTP response from a SetProductRelationsWithResponse call
+func ParseSetProductRelationsResponse(rsp *http.Response) (*SetProductRelationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetProductRelationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProductRelation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Unprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProductReviewResponse parses an HTTP response from a CreateProductReviewWithResponse call
+func ParseCreateProductReviewResponse(rsp *http.Response) (*CreateProductReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProductReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Review
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Unprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProductRevisionsResponse parses an HTTP response from a GetProductRevisionsWithResponse call
+func ParseGetProductRevisionsResponse(rsp *http.Response) (*GetProductRevisionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProductRevisionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProductRevision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreProductRevisionResponse parses an HTTP response from a RestoreProductRevisionWithResponse call
+func ParseRestoreProductRevisionResponse(rsp *http.Response) (*RestoreProductRevisionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreProductRevisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Unprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockResponse parses an HTTP response from a GetStockWithResponse call
+func ParseGetStockResponse(rsp *http.Response) (*GetStockResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []StockItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockMovementsResponse parses an HTTP response from a GetStockMovementsWithResponse call
+func ParseGetStockMovementsResponse(rsp *http.Response) (*GetStockMovementsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockMovementsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []StockMovement
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateStockMovementResponse parses an HTTP response from a CreateStockMovementWithResponse call
+func ParseCreateStockMovementResponse(rsp *http.Response) (*CreateStockMovementResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateStockMovementResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest StockMovement
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockReservationsResponse parses an HTTP response from a GetStockReservationsWithResponse call
+func ParseGetStockReservationsResponse(rsp *http.Response) (*GetStockReservationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockReservationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []StockReservation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateStockReservationResponse parses an HTTP response from a CreateStockReservationWithResponse call
+func ParseCreateStockReservationResponse(rsp *http.Response) (*CreateStockReservationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateStockReservationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest StockReservation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmStockReservationResponse parses an HTTP response from a ConfirmStockReservationWithResponse call
+func ParseConfirmStockReservationResponse(rsp *http.Response) (*ConfirmStockReservationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmStockReservationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockReservation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReleaseStockReservationResponse parses an HTTP response from a ReleaseStockReservationWithResponse call
+func ParseReleaseStockReservationResponse(rsp *http.Response) (*ReleaseStockReservationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReleaseStockReservationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockReservation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetStockThresholdResponse parses an HTTP response from a SetStockThresholdWithResponse call
+func ParseSetStockThresholdResponse(rsp *http.Response) (*SetStockThresholdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetStockThresholdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProductVariantsResponse parses an HTTP response from a GetProductVariantsWithResponse call
+func ParseGetProductVariantsResponse(rsp *http.Response) (*GetProductVariantsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProductVariantsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProductVariant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetProductVariantsResponse parses an HTTP response from a SetProductVariantsWithResponse call
+func ParseSetProductVariantsResponse(rsp *http.Response) (*SetProductVariantsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetProductVariantsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProductVariant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetProductVisibilityResponse parses an HTTP response from a SetProductVisibilityWithResponse call
+func ParseSetProductVisibilityResponse(rsp *http.Response) (*SetProductVisibilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetProductVisibilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Unprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListReviewsResponse parses an HTTP response from a ListReviewsWithResponse call
+func ParseListReviewsResponse(rsp *http.Response) (*ListReviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListReviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReviewPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseModerateReviewResponse parses an HTTP response from a ModerateReviewWithResponse call
+func ParseModerateReviewResponse(rsp *http.Response) (*ModerateReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ModerateReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Review
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Unprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,25 @@
+// Package openapi хранит спецификации HTTP API сервисов; по ним проверяются запросы и генерируются клиенты
+package openapi
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+//go:embed product.yaml
+var productSpec []byte
+
+// LoadProduct разбирает и проверяет спецификацию сервиса товаров
+func LoadProduct(ctx context.Context) (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(productSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse product spec: %w", err)
+	}
+	if err := doc.Validate(ctx); err != nil {
+		return nil, fmt.Errorf("invalid product spec: %w", err)
+	}
+	return doc, nil
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 	"strconv"
@@ -18,6 +19,8 @@ import (
 	"github.com/Nzyazin/zadnik.store/internal/broker"
 )
 
+const defaultFeedInterval = time.Hour
+
 func main() {
 	gin.SetMode(gin.ReleaseMode)
 	// Загружаем .env файл
@@ -26,6 +29,32 @@ func main() {
 		log.Fatalf("Error loading .env file: %v", err)
 	}
 
+	feedInterval := defaultFeedInterval
+	if value := os.Getenv("FEED_INTERVAL"); value != "" {
+		if feedInterval, err = time.ParseDuration(value); err != nil || feedInterval <= 0 {
+			log.Fatalf("Invalid FEED_INTERVAL %q", value)
+		}
+	}
+	feedDir := os.Getenv("FEED_DIR")
+	if feedDir == "" {
+		feedDir = filepath.Join("storage", "feeds")
+	}
+
+	var robotsDisallow []string
+	if value := os.Getenv("ROBOTS_DISALLOW"); value != "" {
+		robotsDisallow = strings.Split(value, ",")
+	}
+
+	var trustedProxies []string
+	if value := os.Getenv("TRUSTED_PROXIES"); value != "" {
+		trustedProxies = strings.Split(value, ",")
+	}
+
+	reviewKeySecret := os.Getenv("REVIEW_KEY_SECRET")
+	if reviewKeySecret == "" {
+		log.Fatalf("REVIEW_KEY_SECRET is required")
+	}
+
 	portStrSMTP := os.Getenv("EMAIL_SMTP_PORT")
 	portSMTP, err := strconv.Atoi(portStrSMTP)
 	if err != nil {
@@ -36,6 +65,7 @@ func main() {
 	cfg := &gateway.ServerConfig{
 		AuthServiceAddr: os.Getenv("AUTH_SERVICE_ADDRESS"),
 		ProductServiceAddr: os.Getenv("PRODUCT_SERVICE_ADDRESS"),
+		ProductServiceGRPCAddr: os.Getenv("PRODUCT_SERVICE_GRPC_ADDRESS"),
 		ProductServiceAPIKey: os.Getenv("PRODUCT_SERVICE_API_KEY"),
 		UseHTTPS: os.Getenv("USE_HTTPS") == "true",
 		RabbitMQ: broker.RabbitMQConfig{
@@ -49,6 +79,20 @@ func main() {
 			From: os.Getenv("EMAIL_FROM"),
 			Password: os.Getenv("EMAIL_PASSWORD"),
 		},
+		SiteURL: strings.TrimRight(os.Getenv("SITE_URL"), "/"),
+		TrustedProxies: trustedProxies,
+		ReviewKeySecret: reviewKeySecret,
+		Feed: gateway.FeedConfig{
+			ShopName: os.Getenv("SHOP_NAME"),
+			Company: os.Getenv("SHOP_COMPANY"),
+			ImageBaseURL: os.Getenv("IMAGE_BASE_URL"),
+			Dir: feedDir,
+			Interval: feedInterval,
+		},
+		Robots: gateway.RobotsConfig{
+			Disallow: robotsDisallow,
+			DisallowAll: os.Getenv("ROBOTS_DISALLOW_ALL") == "true",
+		},
 		CertFile: os.Getenv("CERT_FILE"),
 		KeyFile: os.Getenv("KEY_FILE"),
 		LOG_FILE: os.Getenv("LOG_FILE"),
@@ -3,21 +3,27 @@ package main
 import (
 	"context"
 	"log"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	pb "github.com/Nzyazin/zadnik.store/api/generated/product"
 	"github.com/Nzyazin/zadnik.store/internal/broker"
 	"github.com/Nzyazin/zadnik.store/internal/common"
 	"github.com/Nzyazin/zadnik.store/internal/product/config"
 	"github.com/Nzyazin/zadnik.store/internal/product/delivery"
+	productgrpc "github.com/Nzyazin/zadnik.store/internal/product/delivery/grpc"
+	"github.com/Nzyazin/zadnik.store/internal/product/publisher"
 	"github.com/Nzyazin/zadnik.store/internal/product/repository/postgres"
+	"github.com/Nzyazin/zadnik.store/internal/product/scheduler"
 	"github.com/Nzyazin/zadnik.store/internal/product/server"
 	"github.com/Nzyazin/zadnik.store/internal/product/subscriber"
 	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
 
 	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
 )
 
 
@@ -35,8 +41,15 @@ func main() {
 
 	logger := common.NewSimpleLogger(&common.LogConfig{FilePath: cfg.LOG_FILE})
 	productRepo := postgres.NewProductRepository(db)
-	productUseCase := usecase.NewProductUseCase(productRepo)
-	productHandler := delivery.NewProductHandler(productUseCase, logger, cfg.APIKey)
+	revisionRepo := postgres.NewRevisionRepository(db)
+	variantRepo := postgres.NewVariantRepository(db)
+	imageRepo := postgres.NewImageRepository(db)
+	stockRepo := postgres.NewStockRepository(db)
+	priceTierRepo := postgres.NewPriceTierRepository(db)
+	categoryRepo := postgres.NewCategoryRepository(db)
+	attributeRepo := postgres.NewAttributeRepository(db)
+	reviewRepo := postgres.NewReviewRepository(db)
+	transactor := postgres.NewTransactor(db)
 
 	messageBroker, err := broker.NewRabbitMQBroker(broker.RabbitMQConfig{URL: cfg.RabbitMQ.URL, LogFilePath: cfg.LOG_FILE})
 
@@ -45,6 +58,23 @@ func main() {
 	}
 	defer messageBroker.Close()
 
+	// изменения товаров из HTTP, gRPC, саг и планировщиков попадают в поток WatchProducts и в брокер
+	productPublisher := publisher.NewProductPublisher(messageBroker, logger)
+	productChanges := usecase.NewProductChanges(productPublisher)
+	productUseCase := usecase.WatchProductUseCase(usecase.NewProductUseCase(productRepo, revisionRepo, variantRepo, imageRepo, stockRepo, priceTierRepo, postgres.NewSlugRepository(db), attributeRepo, categoryRepo, postgres.NewVisibilityRepository(db), postgres.NewRelationRepository(db), reviewRepo, transactor, cfg.SellerTaxMode), productChanges)
+	productHandler := delivery.NewProductHandler(productUseCase, productPublisher, logger, cfg.APIKey)
+	categoryUseCase := usecase.WatchCategoryUseCase(usecase.NewCategoryUseCase(categoryRepo, productRepo, transactor), productUseCase, productChanges, publisher.NewCategoryPublisher(messageBroker, logger))
+	categoryHandler := delivery.NewCategoryHandler(categoryUseCase, logger)
+	stockUseCase := usecase.NewStockUseCase(stockRepo, productRepo, variantRepo, publisher.NewStockPublisher(messageBroker, logger))
+	stockHandler := delivery.NewStockHandler(stockUseCase, logger)
+	priceChangeUseCase := usecase.NewPriceChangeUseCase(postgres.NewPriceChangeRepository(db), productRepo, revisionRepo, usecase.WatchPriceChanges(publisher.NewPricePublisher(messageBroker, logger), productUseCase, productChanges), transactor)
+	priceChangeHandler := delivery.NewPriceChangeHandler(priceChangeUseCase, logger)
+	attributeUseCase := usecase.WatchAttributeUseCase(usecase.NewAttributeUseCase(attributeRepo, categoryRepo, productRepo, transactor), productUseCase, productChanges)
+	attributeHandler := delivery.NewAttributeHandler(attributeUseCase, logger)
+	reviewUseCase := usecase.NewReviewUseCase(reviewRepo, productRepo)
+	reviewHandler := delivery.NewReviewHandler(reviewUseCase, logger)
+	catalogHandler := delivery.NewCatalogHandler(usecase.NewCatalogUseCase(productUseCase, categoryUseCase, attributeUseCase), logger)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -53,7 +83,14 @@ func main() {
 		log.Fatalf("Failed to initialize subscribers: %v", err)
 	}
 
-	server := server.NewServer(cfg.ProductServiceAddress, productHandler, logger)
+	go scheduler.NewPriceScheduler(priceChangeUseCase, cfg.PriceSchedulerInterval, logger).Run(ctx)
+	go scheduler.NewVisibilityScheduler(productUseCase, cfg.VisibilitySchedulerInterval, logger).Run(ctx)
+	go scheduler.NewReservationScheduler(stockUseCase, cfg.ReservationSchedulerInterval, logger).Run(ctx)
+
+	server, err := server.NewServer(cfg.ProductServiceAddress, productHandler, categoryHandler, stockHandler, priceChangeHandler, attributeHandler, catalogHandler, reviewHandler, logger)
+	if err != nil {
+		log.Fatalf("Failed to initialize HTTP server: %v", err)
+	}
 
 	go func() {
 		if err := server.Run(); err != nil {
@@ -61,6 +98,20 @@ func main() {
 		}
 	}()
 
+	listener, err := net.Listen("tcp", cfg.ProductServiceGRPCAddress)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	grpcServer := grpc.NewServer(productgrpc.AuthInterceptors(cfg.APIKey, logger)...)
+	pb.RegisterProductServiceServer(grpcServer, productgrpc.NewProductHandler(productUseCase, reviewUseCase, productPublisher, productChanges, logger))
+
+	go func() {
+		logger.Infof("Starting gRPC server for product service on %s", cfg.ProductServiceGRPCAddress)
+		if err := grpcServer.Serve(listener); err != nil {
+			logger.Errorf("gRPC server error: %v", err)
+		}
+	}()
+
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt, syscall.SIGTERM)
 	<-signalChan
@@ -71,4 +122,6 @@ func main() {
 	if err := server.Shutdown(shutdownCtx); err != nil {
 		logger.Errorf("Server shutdown error: %v", err)
 	}
+	// потоки WatchProducts не завершаются сами, поэтому GracefulStop ждал бы их бесконечно
+	grpcServer.Stop()
 }
\ No newline at end of file
@@ -2,6 +2,7 @@ package broker
 
 import (
 	"context"
+	"time"
 
 	"github.com/shopspring/decimal"
 )
@@ -18,6 +19,14 @@ const (
 	EventTypeImageCreated EventType = "image.created"
 	EventTypeProductCreatingCompleted EventType = "product.creating.completed"
 	EventTypeProductDeletingCompleted EventType = "product.deleted.completed"
+	EventTypeImageRemoving EventType = "image.removing"
+	EventTypeImageRemoved EventType = "image.removed"
+	EventTypeStockLow EventType = "stock.low"
+	EventTypeProductPriceChanged EventType = "product.price.changed"
+	// EventTypeProductChanged - изменение товара уже сохранено; в отличие от product.updating приходит после записи
+	EventTypeProductChanged EventType = "product.changed"
+	// EventTypeCategoryChanged - раздел каталога создан, изменён или удалён
+	EventTypeCategoryChanged EventType = "category.changed"
 )
 
 type Event interface {
@@ -27,13 +36,49 @@ type Event interface {
 type ProductEvent struct {
 	EventType   EventType `json:"event_type"`
 	ProductID   int32    `json:"product_id"`
+	// RequestID связывает события саги с запросом, который её начал; сервис товаров копирует его в событие о завершении
+	RequestID string `json:"request_id,omitempty"`
 	ImageData   []byte    `json:"image_data"`
 	Name        string    `json:"name"`
 	Price       decimal.Decimal   `json:"price"`
 	Description string    `json:"description"`
+	// TaxClass - ставка НДС: standard, reduced или exempt; пустая строка - не менялась
+	TaxClass string `json:"tax_class,omitempty"`
+	// Slug - новый адрес товара; RegenerateSlug - сформировать адрес заново из названия.
+	// Без них адрес при обновлении не меняется
+	Slug string `json:"slug,omitempty"`
+	RegenerateSlug bool `json:"regenerate_slug,omitempty"`
 	ImageURL string `json:"image_url"`
+	// ImageURLs - все изображения галереи товара, которые нужно удалить вместе с ним
+	ImageURLs []string `json:"image_urls,omitempty"`
 	Filename string `json:"filename"`
 	Error string `json:"error,omitempty"`
+	Version int32 `json:"version,omitempty"`
+	UserID int64 `json:"user_id,omitempty"`
+	// Variants == nil - варианты не менялись, пустой список - удалить все варианты
+	Variants []ProductVariant `json:"variants"`
+	// PriceTiers == nil - оптовые цены не менялись, пустой список - удалить все ступени
+	PriceTiers []ProductPriceTier `json:"price_tiers"`
+	// Visibility - состояние витрины нового товара: draft, scheduled, published или hidden; пустая строка - published
+	Visibility string `json:"visibility,omitempty"`
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	UnpublishAt *time.Time `json:"unpublish_at,omitempty"`
+	// Change - вид изменения в product.changed: created, updated или deleted
+	Change string `json:"change,omitempty"`
+}
+
+type ProductVariant struct {
+	ID int32 `json:"id,omitempty"`
+	SKU string `json:"sku"`
+	Options map[string]string `json:"options"`
+	Price *decimal.Decimal `json:"price,omitempty"`
+	WeightGrams *int32 `json:"weight_grams,omitempty"`
+	IsActive bool `json:"is_active"`
+}
+
+type ProductPriceTier struct {
+	MinQuantity int32 `json:"min_quantity"`
+	UnitPrice decimal.Decimal `json:"unit_price"`
 }
 
 func (e *ProductEvent) Type() EventType {
@@ -44,6 +89,11 @@ type ImageEvent struct {
 	EventType EventType `json:"event_type"`
 	ProductID int32    `json:"product_id"`
 	ImageData []byte    `json:"image_data"`
+	UserID int64 `json:"user_id,omitempty"`
+	Filename string `json:"filename,omitempty"`
+	Alt string `json:"alt,omitempty"`
+	ImageID int32 `json:"image_id,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
 }
 func (e *ImageEvent) Type() EventType {
 	return e.EventType
@@ -54,12 +104,57 @@ type ProductImageEvent struct {
 	ProductID int32    `json:"product_id"`
 	ImageURL string    `json:"image_url"`
 	Error string `json:"error,omitempty"`
+	UserID int64 `json:"user_id,omitempty"`
+	ImageID int32 `json:"image_id,omitempty"`
+	Alt string `json:"alt,omitempty"`
 }
 
 func (e *ProductImageEvent) Type() EventType {
 	return e.EventType
 }
 
+// StockEvent - уведомление администратора об остатке ниже порога
+type StockEvent struct {
+	EventType EventType `json:"event_type"`
+	ProductID int32 `json:"product_id"`
+	VariantID *int32 `json:"variant_id,omitempty"`
+	Name string `json:"name"`
+	SKU string `json:"sku,omitempty"`
+	OnHand int32 `json:"on_hand"`
+	Reserved int32 `json:"reserved"`
+	Available int32 `json:"available"`
+	Threshold int32 `json:"threshold"`
+}
+
+func (e *StockEvent) Type() EventType {
+	return e.EventType
+}
+
+// PriceChangedEvent - запланированная цена вступила в силу
+type PriceChangedEvent struct {
+	EventType EventType `json:"event_type"`
+	ProductID int32 `json:"product_id"`
+	PriceChangeID int32 `json:"price_change_id"`
+	OldPrice decimal.Decimal `json:"old_price"`
+	NewPrice decimal.Decimal `json:"new_price"`
+	EffectiveAt time.Time `json:"effective_at"`
+	AppliedAt time.Time `json:"applied_at"`
+}
+
+func (e *PriceChangedEvent) Type() EventType {
+	return e.EventType
+}
+
+// CategoryEvent - изменение раздела каталога; по нему витрина пересобирает карту сайта
+type CategoryEvent struct {
+	EventType EventType `json:"event_type"`
+	CategoryID int32 `json:"category_id"`
+}
+
+func (e *CategoryEvent) Type() EventType {
+	return e.EventType
+}
+
 type MessageBroker interface {
 	PublishProduct(ctx context.Context, exchange string, event *ProductEvent) error
 	PublishImage(ctx context.Context, exchange string, event *ImageEvent) error
@@ -73,5 +168,14 @@ type MessageBroker interface {
 	SubscribeToProductDelete(ctx context.Context, exchange string, eventType EventType, handler func(*ProductEvent) error) error
 	SubscribeToProductCreated(ctx context.Context, exchange string, eventType EventType, handler func(*ProductEvent) error) error
 	SubscribeToProductCreatedCompleted(ctx context.Context, exchange string, eventType EventType, handler func(*ProductEvent) error) error
+	SubscribeToImageRemoving(ctx context.Context, exchange string, eventType EventType, handler func(*ImageEvent) error) error
+	SubscribeToImageRemoved(ctx context.Context, exchange string, eventType EventType, handler func(*ProductImageEvent) error) error
+	PublishStock(ctx context.Context, event *StockEvent) error
+	SubscribeToStockLow(ctx context.Context, handler func(*StockEvent) error) error
+	PublishPriceChanged(ctx context.Context, event *PriceChangedEvent) error
+	SubscribeToPriceChanged(ctx context.Context, handler func(*PriceChangedEvent) error) error
+	SubscribeToProductChanged(ctx context.Context, handler func(*ProductEvent) error) error
+	PublishCategory(ctx context.Context, event *CategoryEvent) error
+	SubscribeToCategoryChanged(ctx context.Context, handler func(*CategoryEvent) error) error
 	Close() error
 }
@@ -218,9 +218,10 @@ func subscribe[T any](b *RabbitMQBroker, ctx context.Context, exchange string, e
 		return fmt.Errorf("failed to bind queue: %w", err)
 	}
 	
+	// имя очереди уникально, им же помечаем потребителя, чтобы отписаться при отмене ctx
 	msgs, err := b.channel.Consume(
 		queue.Name,
-		"",
+		queue.Name,
 		true,
 		false,
 		false,
@@ -237,6 +238,10 @@ func subscribe[T any](b *RabbitMQBroker, ctx context.Context, exchange string, e
 		for {
 			select {
 			case <-ctx.Done():
+				// очередь auto-delete удаляется вместе с последним потребителем
+				if err := b.channel.Cancel(queue.Name, false); err != nil {
+					b.logger.Errorf("Failed to cancel subscription to %s: %v", eventType, err)
+				}
 				return
 			case msg, ok := <-msgs:
 				if !ok {
@@ -311,6 +316,42 @@ func (b *RabbitMQBroker) SubscribeToImageCreated(ctx context.Context, exchange s
 	return subscribe(b, ctx, exchange, eventType, handler)
 }
 
+func (b *RabbitMQBroker) SubscribeToImageRemoving(ctx context.Context, exchange string, eventType EventType, handler func(*ImageEvent) error) error {
+	return subscribe(b, ctx, exchange, eventType, handler)
+}
+
+func (b *RabbitMQBroker) SubscribeToImageRemoved(ctx context.Context, exchange string, eventType EventType, handler func(*ProductImageEvent) error) error {
+	return subscribe(b, ctx, exchange, eventType, handler)
+}
+
+func (b *RabbitMQBroker) PublishStock(ctx context.Context, event *StockEvent) error {
+	return publish(b, ctx, ProductExchange, event)
+}
+
+func (b *RabbitMQBroker) SubscribeToStockLow(ctx context.Context, handler func(*StockEvent) error) error {
+	return subscribe(b, ctx, ProductExchange, EventTypeStockLow, handler)
+}
+
+func (b *RabbitMQBroker) PublishPriceChanged(ctx context.Context, event *PriceChangedEvent) error {
+	return publish(b, ctx, ProductExchange, event)
+}
+
+func (b *RabbitMQBroker) SubscribeToPriceChanged(ctx context.Context, handler func(*PriceChangedEvent) error) error {
+	return subscribe(b, ctx, ProductExchange, EventTypeProductPriceChanged, handler)
+}
+
+func (b *RabbitMQBroker) SubscribeToProductChanged(ctx context.Context, handler func(*ProductEvent) error) error {
+	return subscribe(b, ctx, ProductExchange, EventTypeProductChanged, handler)
+}
+
+func (b *RabbitMQBroker) PublishCategory(ctx context.Context, event *CategoryEvent) error {
+	return publish(b, ctx, ProductExchange, event)
+}
+
+func (b *RabbitMQBroker) SubscribeToCategoryChanged(ctx context.Context, handler func(*CategoryEvent) error) error {
+	return subscribe(b, ctx, ProductExchange, EventTypeCategoryChanged, handler)
+}
+
 func (b *RabbitMQBroker) Close() error {
 	if b.channel != nil {
 		b.channel.Close()
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// thinSpace разделяет разряды, noBreakSpace не даёт знаку рубля перенестись на новую строку
+	thinSpace    = "\u2009"
+	noBreakSpace = "\u00a0"
+)
+
+// FormatRubles форматирует сумму по-русски: "12 345,50 ₽".
+// Копейки у целых сумм не выводятся, четырёхзначные числа по правилам набора не разбиваются на разряды
+func FormatRubles(amount decimal.Decimal) string {
+	amount = amount.Round(2)
+
+	sign := ""
+	if amount.IsNegative() {
+		sign = "−"
+		amount = amount.Neg()
+	}
+
+	whole := amount.Truncate(0)
+	kopecks := amount.Sub(whole).Shift(2).IntPart()
+
+	result := sign + groupDigits(whole.String())
+	if kopecks != 0 {
+		result += fmt.Sprintf(",%02d", kopecks)
+	}
+	return result + noBreakSpace + "₽"
+}
+
+func groupDigits(digits string) string {
+	if len(digits) <= 4 {
+		return digits
+	}
+
+	var b strings.Builder
+	head := len(digits) % 3
+	if head > 0 {
+		b.WriteString(digits[:head])
+	}
+	for i := head; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteString(thinSpace)
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
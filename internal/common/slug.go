@@ -1,32 +1,49 @@
 package common
 
 import (
-	"fmt"
 	"strings"
-	"time"
 	"unicode"
 )
 
+// gostTranslit - транслитерация кириллицы по ГОСТ 7.79-2000 (система Б) без апострофов,
+// которые недопустимы в адресе: ъ и ь опускаются, ы и э передаются как y и e
+var gostTranslit = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "j", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "x", 'ц': "cz", 'ч': "ch", 'ш': "sh", 'щ': "shh", 'ъ': "",
+	'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+}
+
+// GenerateSlug строит адрес из названия: латиница, цифры и дефисы.
+// Пустая строка - в названии нет ни букв, ни цифр
 func GenerateSlug(s string) string {
-	s = strings.ToLower(s)
+	runes := []rune(strings.ToLower(s))
 
-	s = strings.Map(func(r rune) rune {
-		if unicode.IsSpace(r) || r == '_' {
-			return '-'
-		}
-		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' {
-			return r
+	var b strings.Builder
+	for i, r := range runes {
+		switch {
+		case r == 'ц':
+			// по ГОСТ перед i, e, y, j пишется c, в остальных случаях - cz
+			if i+1 < len(runes) && strings.ContainsRune("иеыйіє", runes[i+1]) {
+				b.WriteString("c")
+			} else {
+				b.WriteString("cz")
+			}
+		case gostTranslit[r] != "" || r == 'ъ' || r == 'ь':
+			b.WriteString(gostTranslit[r])
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case unicode.IsSpace(r) || r == '-' || r == '_' || r == '/' || r == '.' || r == '+':
+			b.WriteRune('-')
 		}
-		return -1
-	}, s)
+	}
+	s = b.String()
 
 	for strings.Contains(s, "--") {
 		s = strings.ReplaceAll(s, "--", "-")
 	}
 
-	s = strings.Trim(s, "-")
-
-	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
-
-	return fmt.Sprintf("%s-%d", s, timestamp)
-}
\ No newline at end of file
+	return strings.Trim(s, "-")
+}
@@ -0,0 +1,340 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	admin_templates "github.com/Nzyazin/zadnik.store/internal/templates/admin-templates"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	AttributesPath          = "/admin/attributes"
+	AttributeCreatePath     = "/admin/attributes/create"
+	AttributeEditPathFormat = "/admin/attributes/%d/edit"
+)
+
+// attributeForm - поля формы характеристики в формате API сервиса товаров
+type attributeForm struct {
+	Code        string   `json:"code"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Unit        string   `json:"unit"`
+	Options     []string `json:"options"`
+	Position    int32    `json:"position"`
+	CategoryIDs []int32  `json:"category_ids"`
+}
+
+func (h *Handler) attributesIndex(c *gin.Context) {
+	params := admin_templates.AttributesIndexParams{
+		BaseParams: admin_templates.BaseParams{
+			Title: "Характеристики",
+		},
+		Error: c.Query("error"),
+	}
+
+	attributes, err := h.fetchAttributes(c.Request.Context())
+	if err != nil {
+		h.logger.Errorf("Failed to get attributes: %v", err)
+		params.Error = "Не удалось загрузить характеристики"
+	}
+	params.Attributes = attributes
+
+	tree, err := h.fetchCategoryTree(c.Request.Context())
+	if err != nil {
+		h.logger.Errorf("Failed to get categories: %v", err)
+	}
+	params.CategoryNames = admin_templates.CategoryNames(admin_templates.FlattenCategories(tree, nil))
+
+	if err := h.templates.RenderAttributesIndex(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render attributes template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+func (h *Handler) attributeCreatePage(c *gin.Context) {
+	h.renderAttributeForm(c, &admin_templates.Attribute{}, c.Query("error"))
+}
+
+func (h *Handler) attributeCreate(c *gin.Context) {
+	form, err := parseAttributeForm(c)
+	if err != nil {
+		c.Redirect(http.StatusFound, AttributeCreatePath+"?error="+url.QueryEscape(err.Error()))
+		return
+	}
+
+	resp, err := h.productServiceRequest(c.Request.Context(), http.MethodPost, "/attributes", form)
+	if err != nil {
+		h.logger.Errorf("Failed to create attribute: %v", err)
+		c.Redirect(http.StatusFound, AttributeCreatePath+"?error="+url.QueryEscape("Сервис товаров недоступен"))
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		h.renderAttributeForm(c, form.toAttribute(0), attributeErrorMessage(resp))
+		return
+	}
+
+	c.Redirect(http.StatusFound, AttributesPath)
+}
+
+func (h *Handler) attributeEditPage(c *gin.Context) {
+	attributeID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Redirect(http.StatusFound, AttributesPath)
+		return
+	}
+
+	resp, err := h.productAPI.GetAttributeWithResponse(c.Request.Context(), int32(attributeID))
+	if err == nil && resp.JSON200 == nil {
+		err = fmt.Errorf("product service returned status %d on attribute", resp.StatusCode())
+	}
+	if err != nil {
+		h.logger.Errorf("Failed to get attribute: %v", err)
+		c.Redirect(http.StatusFound, AttributesPath)
+		return
+	}
+
+	attribute := attributeFromAPI(*resp.JSON200)
+	h.renderAttributeForm(c, &attribute, c.Query("error"))
+}
+
+func (h *Handler) attributeUpdate(c *gin.Context) {
+	attributeID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Redirect(http.StatusFound, AttributesPath)
+		return
+	}
+
+	editPath := fmt.Sprintf(AttributeEditPathFormat, attributeID)
+	form, err := parseAttributeForm(c)
+	if err != nil {
+		c.Redirect(http.StatusFound, editPath+"?error="+url.QueryEscape(err.Error()))
+		return
+	}
+
+	resp, err := h.productServiceRequest(c.Request.Context(), http.MethodPut, fmt.Sprintf("/attributes/%d", attributeID), form)
+	if err != nil {
+		h.logger.Errorf("Failed to update attribute: %v", err)
+		c.Redirect(http.StatusFound, editPath+"?error="+url.QueryEscape("Сервис товаров недоступен"))
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		h.renderAttributeForm(c, form.toAttribute(int32(attributeID)), attributeErrorMessage(resp))
+		return
+	}
+
+	c.Redirect(http.StatusFound, AttributesPath)
+}
+
+func (h *Handler) attributeDelete(c *gin.Context) {
+	attributeID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Redirect(http.StatusFound, AttributesPath)
+		return
+	}
+
+	resp, err := h.productServiceRequest(c.Request.Context(), http.MethodDelete, fmt.Sprintf("/attributes/%d", attributeID), nil)
+	if err != nil {
+		h.logger.Errorf("Failed to delete attribute: %v", err)
+		c.Redirect(http.StatusFound, AttributesPath+"?error="+url.QueryEscape("Сервис товаров недоступен"))
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		c.Redirect(http.StatusFound, AttributesPath+"?error="+url.QueryEscape(attributeErrorMessage(resp)))
+		return
+	}
+
+	c.Redirect(http.StatusFound, AttributesPath)
+}
+
+func (h *Handler) renderAttributeForm(c *gin.Context, attribute *admin_templates.Attribute, errMessage string) {
+	params := admin_templates.AttributeFormPageParams{
+		BaseParams: admin_templates.BaseParams{
+			Title: "Новая характеристика",
+		},
+		Action:     AttributeCreatePath,
+		Attribute:  attribute,
+		ButtonText: "Создать",
+		Error:      errMessage,
+	}
+	if attribute.ID != 0 {
+		params.Title = "Редактирование характеристики - " + attribute.Name
+		params.Action = fmt.Sprintf(AttributeEditPathFormat, attribute.ID)
+		params.IsEdit = true
+		params.ButtonText = "Сохранить"
+	}
+
+	tree, err := h.fetchCategoryTree(c.Request.Context())
+	if err != nil {
+		h.logger.Errorf("Failed to get categories: %v", err)
+	}
+	selected := make(map[int32]bool, len(attribute.CategoryIDs))
+	for _, id := range attribute.CategoryIDs {
+		selected[id] = true
+	}
+	params.Categories = admin_templates.FlattenCategories(tree, selected)
+
+	if err := h.templates.RenderAttributeFormPage(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render attribute form template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+func parseAttributeForm(c *gin.Context) (*attributeForm, error) {
+	form := &attributeForm{
+		Code:        strings.TrimSpace(c.PostForm("code")),
+		Name:        strings.TrimSpace(c.PostForm("name")),
+		Type:        c.PostForm("type"),
+		Unit:        strings.TrimSpace(c.PostForm("unit")),
+		Options:     []string{},
+		CategoryIDs: []int32{},
+	}
+	if form.Code == "" || form.Name == "" {
+		return nil, fmt.Errorf("Название и код обязательны")
+	}
+
+	for _, option := range strings.Split(c.PostForm("options"), "\n") {
+		if option = strings.TrimSpace(option); option != "" {
+			form.Options = append(form.Options, option)
+		}
+	}
+
+	if value := c.PostForm("position"); value != "" {
+		position, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Некорректный порядок")
+		}
+		form.Position = int32(position)
+	}
+
+	for _, value := range c.PostFormArray("category_ids") {
+		id, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Некорректный раздел")
+		}
+		form.CategoryIDs = append(form.CategoryIDs, int32(id))
+	}
+
+	return form, nil
+}
+
+func (f *attributeForm) toAttribute(id int32) *admin_templates.Attribute {
+	return &admin_templates.Attribute{
+		ID:          id,
+		Code:        f.Code,
+		Name:        f.Name,
+		Type:        f.Type,
+		Unit:        f.Unit,
+		Options:     f.Options,
+		Position:    f.Position,
+		CategoryIDs: f.CategoryIDs,
+	}
+}
+
+// attributeErrorMessage переводит ответ сервиса товаров в сообщение для формы
+func attributeErrorMessage(resp *http.Response) string {
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return "Характеристика не найдена"
+	case http.StatusConflict:
+		return "Характеристика с таким кодом уже существует"
+	case http.StatusUnprocessableEntity:
+		return "Выбранный раздел не найден"
+	case http.StatusBadRequest:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "Проверьте характеристику: " + productServiceErrorText(body)
+	default:
+		return "Не удалось сохранить характеристику"
+	}
+}
+
+func (h *Handler) fetchAttributes(ctx context.Context) ([]admin_templates.Attribute, error) {
+	resp, err := h.productAPI.ListAttributesWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on attributes", resp.StatusCode())
+	}
+
+	attributes := make([]admin_templates.Attribute, len(*resp.JSON200))
+	for i, attribute := range *resp.JSON200 {
+		attributes[i] = attributeFromAPI(attribute)
+	}
+	return attributes, nil
+}
+
+func attributeFromAPI(attribute productapi.Attribute) admin_templates.Attribute {
+	return admin_templates.Attribute{
+		ID:          attribute.Id,
+		Code:        attribute.Code,
+		Name:        attribute.Name,
+		Type:        string(attribute.Type),
+		Unit:        attribute.Unit,
+		Options:     sliceValue(attribute.Options),
+		Position:    attribute.Position,
+		CategoryIDs: sliceValue(attribute.CategoryIds),
+	}
+}
+
+// productAttributeInputs готовит поля характеристик для формы товара
+func (h *Handler) productAttributeInputs(ctx context.Context, product *admin_templates.Product) []admin_templates.AttributeInput {
+	attributes, err := h.fetchAttributes(ctx)
+	if err != nil {
+		h.logger.Errorf("Failed to get attributes: %v", err)
+		return nil
+	}
+	return admin_templates.AttributeInputs(attributes, product.Attributes)
+}
+
+// setProductAttributes сохраняет характеристики из формы товара; с version - только по этой версии товара.
+// Ответ 400 возвращается как ошибка с причиной отказа, 409 - как errProductConflict
+func (h *Handler) setProductAttributes(c *gin.Context, productID int32, version *int32) error {
+	if c.PostForm("attributes_present") == "" {
+		return nil
+	}
+
+	ids := c.PostFormArray("attribute_id")
+	values := c.PostFormArray("attribute_value")
+	if len(ids) != len(values) {
+		return fmt.Errorf("attribute fields mismatch: %d ids, %d values", len(ids), len(values))
+	}
+
+	attributes := make([]productapi.AttributeValueInput, 0, len(ids))
+	for i, value := range ids {
+		id, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid attribute id %q: %w", value, err)
+		}
+		attributes = append(attributes, productapi.AttributeValueInput{AttributeId: int32(id), Value: values[i]})
+	}
+
+	resp, err := h.productAPI.SetProductAttributesWithResponse(c.Request.Context(), productID,
+		productapi.SetProductAttributesJSONRequestBody{Attributes: &attributes, Version: version}, h.asCurrentUser(c))
+	if err != nil {
+		return fmt.Errorf("failed to set product attributes: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusBadRequest:
+		return fmt.Errorf("%s", productServiceErrorText(resp.Body))
+	case http.StatusConflict:
+		return errProductConflict
+	default:
+		return fmt.Errorf("product service returned status %d on set attributes", resp.StatusCode())
+	}
+}
@@ -0,0 +1,464 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	admin_templates "github.com/Nzyazin/zadnik.store/internal/templates/admin-templates"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	CategoriesPath          = "/admin/categories"
+	CategoryCreatePath      = "/admin/categories/create"
+	CategoryEditPathFormat  = "/admin/categories/%d/edit"
+)
+
+// categoryForm - поля формы раздела в формате API сервиса товаров
+type categoryForm struct {
+	ParentID       *int32 `json:"parent_id"`
+	Name           string `json:"name"`
+	Slug           string `json:"slug"`
+	Description    string `json:"description"`
+	SEOTitle       string `json:"seo_title"`
+	SEODescription string `json:"seo_description"`
+	Position       int32  `json:"position"`
+}
+
+func (h *Handler) categoriesIndex(c *gin.Context) {
+	params := admin_templates.CategoriesIndexParams{
+		BaseParams: admin_templates.BaseParams{
+			Title: "Категории",
+		},
+		Error: c.Query("error"),
+	}
+
+	tree, err := h.fetchCategoryTree(c.Request.Context())
+	if err != nil {
+		h.logger.Errorf("Failed to get categories: %v", err)
+		params.Error = "Не удалось загрузить категории"
+	}
+	params.Categories = admin_templates.FlattenCategories(tree, nil)
+
+	if err := h.templates.RenderCategoriesIndex(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render categories template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+func (h *Handler) categoryCreatePage(c *gin.Context) {
+	h.renderCategoryForm(c, &admin_templates.Category{}, c.Query("error"))
+}
+
+func (h *Handler) categoryCreate(c *gin.Context) {
+	form, err := parseCategoryForm(c)
+	if err != nil {
+		c.Redirect(http.StatusFound, CategoryCreatePath+"?error="+url.QueryEscape(err.Error()))
+		return
+	}
+
+	resp, err := h.productServiceRequest(c.Request.Context(), http.MethodPost, "/categories", form)
+	if err != nil {
+		h.logger.Errorf("Failed to create category: %v", err)
+		c.Redirect(http.StatusFound, CategoryCreatePath+"?error="+url.QueryEscape("Сервис товаров недоступен"))
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		h.renderCategoryForm(c, form.toCategory(0), categoryErrorMessage(resp))
+		return
+	}
+
+	c.Redirect(http.StatusFound, CategoriesPath)
+}
+
+func (h *Handler) categoryEditPage(c *gin.Context) {
+	category, err := h.fetchCategory(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.logger.Errorf("Failed to get category: %v", err)
+		c.Redirect(http.StatusFound, CategoriesPath)
+		return
+	}
+
+	h.renderCategoryForm(c, category, c.Query("error"))
+}
+
+func (h *Handler) categoryUpdate(c *gin.Context) {
+	categoryID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Redirect(http.StatusFound, CategoriesPath)
+		return
+	}
+
+	editPath := fmt.Sprintf(CategoryEditPathFormat, categoryID)
+	form, err := parseCategoryForm(c)
+	if err != nil {
+		c.Redirect(http.StatusFound, editPath+"?error="+url.QueryEscape(err.Error()))
+		return
+	}
+
+	resp, err := h.productServiceRequest(c.Request.Context(), http.MethodPut, fmt.Sprintf("/categories/%d", categoryID), form)
+	if err != nil {
+		h.logger.Errorf("Failed to update category: %v", err)
+		c.Redirect(http.StatusFound, editPath+"?error="+url.QueryEscape("Сервис товаров недоступен"))
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		h.renderCategoryForm(c, form.toCategory(int32(categoryID)), categoryErrorMessage(resp))
+		return
+	}
+
+	c.Redirect(http.StatusFound, CategoriesPath)
+}
+
+func (h *Handler) categoryDelete(c *gin.Context) {
+	categoryID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Redirect(http.StatusFound, CategoriesPath)
+		return
+	}
+
+	resp, err := h.productServiceRequest(c.Request.Context(), http.MethodDelete, fmt.Sprintf("/categories/%d", categoryID), nil)
+	if err != nil {
+		h.logger.Errorf("Failed to delete category: %v", err)
+		c.Redirect(http.StatusFound, CategoriesPath+"?error="+url.QueryEscape("Сервис товаров недоступен"))
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		c.Redirect(http.StatusFound, CategoriesPath+"?error="+url.QueryEscape(categoryErrorMessage(resp)))
+		return
+	}
+
+	c.Redirect(http.StatusFound, CategoriesPath)
+}
+
+func (h *Handler) renderCategoryForm(c *gin.Context, category *admin_templates.Category, errMessage string) {
+	params := admin_templates.CategoryFormPageParams{
+		BaseParams: admin_templates.BaseParams{
+			Title: "Новый раздел",
+		},
+		Action:     CategoryCreatePath,
+		Category:   category,
+		ButtonText: "Создать",
+		Error:      errMessage,
+	}
+	if category.ID != 0 {
+		params.Title = "Редактирование раздела - " + category.Name
+		params.Action = fmt.Sprintf(CategoryEditPathFormat, category.ID)
+		params.IsEdit = true
+		params.ButtonText = "Сохранить"
+	}
+
+	tree, err := h.fetchCategoryTree(c.Request.Context())
+	if err != nil {
+		h.logger.Errorf("Failed to get categories: %v", err)
+	}
+	selected := map[int32]bool{}
+	if category.ParentID != nil {
+		selected[*category.ParentID] = true
+	}
+	params.Parents = categoryParentOptions(admin_templates.FlattenCategories(tree, selected), category.ID)
+
+	if err := h.templates.RenderCategoryFormPage(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render category form template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+// categoryParentOptions убирает из списка родителей сам раздел и его подразделы
+func categoryParentOptions(options []admin_templates.CategoryOption, categoryID int32) []admin_templates.CategoryOption {
+	if categoryID == 0 {
+		return options
+	}
+
+	result := make([]admin_templates.CategoryOption, 0, len(options))
+	skipDepth := -1
+	for _, option := range options {
+		if skipDepth >= 0 && option.Depth > skipDepth {
+			continue
+		}
+		skipDepth = -1
+		if option.ID == categoryID {
+			skipDepth = option.Depth
+			continue
+		}
+		result = append(result, option)
+	}
+	return result
+}
+
+func parseCategoryForm(c *gin.Context) (*categoryForm, error) {
+	form := &categoryForm{
+		Name:           strings.TrimSpace(c.PostForm("name")),
+		Slug:           strings.TrimSpace(c.PostForm("slug")),
+		Description:    c.PostForm("description"),
+		SEOTitle:       c.PostForm("seo_title"),
+		SEODescription: c.PostForm("seo_description"),
+	}
+	if form.Name == "" || form.Slug == "" {
+		return nil, fmt.Errorf("Название и адрес обязательны")
+	}
+
+	if value := c.PostForm("parent_id"); value != "" {
+		parentID, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Некорректный родительский раздел")
+		}
+		id := int32(parentID)
+		form.ParentID = &id
+	}
+
+	if value := c.PostForm("position"); value != "" {
+		position, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Некорректный порядок")
+		}
+		form.Position = int32(position)
+	}
+
+	return form, nil
+}
+
+func (f *categoryForm) toCategory(id int32) *admin_templates.Category {
+	return &admin_templates.Category{
+		ID:             id,
+		ParentID:       f.ParentID,
+		Name:           f.Name,
+		Slug:           f.Slug,
+		Description:    f.Description,
+		SEOTitle:       f.SEOTitle,
+		SEODescription: f.SEODescription,
+		Position:       f.Position,
+	}
+}
+
+// categoryErrorMessage переводит ответ сервиса товаров в сообщение для формы
+func categoryErrorMessage(resp *http.Response) string {
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return "Раздел не найден"
+	case http.StatusConflict:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if strings.Contains(string(body), "subcategories") {
+			return "Сначала удалите или перенесите подразделы"
+		}
+		return "Раздел с таким адресом уже существует"
+	case http.StatusBadRequest:
+		return "Проверьте название, адрес и родительский раздел"
+	default:
+		return "Не удалось сохранить раздел"
+	}
+}
+
+func (h *Handler) fetchCategory(ctx context.Context, categoryID string) (*admin_templates.Category, error) {
+	id, err := strconv.ParseInt(categoryID, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid category id %q: %w", categoryID, err)
+	}
+
+	resp, err := h.productAPI.GetCategoryWithResponse(ctx, int32(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on category", resp.StatusCode())
+	}
+	category := categoryFromAPI(*resp.JSON200)
+	return &category, nil
+}
+
+func (h *Handler) fetchCategoryTree(ctx context.Context) ([]admin_templates.Category, error) {
+	tree := "1"
+	resp, err := h.productAPI.ListCategoriesWithResponse(ctx, &productapi.ListCategoriesParams{Tree: &tree})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on categories", resp.StatusCode())
+	}
+	return categoriesFromAPI(*resp.JSON200), nil
+}
+
+// fetchProductCategoryIDs возвращает разделы, к которым привязан товар
+func (h *Handler) fetchProductCategoryIDs(ctx context.Context, productID int32) (map[int32]bool, error) {
+	resp, err := h.productAPI.GetProductCategoriesWithResponse(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product categories: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on product categories", resp.StatusCode())
+	}
+
+	ids := make(map[int32]bool, len(*resp.JSON200))
+	for _, category := range *resp.JSON200 {
+		ids[category.Id] = true
+	}
+	return ids, nil
+}
+
+func categoryFromAPI(category productapi.Category) admin_templates.Category {
+	return admin_templates.Category{
+		ID:             category.Id,
+		ParentID:       category.ParentId,
+		Name:           category.Name,
+		Slug:           category.Slug,
+		Description:    category.Description,
+		SEOTitle:       category.SeoTitle,
+		SEODescription: category.SeoDescription,
+		Position:       category.Position,
+		Children:       categoriesFromAPI(sliceValue(category.Children)),
+	}
+}
+
+func categoriesFromAPI(categories []productapi.Category) []admin_templates.Category {
+	out := make([]admin_templates.Category, len(categories))
+	for i, category := range categories {
+		out[i] = categoryFromAPI(category)
+	}
+	return out
+}
+
+// productCategoryOptions готовит чекбоксы категорий для формы товара; productID == 0 - новый товар
+func (h *Handler) productCategoryOptions(ctx context.Context, productID int32) []admin_templates.CategoryOption {
+	tree, err := h.fetchCategoryTree(ctx)
+	if err != nil {
+		h.logger.Errorf("Failed to get categories: %v", err)
+		return nil
+	}
+
+	var checked map[int32]bool
+	if productID != 0 {
+		if checked, err = h.fetchProductCategoryIDs(ctx, productID); err != nil {
+			h.logger.Errorf("Failed to get product categories: %v", err)
+		}
+	}
+	return admin_templates.FlattenCategories(tree, checked)
+}
+
+// setProductCategories сохраняет отмеченные в форме товара категории; с version - только по этой версии товара
+func (h *Handler) setProductCategories(c *gin.Context, productID int32, version *int32) error {
+	if c.PostForm("categories_present") == "" {
+		return nil
+	}
+
+	categoryIDs := []int32{}
+	for _, value := range c.PostFormArray("category_ids") {
+		id, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid category id %q: %w", value, err)
+		}
+		categoryIDs = append(categoryIDs, int32(id))
+	}
+
+	resp, err := h.productAPI.SetProductCategoriesWithResponse(c.Request.Context(), productID,
+		productapi.SetProductCategoriesJSONRequestBody{CategoryIds: &categoryIDs, Version: version}, h.asCurrentUser(c))
+	if err != nil {
+		return fmt.Errorf("failed to set product categories: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusConflict:
+		return errProductConflict
+	default:
+		return fmt.Errorf("product service returned status %d on set categories", resp.StatusCode())
+	}
+}
+
+// productServiceRequest отправляет запрос к сервису товаров с ключом API и телом в JSON
+func (h *Handler) productServiceRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	req, err := h.newProductServiceRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return h.httpClient.Do(req)
+}
+
+func (h *Handler) newProductServiceRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, h.productServiceUrl+path, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-KEY", h.productServiceAPIKey)
+	return req, nil
+}
+
+// sendProductServiceChange отправляет изменение от имени администратора и возвращает на backPath;
+// ответы 400 и 409 показываются пользователю как причина отказа
+func (h *Handler) sendProductServiceChange(c *gin.Context, method, path string, body interface{}, wantStatus int, backPath, failMessage string) {
+	req, err := h.newProductServiceRequest(c.Request.Context(), method, path, body)
+	if err != nil {
+		h.logger.Errorf("Failed to create product service request: %v", err)
+		c.Redirect(http.StatusFound, backPath)
+		return
+	}
+	req.Header.Set("X-User-ID", strconv.FormatInt(h.currentUserID(c), 10))
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		h.logger.Errorf("Failed to send product service request: %v", err)
+		c.Redirect(http.StatusFound, backPath+"?error="+url.QueryEscape("Сервис товаров недоступен"))
+		return
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case wantStatus:
+		c.Redirect(http.StatusFound, backPath)
+	case http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity:
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		c.Redirect(http.StatusFound, backPath+"?error="+url.QueryEscape("Операция отклонена: "+productServiceErrorText(message)))
+	default:
+		h.logger.Errorf("Product service returned status %d on %s %s", resp.StatusCode, method, path)
+		c.Redirect(http.StatusFound, backPath+"?error="+url.QueryEscape(failMessage))
+	}
+}
+
+// productServiceErrorText достаёт причину отказа из ответа сервиса товаров: JSON с полями по спецификации или текст
+func productServiceErrorText(body []byte) string {
+	var resp struct {
+		Error   string `json:"error"`
+		Details []struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"details"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil || resp.Error == "" {
+		return strings.TrimSpace(string(body))
+	}
+	if len(resp.Details) == 0 {
+		return resp.Error
+	}
+	reasons := make([]string, len(resp.Details))
+	for i, detail := range resp.Details {
+		reasons[i] = detail.Message
+		if detail.Field != "" {
+			reasons[i] = detail.Field + ": " + detail.Message
+		}
+	}
+	return strings.Join(reasons, "; ")
+}
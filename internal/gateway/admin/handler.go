@@ -1,14 +1,19 @@
 package admin
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
+	pb "github.com/Nzyazin/zadnik.store/api/generated/product"
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
 	"github.com/Nzyazin/zadnik.store/internal/broker"
 	"github.com/Nzyazin/zadnik.store/internal/common"
 	"github.com/Nzyazin/zadnik.store/internal/gateway/auth"
@@ -23,18 +28,34 @@ const (
     ProductCreatePath      = "/admin/products/create"
     ProductEditPathFormat  = "/admin/products/%d/edit"
     ProductDeletePathFormat = "/admin/products/%d/delete"
+    ProductHistoryPathFormat = "/admin/products/%d/history"
     
     LoginPath              = "/admin/login"
     LogoutPath             = "/admin/logout"
     
     AdminIndexPath         = "/admin"
+
+    userIDContextKey = "user_id"
+
+    productsPageSize = 20
 )
 
+var productSortFields = []string{"name", "price", "created_at"}
+
+// errProductConflict - сервис товаров отклонил изменение: товар уже сохранили с другой версией
+var errProductConflict = errors.New("product was modified by another request")
+
 type Handler struct {
 	authService          auth.AuthService
 	templates            *admin_templates.Templates
 	productServiceUrl    string
 	productServiceAPIKey string
+	// products - gRPC API товаров для чтения; изменения идут через саги и HTTP, где есть проверка версии
+	products             pb.ProductServiceClient
+	// productAPI - HTTP API товаров: синхронные изменения с проверкой версии
+	productAPI           *productapi.ClientWithResponses
+	// catalogAPI - тот же API с долгим таймаутом для импорта и выгрузки каталога
+	catalogAPI           *productapi.ClientWithResponses
 	httpClient           *http.Client
 	logger               common.Logger
 	messageBroker        broker.MessageBroker
@@ -45,6 +66,9 @@ func NewHandler(
 	templates *admin_templates.Templates,
 	productServiceUrl string,
 	productServiceAPIKey string,
+	products pb.ProductServiceClient,
+	productAPI *productapi.ClientWithResponses,
+	catalogAPI *productapi.ClientWithResponses,
 	messageBroker broker.MessageBroker,
 ) *Handler {
 	return &Handler{
@@ -52,6 +76,9 @@ func NewHandler(
 		templates:            templates,
 		productServiceUrl:    productServiceUrl,
 		productServiceAPIKey: productServiceAPIKey,
+		products:             products,
+		productAPI:           productAPI,
+		catalogAPI:           catalogAPI,
 		httpClient: &http.Client{
 			Timeout: time.Second * 9,
 		},
@@ -76,9 +103,41 @@ func (h *Handler) RegisterRoutes(r *gin.Engine) {
 			authorized.GET("/products", h.productsIndex)
 			authorized.GET("/products/create", h.productCreatePage)
 			authorized.POST("/products/create", h.productCreate)
+			authorized.GET("/products/import", h.productsImportPage)
+			authorized.POST("/products/import", h.productsImportUpload)
+			authorized.POST("/products/import/apply", h.productsImportApply)
+			authorized.GET("/products/export", h.productsExport)
 			authorized.GET("/products/:id/edit", h.productEditPage)
 			authorized.POST("/products/:id/edit", h.productUpdate)
 			authorized.POST("/products/:id/delete", h.productDelete)
+			authorized.POST("/products/:id/visibility", h.productVisibilityUpdate)
+			authorized.POST("/products/:id/images", h.productImagesUpdate)
+			authorized.POST("/products/:id/images/:imageID/delete", h.productImageDelete)
+			authorized.GET("/products/:id/stock", h.productStockPage)
+			authorized.POST("/products/:id/stock", h.productStockMove)
+			authorized.POST("/products/:id/stock/threshold", h.productStockThreshold)
+			authorized.POST("/products/:id/stock/reservations/:reservationID/:action", h.productStockReservation)
+			authorized.GET("/products/:id/prices", h.productPricesPage)
+			authorized.POST("/products/:id/prices", h.productPriceSchedule)
+			authorized.POST("/products/:id/prices/:changeID/cancel", h.productPriceCancel)
+			authorized.GET("/products/:id/relations", h.productRelationsPage)
+			authorized.POST("/products/:id/relations", h.productRelationsUpdate)
+			authorized.GET("/products/:id/history", h.productHistoryPage)
+			authorized.POST("/products/:id/history/:revisionID/restore", h.productRestoreRevision)
+			authorized.GET("/categories", h.categoriesIndex)
+			authorized.GET("/categories/create", h.categoryCreatePage)
+			authorized.POST("/categories/create", h.categoryCreate)
+			authorized.GET("/categories/:id/edit", h.categoryEditPage)
+			authorized.POST("/categories/:id/edit", h.categoryUpdate)
+			authorized.POST("/categories/:id/delete", h.categoryDelete)
+			authorized.GET("/attributes", h.attributesIndex)
+			authorized.GET("/attributes/create", h.attributeCreatePage)
+			authorized.POST("/attributes/create", h.attributeCreate)
+			authorized.GET("/attributes/:id/edit", h.attributeEditPage)
+			authorized.POST("/attributes/:id/edit", h.attributeUpdate)
+			authorized.POST("/attributes/:id/delete", h.attributeDelete)
+			authorized.GET("/reviews", h.reviewsIndex)
+			authorized.POST("/reviews/:id/moderate", h.reviewModerate)
 		}
 	}
 }
@@ -106,6 +165,20 @@ func (h *Handler) checkAuth(c *gin.Context) bool {
 	return true
 }
 
+// currentUserID возвращает ID администратора, чей токен проверил authMiddleware
+func (h *Handler) currentUserID(c *gin.Context) int64 {
+	return c.GetInt64(userIDContextKey)
+}
+
+// asCurrentUser помечает запрос к API товаров администратором, от имени которого идёт изменение
+func (h *Handler) asCurrentUser(c *gin.Context) productapi.RequestEditorFn {
+	userID := strconv.FormatInt(h.currentUserID(c), 10)
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-User-ID", userID)
+		return nil
+	}
+}
+
 func (h *Handler) validateProductID(c *gin.Context) (int64, error) {
 	productID := c.Param("id")
 	if productID == "" {
@@ -128,7 +201,10 @@ func (h *Handler) productCreatePage(c *gin.Context) {
 		Product: &admin_templates.Product{},
 		ButtonText: "Создать",
 		Error: c.Query("error"),
+		Categories: h.productCategoryOptions(c.Request.Context(), 0),
 	}
+	params.Attributes = h.productAttributeInputs(c.Request.Context(), params.Product)
+	params.CategoryNames = admin_templates.CategoryNames(params.Categories)
 
 	if err := h.templates.RenderProductFormPage(c.Writer, params); err != nil {
 		h.logger.Errorf("Failed to render product create page: %v", err)
@@ -155,23 +231,47 @@ func (h *Handler) productCreate(c *gin.Context) {
 		EventType:   broker.EventTypeProductCreating,
 		Name:        name,
 		Description: description,
+		TaxClass:    c.PostForm("tax_class"),
+		UserID:      h.currentUserID(c),
+	}
+
+	var err error
+	productEvent.Visibility, productEvent.PublishAt, productEvent.UnpublishAt, err = parseVisibilityForm(c)
+	if err != nil {
+		h.redirectWithError(c, "", err.Error())
+		return
 	}
 
-	imageReader, filename, err := h.handleImage(c)
+	// первое изображение создаётся вместе с товаром, остальные догружаются в галерею после создания
+	images, err := formImages(c)
 	if err != nil {
-		h.redirectWithError(c, "", "Failed to handle image")
+		h.redirectWithError(c, "", err.Error())
 		return
 	}
-	if imageReader != nil {
-		defer imageReader.Close()
-		imageBytes, err := io.ReadAll(imageReader)
+	if len(images) > 0 {
+		imageBytes, err := readImage(images[0])
 		if err != nil {
 			h.redirectWithError(c, "", "Failed to read image")
 			return
 		}
-		productEvent.Filename = filename
+		productEvent.Filename = images[0].Filename
 		productEvent.ImageData = imageBytes
+		images = images[1:]
+	}
+
+	variants, err := parseVariantsForm(c)
+	if err != nil {
+		h.redirectWithError(c, "", "Invalid variants: "+err.Error())
+		return
+	}
+	productEvent.Variants = variants
+
+	priceTiers, err := parsePriceTiersForm(c)
+	if err != nil {
+		h.redirectWithError(c, "", "Invalid price tiers: "+err.Error())
+		return
 	}
+	productEvent.PriceTiers = priceTiers
 
 	if priceDecimal, err := decimal.NewFromString(priceStr); err != nil {
 		h.redirectWithError(c, "", "Invalid price format")
@@ -183,13 +283,33 @@ func (h *Handler) productCreate(c *gin.Context) {
 		productEvent.Price = priceDecimal
 	}
 
-	done := make(chan error, 1)
-	if err := h.messageBroker.SubscribeToProductCreatedCompleted(c.Request.Context(), broker.ProductImageCreatingCompletedExchange, broker.EventTypeProductCreatingCompleted, func(pe *broker.ProductEvent) error {
+	// по RequestID отличаем свой товар от созданных параллельно в админке и через REST
+	requestID, err := newRandomToken()
+	if err != nil {
+		h.logger.Errorf("Failed to generate request ID: %v", err)
+		h.redirectWithError(c, "", "Failed to create product")
+		return
+	}
+	productEvent.RequestID = requestID
+
+	// подписка снимается, когда обработчик завершится
+	subscriptionCtx, unsubscribe := context.WithCancel(c.Request.Context())
+	defer unsubscribe()
+
+	done := make(chan int32, 1)
+	if err := h.messageBroker.SubscribeToProductCreatedCompleted(subscriptionCtx, broker.ProductImageCreatingCompletedExchange, broker.EventTypeProductCreatingCompleted, func(pe *broker.ProductEvent) error {
+		if pe.RequestID != requestID {
+			return nil
+		}
 		h.logger.Infof("Received add completed event for product %d", pe.ProductID)
-		done <- nil
+		select {
+		case done <- pe.ProductID:
+		default:
+		}
 		return nil
 	}); err != nil {
 		h.logger.Errorf("Failed to subscribe to product created completed: %v", err)
+		h.redirectWithError(c, "", "Failed to create product")
 		return
 	}
 
@@ -202,7 +322,16 @@ func (h *Handler) productCreate(c *gin.Context) {
 	h.logger.Infof("Successfully published create event for product")
 
 	select {
-	case <-done:
+	case productID := <-done:
+		if err := h.setProductCategories(c, productID, nil); err != nil {
+			h.logger.Errorf("Failed to set categories for product %d: %v", productID, err)
+		}
+		if err := h.setProductAttributes(c, productID, nil); err != nil {
+			h.logger.Errorf("Failed to set attributes for product %d: %v", productID, err)
+		}
+		if err := h.publishImageUploads(c, productID, images, name); err != nil {
+			h.logger.Errorf("Failed to upload gallery of product %d: %v", productID, err)
+		}
 		c.Redirect(http.StatusFound, ProductsPath)
 	case <-time.After(3 * time.Second):
 		h.renderProductsIndex(c, admin_templates.ProductsIndexParams{
@@ -235,43 +364,149 @@ func (h *Handler) productUpdate(c *gin.Context) {
 	originalName := c.PostForm("original_name")
 	originalDescription := c.PostForm("original_description")
 
-	productEvent := &broker.ProductEvent{
-		EventType: broker.EventTypeProductUpdating,
-		ProductID: int32(productIDInt),
-	}
 	productIDStr := strconv.FormatInt(productIDInt, 10)
+	version, err := strconv.ParseInt(c.PostForm("version"), 10, 32)
+	if err != nil {
+		h.redirectWithError(c, productIDStr, "Invalid product version")
+		return
+	}
+
+	currentProduct, err := h.fetchProduct(c.Request.Context(), productIDStr)
+	if err != nil {
+		h.logger.Errorf("Failed to get product for update: %v", err)
+		h.redirectWithError(c, productIDStr, "Failed to load product")
+		return
+	}
+
+	patch := productapi.ProductPatch{Version: int32(version)}
+	changed := false
 
 	if priceDecimal, err := h.handlePrice(priceStr, originalPrice); err != nil {
 		h.redirectWithError(c, productIDStr, "Failed to update price")
 		return
 	} else if priceDecimal != decimal.Zero {
-		productEvent.Price = priceDecimal
+		price := priceDecimal.String()
+		patch.Price, changed = &price, true
 	}
 
 	if name != originalName {
-		productEvent.Name = name
+		patch.Name, changed = &name, true
+	}
+
+	variants, err := parseVariantsForm(c)
+	if err != nil {
+		h.redirectWithError(c, productIDStr, "Invalid variants: "+err.Error())
+		return
+	}
+	if variants != nil && variantsChanged(currentProduct.Variants, variants) {
+		inputs := variantInputs(variants)
+		patch.Variants, changed = &inputs, true
+	}
+
+	priceTiers, err := parsePriceTiersForm(c)
+	if err != nil {
+		h.redirectWithError(c, productIDStr, "Invalid price tiers: "+err.Error())
+		return
+	}
+	if priceTiers != nil && priceTiersChanged(currentProduct.PriceTiers, priceTiers) {
+		inputs := priceTierInputs(priceTiers)
+		patch.PriceTiers, changed = &inputs, true
 	}
 
 	if description != originalDescription {
-		productEvent.Description = description
+		patch.Description, changed = &description, true
+	}
+
+	if taxClass := c.PostForm("tax_class"); taxClass != c.PostForm("original_tax_class") {
+		class := productapi.TaxClass(taxClass)
+		patch.TaxClass, changed = &class, true
+	}
+
+	// адрес меняется только если его исправили в форме; пустое поле - сформировать из названия
+	if slug, ok := c.GetPostForm("slug"); ok {
+		if slug = strings.TrimSpace(slug); slug == "" {
+			regenerate := true
+			patch.RegenerateSlug, changed = &regenerate, true
+		} else if slug != c.PostForm("original_slug") {
+			patch.Slug, changed = &slug, true
+		}
+	}
+
+	images, err := formImages(c)
+	if err != nil {
+		h.redirectWithError(c, productIDStr, err.Error())
+		return
+	}
+
+	// Версию проверяет сервис товаров: поля меняются PATCH по версии формы, а разделы и характеристики
+	// пишутся по версии, которую вернул PATCH, - так они не лягут поверх чужого сохранения
+	productVersion := patch.Version
+	if changed {
+		resp, err := h.productAPI.UpdateProductWithResponse(c.Request.Context(), int32(productIDInt), patch, h.asCurrentUser(c))
+		if err != nil {
+			h.logger.Errorf("Failed to update product %d: %v", productIDInt, err)
+			h.redirectWithError(c, productIDStr, "Сервис товаров недоступен")
+			return
+		}
+		switch resp.StatusCode() {
+		case http.StatusOK:
+			productVersion = resp.JSON200.Version
+		case http.StatusConflict:
+			h.renderProductConflict(c, productIDStr, name, description, priceStr)
+			return
+		case http.StatusBadRequest, http.StatusUnprocessableEntity:
+			c.Status(http.StatusUnprocessableEntity)
+			h.renderProductEditPage(c, currentProduct, "Изменение отклонено: "+productServiceErrorText(resp.Body), nil)
+			return
+		default:
+			h.logger.Errorf("Product service returned status %d on update of product %d", resp.StatusCode(), productIDInt)
+			h.redirectWithError(c, productIDStr, "Failed to update product")
+			return
+		}
+	}
+
+	if err := h.setProductCategories(c, int32(productIDInt), &productVersion); err != nil {
+		if errors.Is(err, errProductConflict) {
+			h.renderProductConflict(c, productIDStr, name, description, priceStr)
+			return
+		}
+		h.logger.Errorf("Failed to set categories for product %d: %v", productIDInt, err)
+		h.redirectWithError(c, productIDStr, "Failed to update categories")
+		return
 	}
 
-	if productEvent.Price != decimal.Zero || productEvent.Name != "" || productEvent.Description != "" {
-		if err := h.messageBroker.PublishProduct(c.Request.Context(), broker.ProductImageUpdatingExchange, productEvent); err != nil {
-			h.logger.Errorf("Failed to publish product event: %v", err)
-			h.redirectWithError(c, productIDStr, "Failed to publish product event")
+	if err := h.setProductAttributes(c, int32(productIDInt), &productVersion); err != nil {
+		if errors.Is(err, errProductConflict) {
+			h.renderProductConflict(c, productIDStr, name, description, priceStr)
 			return
 		}
+		h.logger.Errorf("Failed to set attributes for product %d: %v", productIDInt, err)
+		h.redirectWithError(c, productIDStr, "Failed to update attributes: "+err.Error())
+		return
 	}
 
-	if err := h.handleImageUpload(c, productIDInt); err != nil {
-		h.redirectWithError(c, strconv.FormatInt(productIDInt, 10), err.Error())
+	// изображения отправляются последними, когда изменения формы уже приняты по версии
+	if err := h.publishImageUploads(c, int32(productIDInt), images, currentProduct.Name); err != nil {
+		h.redirectWithError(c, productIDStr, err.Error())
 		return
 	}
 
 	c.Redirect(http.StatusFound, ProductsPath)
 }
 
+// renderProductConflict показывает форму с актуальным товаром и расхождениями с отправленными значениями
+func (h *Handler) renderProductConflict(c *gin.Context, productID, name, description, priceStr string) {
+	current, err := h.fetchProduct(c.Request.Context(), productID)
+	if err != nil {
+		h.logger.Errorf("Failed to get product after version conflict: %v", err)
+		h.redirectWithError(c, productID, "Failed to load product")
+		return
+	}
+	h.logger.Warnf("Product %s was modified concurrently, current version %d", productID, current.Version)
+	c.Status(http.StatusConflict)
+	h.renderProductEditPage(c, current, "", productConflicts(current, name, description, priceStr))
+}
+
 func (h *Handler) productDelete(c *gin.Context) {
 	if !h.checkAuth(c) {
 		h.logger.Errorf("Unauthorized attempt to delete product")
@@ -287,7 +522,18 @@ func (h *Handler) productDelete(c *gin.Context) {
 		return
 	}
 
-	imageURL := c.PostForm("image_url")
+	product, err := h.fetchProduct(c.Request.Context(), strconv.FormatInt(productIDint, 10))
+	if err != nil {
+		h.logger.Errorf("Failed to get product %d for deletion: %v", productIDint, err)
+		h.renderProductsIndex(c, admin_templates.ProductsIndexParams{
+			Error: "Failed to load product",
+		})
+		return
+	}
+	imageURLs := make([]string, 0, len(product.Images))
+	for _, image := range product.Images {
+		imageURLs = append(imageURLs, image.URL)
+	}
 	h.logger.Infof("Starting deletion process for product %d", productIDint)	
 
 	done := make(chan error, 1)
@@ -315,7 +561,9 @@ func (h *Handler) productDelete(c *gin.Context) {
 	productEvent := &broker.ProductEvent{
 		EventType: broker.EventTypeProductDeleted,
 		ProductID: int32(productIDint),
-		ImageURL:  imageURL,
+		ImageURL:  product.ImageURL.String,
+		ImageURLs: imageURLs,
+		UserID:    h.currentUserID(c),
 	}
 
 	if err := h.messageBroker.PublishProduct(c.Request.Context(), broker.ProductImageDeletingExchange, productEvent); err != nil {
@@ -350,54 +598,6 @@ func (h *Handler) productDelete(c *gin.Context) {
 
 }
 
-func (h *Handler) handleImage(c *gin.Context) (io.ReadCloser, string, error) {
-	file, err := c.FormFile("image")
-	if err == http.ErrMissingFile {
-		return nil, "", nil
-	}
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to get image: %w", err)
-	}
-	if file == nil {
-		return nil, "", fmt.Errorf("file not found")
-	}
-
-	imageData, err := file.Open()
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to open image: %w", err)
-	}
-	return imageData, file.Filename, nil
-}
-
-func (h *Handler) handleImageUpload(c *gin.Context, productIDInt int64) error {
-	imageReader, _, err := h.handleImage(c)
-	if err != nil {
-		return fmt.Errorf("failed to read image: %w", err)
-	}
-	if imageReader == nil {
-		return nil
-	}
-	imageEvent := &broker.ImageEvent{
-		EventType: broker.EventTypeImageUploaded,
-		ProductID: int32(productIDInt),
-	}
-
-	defer imageReader.Close()
-	imageBytes, err := io.ReadAll(imageReader)
-	if err != nil {
-		return fmt.Errorf("failed to read image: %w", err)
-	}
-	imageEvent.ImageData = imageBytes
-
-	if err := h.messageBroker.PublishImage(c.Request.Context(), broker.ImageExchange, imageEvent); err != nil {
-		h.logger.Errorf("Failed to publish image event: %v", err)
-		return fmt.Errorf("failed to publish image event: %v", err)
-	}
-
-	h.logger.Infof("Successfully published image event for product ID: %d", productIDInt)
-	return nil
-}
-
 func (h *Handler) handlePrice(productIDStr, originalPrice string) (decimal.Decimal, error) {
 	if productIDStr == originalPrice {
 		return decimal.Zero, nil
@@ -432,52 +632,196 @@ func (h *Handler) productEditPage(c *gin.Context) {
 		return
 	}
 
-	req, err := http.NewRequest(http.MethodGet, h.productServiceUrl+"/products/"+productID, nil)
+	product, err := h.fetchProduct(c.Request.Context(), productID)
 	if err != nil {
-		h.logger.Errorf("Failed to create request: %v", err)
+		h.logger.Errorf("Failed to get product: %v", err)
 		c.Redirect(http.StatusFound, ProductsPath)
 		return
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-API-KEY", h.productServiceAPIKey)
 
-	resp, err := h.httpClient.Do(req)
-	if err != nil {
-		h.logger.Errorf("Failed to get product: %v", err)
-		c.Redirect(http.StatusFound, ProductsPath)
-		return
+	h.renderProductEditPage(c, product, c.Query("error"), nil)
+}
+
+func (h *Handler) renderProductEditPage(c *gin.Context, product *admin_templates.Product, errMessage string, conflicts []admin_templates.ProductFieldConflict) {
+	params := admin_templates.ProductFormPageParams{
+		BaseParams: admin_templates.BaseParams{
+			Title: "Редактирование товара - " + product.Name,
+		},
+		Action: fmt.Sprintf(ProductEditPathFormat, product.ID),
+		IsEdit: true,
+		Product: product,
+		ButtonText: "Сохранить",
+		Error:   errMessage,
+		Conflicts: conflicts,
+		Categories: h.productCategoryOptions(c.Request.Context(), int32(product.ID)),
 	}
-	defer resp.Body.Close()
+	params.Attributes = h.productAttributeInputs(c.Request.Context(), product)
+	params.CategoryNames = admin_templates.CategoryNames(params.Categories)
 
-	if resp.StatusCode != http.StatusOK {
-		h.logger.Errorf("Product service returned non-200 status: %d", resp.Status)
+	if err := h.templates.RenderProductFormPage(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render product template: %v", err)
 		c.Redirect(http.StatusFound, ProductsPath)
 		return
 	}
+}
+
+func (h *Handler) fetchProduct(ctx context.Context, productID string) (*admin_templates.Product, error) {
+	id, err := strconv.ParseInt(productID, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid product id %q: %w", productID, err)
+	}
+
+	resp, err := h.products.GetProduct(ctx, &pb.GetProductRequest{Key: &pb.GetProductRequest_Id{Id: int32(id)}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	product := productFromProto(resp.Product)
+	return &product, nil
+}
+
+// productConflicts сравнивает отправленные значения с актуальными значениями товара
+func productConflicts(current *admin_templates.Product, name, description, priceStr string) []admin_templates.ProductFieldConflict {
+	var conflicts []admin_templates.ProductFieldConflict
+
+	if name != current.Name {
+		conflicts = append(conflicts, admin_templates.ProductFieldConflict{
+			Label: "Название", Yours: name, Current: current.Name,
+		})
+	}
+
+	if price, err := decimal.NewFromString(priceStr); err != nil || !price.Equal(current.Price) {
+		conflicts = append(conflicts, admin_templates.ProductFieldConflict{
+			Label: "Цена", Yours: priceStr, Current: current.Price.String(),
+		})
+	}
 
-	var product admin_templates.Product
-	if err := json.NewDecoder(resp.Body).Decode(&product); err != nil {
-		h.logger.Errorf("failed to decode product: " + err.Error())
+	if description != current.Description {
+		conflicts = append(conflicts, admin_templates.ProductFieldConflict{
+			Label: "Описание", Yours: description, Current: current.Description,
+		})
+	}
+
+	return conflicts
+}
+
+func (h *Handler) productHistoryPage(c *gin.Context) {
+	productID := c.Param("id")
+
+	product, err := h.fetchProduct(c.Request.Context(), productID)
+	if err != nil {
+		h.logger.Errorf("Failed to get product: %v", err)
 		c.Redirect(http.StatusFound, ProductsPath)
 		return
 	}
 
-	params := admin_templates.ProductFormPageParams{
+	params := admin_templates.ProductHistoryPageParams{
 		BaseParams: admin_templates.BaseParams{
-			Title: "Редактирование товара - " + product.Name,
+			Title: "История изменений - " + product.Name,
 		},
-		Action: fmt.Sprintf(ProductEditPathFormat, product.ID),
-		IsEdit: true,
-		Product: &product,
-		ButtonText: "Сохранить",
+		Product: product,
 		Error:   c.Query("error"),
 	}
 
-	if err := h.templates.RenderProductFormPage(c.Writer, params); err != nil {
-		h.logger.Errorf("Failed to render product template: %v", err)
+	revisions, err := h.fetchProductRevisions(c.Request.Context(), int32(product.ID))
+	if err != nil {
+		h.logger.Errorf("Failed to get product revisions: %v", err)
+		params.Error = "Не удалось загрузить историю изменений"
+	}
+	params.Revisions = revisions
+
+	if err := h.templates.RenderProductHistoryPage(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render product history template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+func (h *Handler) fetchProductRevisions(ctx context.Context, productID int32) ([]admin_templates.ProductRevision, error) {
+	resp, err := h.productAPI.GetProductRevisionsWithResponse(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product revisions: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on product revisions", resp.StatusCode())
+	}
+
+	revisions := make([]admin_templates.ProductRevision, len(*resp.JSON200))
+	for i, revision := range *resp.JSON200 {
+		revisions[i] = admin_templates.ProductRevision{
+			ID:        int(revision.Id),
+			UserID:    revision.UserId,
+			Action:    string(revision.Action),
+			CreatedAt: revision.CreatedAt,
+		}
+		if revision.Before != nil {
+			revisions[i].Before = productFromAPI(revision.Before)
+		}
+		if revision.After != nil {
+			revisions[i].After = productFromAPI(revision.After)
+		}
+	}
+	return revisions, nil
+}
+
+func (h *Handler) productRestoreRevision(c *gin.Context) {
+	productIDInt, err := h.validateProductID(c)
+	if err != nil {
+		h.logger.Errorf("Product ID validation failed: %v", err)
 		c.Redirect(http.StatusFound, ProductsPath)
 		return
 	}
+
+	historyPath := fmt.Sprintf(ProductHistoryPathFormat, productIDInt)
+	revisionID, err := strconv.ParseInt(c.Param("revisionID"), 10, 32)
+	if err != nil {
+		c.Redirect(http.StatusFound, historyPath+"?error="+url.QueryEscape("Некорректная ревизия"))
+		return
+	}
+	version, err := strconv.ParseInt(c.PostForm("version"), 10, 32)
+	if err != nil {
+		c.Redirect(http.StatusFound, historyPath+"?error="+url.QueryEscape("Некорректная версия товара"))
+		return
+	}
+
+	body, err := json.Marshal(map[string]int64{"version": version})
+	if err != nil {
+		h.logger.Errorf("Failed to encode restore request: %v", err)
+		c.Redirect(http.StatusFound, historyPath)
+		return
+	}
+
+	req, err := http.NewRequestWithContext(
+		c.Request.Context(),
+		http.MethodPost,
+		fmt.Sprintf("%s/products/%d/revisions/%d/restore", h.productServiceUrl, productIDInt, revisionID),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		h.logger.Errorf("Failed to create request: %v", err)
+		c.Redirect(http.StatusFound, historyPath)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-KEY", h.productServiceAPIKey)
+	req.Header.Set("X-User-ID", strconv.FormatInt(h.currentUserID(c), 10))
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		h.logger.Errorf("Failed to restore product revision: %v", err)
+		c.Redirect(http.StatusFound, historyPath+"?error="+url.QueryEscape("Сервис товаров недоступен"))
+		return
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		c.Redirect(http.StatusFound, historyPath)
+	case http.StatusConflict:
+		c.Redirect(http.StatusFound, historyPath+"?error="+url.QueryEscape("Товар был изменён другим пользователем, обновите страницу"))
+	default:
+		h.logger.Errorf("Product service returned status %d on restore", resp.StatusCode)
+		c.Redirect(http.StatusFound, historyPath+"?error="+url.QueryEscape("Не удалось откатить изменения"))
+	}
 }
 
 func (h *Handler) adminIndex(c *gin.Context) {
@@ -559,6 +903,7 @@ func (h *Handler) authMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		c.Set(userIDContextKey, resp.UserId)
 		c.Next()
 	}
 }
@@ -568,55 +913,129 @@ func (h *Handler) productsIndex(c *gin.Context) {
 		BaseParams: admin_templates.BaseParams{
 			Title: "Товары",
 		},
+		Status: c.Query("status"),
+		Error:  c.Query("error"),
 	}
 
-	req, err := http.NewRequest(http.MethodGet, h.productServiceUrl+"/products", nil)
-	if err != nil {
-		h.logger.Errorf("Failed to create request: %v", err)
-		params.Error = "Не удалось загрузить список товаров"
-		h.renderProductsIndex(c, params)
-		return
+	listQuery := url.Values{}
+	if params.Status != "" {
+		listQuery.Set("status", params.Status)
 	}
-	req.Header.Set("X-API-KEY", h.productServiceAPIKey)
+	if params.Search = strings.TrimSpace(c.Query("q")); params.Search != "" {
+		listQuery.Set("q", params.Search)
+	}
+	sort, order := c.Query("sort"), c.Query("order")
+	if sort != "" {
+		listQuery.Set("sort", sort)
+	}
+	if order != "" {
+		listQuery.Set("order", order)
+	}
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	params.SortLinks = productSortLinks(listQuery, sort, order)
 
-	resp, err := h.httpClient.Do(req)
-	if err != nil {
-		h.logger.Errorf("Failed to fetch products: %v", err)
-		params.Error = "Не удалось загрузить список товаров"
-		h.renderProductsIndex(c, params)
-		return
+	filter := &pb.ProductFilter{}
+	for _, status := range strings.Split(params.Status, ",") {
+		if status = strings.TrimSpace(status); status != "" {
+			filter.Statuses = append(filter.Statuses, status)
+		}
 	}
-	defer resp.Body.Close()
+	limit, offset := int32(productsPageSize), int32((page-1)*productsPageSize)
 
-	if resp.StatusCode != http.StatusOK {
-		h.logger.Errorf("Product service returned non-200 status: %d", resp.StatusCode)
-		params.Error = "Сервис товаров временно недоступен"
-		h.renderProductsIndex(c, params)
-		return
+	var total int32
+	if params.Search != "" {
+		searchPage, err := h.products.SearchProducts(c.Request.Context(), &pb.SearchProductsRequest{Query: params.Search, Filter: filter, Limit: limit, Offset: offset})
+		if err != nil {
+			h.logger.Errorf("Failed to search products: %v", err)
+			params.Error = "Не удалось загрузить список товаров"
+			h.renderProductsIndex(c, params)
+			return
+		}
+		for _, result := range searchPage.Items {
+			params.Products = append(params.Products, productFromProto(result.Product))
+		}
+		total = searchPage.Total
+	} else {
+		productPage, err := h.products.ListProducts(c.Request.Context(), &pb.ListProductsRequest{Filter: filter, Sort: sort, Desc: order == "desc", Limit: limit, Offset: offset})
+		if err != nil {
+			h.logger.Errorf("Failed to fetch products: %v", err)
+			params.Error = "Не удалось загрузить список товаров"
+			h.renderProductsIndex(c, params)
+			return
+		}
+		for _, product := range productPage.Items {
+			params.Products = append(params.Products, productFromProto(product))
+		}
+		total = productPage.Total
 	}
 
-	var apiProducts []admin_templates.Product
-	if err := json.NewDecoder(resp.Body).Decode(&apiProducts); err != nil {
-		h.logger.Errorf("Failed to decode products response: %v", err)
-		params.Error = "Ошибка при обработке данных"
-		h.renderProductsIndex(c, params)
-		return
+	params.Pagination = productsPagination(listQuery, page, int(total))
+	h.renderProductsIndex(c, params)
+}
+
+func productsIndexURL(values url.Values) string {
+	if len(values) == 0 {
+		return ProductsPath
 	}
+	return ProductsPath + "?" + values.Encode()
+}
+
+// productSortLinks строит ссылки сортировки по колонкам; повторный клик меняет направление
+func productSortLinks(listQuery url.Values, sort, order string) map[string]admin_templates.SortLink {
+	links := make(map[string]admin_templates.SortLink, len(productSortFields))
+	for _, field := range productSortFields {
+		values := url.Values{}
+		for key, v := range listQuery {
+			values[key] = v
+		}
+		active := sort == field
+		desc := active && order == "desc"
+
+		values.Set("sort", field)
+		if active && !desc {
+			values.Set("order", "desc")
+		} else {
+			values.Del("order")
+		}
 
-	products := make([]admin_templates.Product, len(apiProducts))
-	for i, p := range apiProducts {
-		products[i] = admin_templates.Product{
-			ID:          p.ID,
-			Name:        p.Name,
-			Slug:        p.Slug,
-			Price:       p.Price,
-			Description: p.Description,
-			ImageURL:    p.ImageURL,
+		links[field] = admin_templates.SortLink{
+			URL:    productsIndexURL(values),
+			Active: active,
+			Desc:   desc,
 		}
 	}
+	return links
+}
 
-	params.Products = products
-	h.renderProductsIndex(c, params)
+func productsPagination(listQuery url.Values, page, total int) admin_templates.Pagination {
+	pagination := admin_templates.Pagination{
+		Page:       page,
+		TotalPages: (total + productsPageSize - 1) / productsPageSize,
+		Total:      total,
+		Offset:     (page - 1) * productsPageSize,
+	}
+
+	pageURL := func(page int) string {
+		values := url.Values{}
+		for key, v := range listQuery {
+			values[key] = v
+		}
+		if page > 1 {
+			values.Set("page", strconv.Itoa(page))
+		}
+		return productsIndexURL(values)
+	}
+
+	if page > 1 {
+		pagination.PrevURL = pageURL(page - 1)
+	}
+	if page < pagination.TotalPages {
+		pagination.NextURL = pageURL(page + 1)
+	}
+	return pagination
 }
 
 func (h *Handler) renderProductsIndex(c *gin.Context, params admin_templates.ProductsIndexParams) {
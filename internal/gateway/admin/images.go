@@ -0,0 +1,228 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Nzyazin/zadnik.store/internal/broker"
+	"github.com/gin-gonic/gin"
+)
+
+const maxImagesPerUpload = 20
+
+type imageArrangement struct {
+	ID        int32  `json:"id"`
+	Alt       string `json:"alt"`
+	Position  int32  `json:"position"`
+	IsPrimary bool   `json:"is_primary"`
+}
+
+// formImages возвращает файлы из поля images формы товара
+func formImages(c *gin.Context) ([]*multipart.FileHeader, error) {
+	form, err := c.MultipartForm()
+	if errors.Is(err, http.ErrNotMultipart) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse form: %w", err)
+	}
+
+	files := []*multipart.FileHeader{}
+	for _, file := range form.File["images"] {
+		if file.Size > 0 {
+			files = append(files, file)
+		}
+	}
+	if len(files) > maxImagesPerUpload {
+		return nil, fmt.Errorf("no more than %d images at once", maxImagesPerUpload)
+	}
+	return files, nil
+}
+
+func readImage(file *multipart.FileHeader) ([]byte, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open image %s: %w", file.Filename, err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image %s: %w", file.Filename, err)
+	}
+	return data, nil
+}
+
+// publishImageUploads отправляет каждое изображение в сервис изображений отдельным событием;
+// в галерею товара они попадают после обработки
+func (h *Handler) publishImageUploads(c *gin.Context, productID int32, files []*multipart.FileHeader, alt string) error {
+	for _, file := range files {
+		data, err := readImage(file)
+		if err != nil {
+			return err
+		}
+		if err := h.publishImage(c, productID, file.Filename, data, alt); err != nil {
+			return err
+		}
+	}
+
+	h.logger.Infof("Successfully published %d image events for product ID: %d", len(files), productID)
+	return nil
+}
+
+func (h *Handler) publishImage(c *gin.Context, productID int32, filename string, data []byte, alt string) error {
+	imageEvent := &broker.ImageEvent{
+		EventType: broker.EventTypeImageUploaded,
+		ProductID: productID,
+		ImageData: data,
+		Filename:  filename,
+		Alt:       alt,
+		UserID:    h.currentUserID(c),
+	}
+	if err := h.messageBroker.PublishImage(c.Request.Context(), broker.ImageExchange, imageEvent); err != nil {
+		h.logger.Errorf("Failed to publish image event: %v", err)
+		return fmt.Errorf("failed to publish image event: %v", err)
+	}
+	return nil
+}
+
+// productImagesUpdate сохраняет порядок, подписи и основное изображение галереи и загружает выбранные файлы
+func (h *Handler) productImagesUpdate(c *gin.Context) {
+	if !h.checkAuth(c) {
+		return
+	}
+
+	productIDInt, err := h.validateProductID(c)
+	if err != nil {
+		h.logger.Errorf("Product ID validation failed: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+	productIDStr := strconv.FormatInt(productIDInt, 10)
+
+	images, err := parseImagesForm(c)
+	if err != nil {
+		h.redirectWithError(c, productIDStr, "Invalid gallery: "+err.Error())
+		return
+	}
+
+	if len(images) > 0 {
+		resp, err := h.productServiceRequest(c.Request.Context(), http.MethodPut, fmt.Sprintf("/products/%d/images", productIDInt),
+			map[string][]imageArrangement{"images": images})
+		if err != nil {
+			h.logger.Errorf("Failed to arrange images of product %d: %v", productIDInt, err)
+			h.redirectWithError(c, productIDStr, "Failed to save gallery")
+			return
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			h.logger.Errorf("Product service returned status %d on arrange images", resp.StatusCode)
+			h.redirectWithError(c, productIDStr, "Failed to save gallery")
+			return
+		}
+	}
+
+	files, err := formImages(c)
+	if err != nil {
+		h.redirectWithError(c, productIDStr, err.Error())
+		return
+	}
+	if err := h.publishImageUploads(c, int32(productIDInt), files, c.PostForm("name")); err != nil {
+		h.redirectWithError(c, productIDStr, err.Error())
+		return
+	}
+
+	c.Redirect(http.StatusFound, fmt.Sprintf(ProductEditPathFormat, productIDInt))
+}
+
+// parseImagesForm собирает раскладку галереи из параллельных полей image_id, image_alt, image_position
+func parseImagesForm(c *gin.Context) ([]imageArrangement, error) {
+	ids := c.PostFormArray("image_id")
+	alts := c.PostFormArray("image_alt")
+	positions := c.PostFormArray("image_position")
+	if len(alts) != len(ids) || len(positions) != len(ids) {
+		return nil, fmt.Errorf("malformed gallery form")
+	}
+	primary := c.PostForm("image_primary")
+
+	images := make([]imageArrangement, len(ids))
+	for i, value := range ids {
+		id, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid image id %q", value)
+		}
+		position, err := strconv.ParseInt(strings.TrimSpace(positions[i]), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid position %q", positions[i])
+		}
+		images[i] = imageArrangement{
+			ID:        int32(id),
+			Alt:       strings.TrimSpace(alts[i]),
+			Position:  int32(position),
+			IsPrimary: value == primary,
+		}
+	}
+	return images, nil
+}
+
+// productImageDelete просит сервис изображений удалить файл; запись галереи удалит сервис товаров по событию image.removed
+func (h *Handler) productImageDelete(c *gin.Context) {
+	if !h.checkAuth(c) {
+		return
+	}
+
+	productIDInt, err := h.validateProductID(c)
+	if err != nil {
+		h.logger.Errorf("Product ID validation failed: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+	productIDStr := strconv.FormatInt(productIDInt, 10)
+
+	imageID, err := strconv.ParseInt(c.Param("imageID"), 10, 32)
+	if err != nil {
+		h.redirectWithError(c, productIDStr, "Invalid image ID")
+		return
+	}
+
+	product, err := h.fetchProduct(c.Request.Context(), productIDStr)
+	if err != nil {
+		h.logger.Errorf("Failed to get product for image delete: %v", err)
+		h.redirectWithError(c, productIDStr, "Failed to load product")
+		return
+	}
+
+	imageURL := ""
+	for _, image := range product.Images {
+		if image.ID == int32(imageID) {
+			imageURL = image.URL
+			break
+		}
+	}
+	if imageURL == "" {
+		h.redirectWithError(c, productIDStr, "Image not found")
+		return
+	}
+
+	imageEvent := &broker.ImageEvent{
+		EventType: broker.EventTypeImageRemoving,
+		ProductID: int32(productIDInt),
+		ImageID:   int32(imageID),
+		ImageURL:  imageURL,
+		UserID:    h.currentUserID(c),
+	}
+	if err := h.messageBroker.PublishImage(c.Request.Context(), broker.ImageExchange, imageEvent); err != nil {
+		h.logger.Errorf("Failed to publish image removing event: %v", err)
+		h.redirectWithError(c, productIDStr, "Failed to delete image")
+		return
+	}
+
+	h.logger.Infof("Published removing event for image %d of product %d", imageID, productIDInt)
+	c.Redirect(http.StatusFound, fmt.Sprintf(ProductEditPathFormat, productIDInt))
+}
@@ -0,0 +1,406 @@
+package admin
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	admin_templates "github.com/Nzyazin/zadnik.store/internal/templates/admin-templates"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	ProductsImportPath = "/admin/products/import"
+
+	// importTTL - сколько хранятся загруженные файлы между проверкой и применением
+	importTTL           = time.Hour
+	maxImportUploadSize = 200 << 20
+	// maxImportImageSize ограничивает одну картинку в архиве: размер из заголовка zip ничем не гарантирован
+	maxImportImageSize = 20 << 20
+	importImagesFile   = "images.zip"
+	importCatalogFile  = "catalog"
+)
+
+var importTokenPattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// errImportRejected - сервис товаров нашёл строки с ошибками, отчёт возвращается вместе с ошибкой
+var errImportRejected = errors.New("import has invalid rows")
+
+func importRoot() string {
+	return filepath.Join(os.TempDir(), "zadnik-import")
+}
+
+func (h *Handler) productsImportPage(c *gin.Context) {
+	h.renderProductsImport(c, admin_templates.ProductsImportParams{Error: c.Query("error")})
+}
+
+// productsImportUpload сохраняет таблицу и архив изображений и показывает предварительную проверку
+func (h *Handler) productsImportUpload(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportUploadSize)
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		h.renderProductsImport(c, admin_templates.ProductsImportParams{Error: "Выберите файл CSV или XLSX"})
+		return
+	}
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if ext != ".csv" && ext != ".xlsx" {
+		h.renderProductsImport(c, admin_templates.ProductsImportParams{Error: "Поддерживаются только файлы CSV и XLSX"})
+		return
+	}
+
+	h.cleanupImports()
+
+	token, err := newRandomToken()
+	if err != nil {
+		h.logger.Errorf("Failed to create import token: %v", err)
+		h.renderProductsImport(c, admin_templates.ProductsImportParams{Error: "Не удалось сохранить файл"})
+		return
+	}
+	dir := filepath.Join(importRoot(), token)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		h.logger.Errorf("Failed to create import dir: %v", err)
+		h.renderProductsImport(c, admin_templates.ProductsImportParams{Error: "Не удалось сохранить файл"})
+		return
+	}
+
+	params := admin_templates.ProductsImportParams{Token: token, FileName: file.Filename}
+	if err := c.SaveUploadedFile(file, filepath.Join(dir, importCatalogFile+ext)); err != nil {
+		h.logger.Errorf("Failed to save import file: %v", err)
+		h.discardImport(c, dir, "Не удалось сохранить файл")
+		return
+	}
+
+	if images, err := c.FormFile("images"); err == nil && images.Size > 0 {
+		if strings.ToLower(filepath.Ext(images.Filename)) != ".zip" {
+			h.discardImport(c, dir, "Изображения загружаются ZIP-архивом")
+			return
+		}
+		if err := c.SaveUploadedFile(images, filepath.Join(dir, importImagesFile)); err != nil {
+			h.logger.Errorf("Failed to save import images: %v", err)
+			h.discardImport(c, dir, "Не удалось сохранить архив изображений")
+			return
+		}
+		params.ImagesName = images.Filename
+	}
+
+	report, err := h.sendCatalogImport(c, dir, false)
+	if err != nil && !errors.Is(err, errImportRejected) {
+		h.discardImport(c, dir, err.Error())
+		return
+	}
+	params.Report = report
+	h.renderProductsImport(c, params)
+}
+
+// productsImportApply применяет проверенный импорт и загружает изображения из архива созданным и изменённым товарам
+func (h *Handler) productsImportApply(c *gin.Context) {
+	token := c.PostForm("token")
+	if !importTokenPattern.MatchString(token) {
+		c.Redirect(http.StatusFound, ProductsImportPath)
+		return
+	}
+	dir := filepath.Join(importRoot(), token)
+	if _, err := os.Stat(dir); err != nil {
+		c.Redirect(http.StatusFound, ProductsImportPath+"?error="+url.QueryEscape("Загрузка устарела, загрузите файл снова"))
+		return
+	}
+
+	report, err := h.sendCatalogImport(c, dir, true)
+	if errors.Is(err, errImportRejected) {
+		h.renderProductsImport(c, admin_templates.ProductsImportParams{
+			Report: report,
+			Token:  token,
+			Error:  "Импорт не применён: в файле есть строки с ошибками",
+		})
+		return
+	}
+	if err != nil {
+		h.renderProductsImport(c, admin_templates.ProductsImportParams{Token: token, Error: err.Error()})
+		return
+	}
+
+	params := admin_templates.ProductsImportParams{Report: report}
+	if err := h.publishImportImages(c, dir, report); err != nil {
+		h.logger.Errorf("Failed to upload import images: %v", err)
+		params.Error = "Товары сохранены, но не все изображения загружены: " + err.Error()
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		h.logger.Errorf("Failed to remove import dir: %v", err)
+	}
+
+	h.renderProductsImport(c, params)
+}
+
+// productsExport отдаёт выгрузку каталога из сервиса товаров
+func (h *Handler) productsExport(c *gin.Context) {
+	format := productapi.ExportCatalogParamsFormat(c.DefaultQuery("format", "csv"))
+	// выгрузка отдаётся потоком, поэтому без разбора ответа в ExportCatalogWithResponse
+	resp, err := h.catalogAPI.ExportCatalog(c.Request.Context(), &productapi.ExportCatalogParams{Format: &format})
+	if err != nil {
+		h.logger.Errorf("Failed to export products: %v", err)
+		c.Redirect(http.StatusFound, ProductsImportPath+"?error="+url.QueryEscape("Сервис товаров недоступен"))
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		h.logger.Errorf("Product service returned status %d on export", resp.StatusCode)
+		c.Redirect(http.StatusFound, ProductsImportPath+"?error="+url.QueryEscape("Не удалось выгрузить каталог"))
+		return
+	}
+
+	c.Header("Content-Type", resp.Header.Get("Content-Type"))
+	c.Header("Content-Disposition", resp.Header.Get("Content-Disposition"))
+	c.Status(http.StatusOK)
+	if _, err := io.Copy(c.Writer, resp.Body); err != nil {
+		h.logger.Errorf("Failed to write export: %v", err)
+	}
+}
+
+// sendCatalogImport отправляет сохранённые файлы в сервис товаров; apply == false - только проверка
+func (h *Handler) sendCatalogImport(c *gin.Context, dir string, apply bool) (*admin_templates.ImportReport, error) {
+	catalogs, _ := filepath.Glob(filepath.Join(dir, importCatalogFile+".*"))
+	if len(catalogs) == 0 {
+		return nil, errors.New("Загрузка устарела, загрузите файл снова")
+	}
+	data, err := os.ReadFile(catalogs[0])
+	if err != nil {
+		h.logger.Errorf("Failed to read import file: %v", err)
+		return nil, errors.New("Не удалось прочитать файл")
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", filepath.Base(catalogs[0]))
+	if err == nil {
+		_, err = part.Write(data)
+	}
+	if err != nil {
+		h.logger.Errorf("Failed to build import request: %v", err)
+		return nil, errors.New("Не удалось отправить файл")
+	}
+
+	imagesPath := filepath.Join(dir, importImagesFile)
+	if _, err := os.Stat(imagesPath); err == nil {
+		names, err := zipImageNames(imagesPath)
+		if err != nil {
+			h.logger.Errorf("Failed to read import images: %v", err)
+			return nil, errors.New("Не удалось прочитать архив изображений")
+		}
+		writer.WriteField("has_images", "1")
+		for _, name := range names {
+			writer.WriteField("image_names", name)
+		}
+	}
+	if apply {
+		writer.WriteField("apply", "1")
+	}
+	if err := writer.Close(); err != nil {
+		h.logger.Errorf("Failed to build import request: %v", err)
+		return nil, errors.New("Не удалось отправить файл")
+	}
+
+	resp, err := h.catalogAPI.ImportCatalogWithBodyWithResponse(c.Request.Context(), writer.FormDataContentType(), &body, h.asCurrentUser(c))
+	if err != nil {
+		h.logger.Errorf("Failed to send import request: %v", err)
+		return nil, errors.New("Сервис товаров недоступен")
+	}
+
+	switch {
+	case resp.JSON200 != nil:
+		return importReportFromAPI(resp.JSON200), nil
+	case resp.JSON422 != nil:
+		return importReportFromAPI(resp.JSON422), errImportRejected
+	case resp.StatusCode() == http.StatusBadRequest:
+		return nil, fmt.Errorf("Файл не принят: %s", productServiceErrorText(resp.Body))
+	default:
+		h.logger.Errorf("Product service returned status %d on import", resp.StatusCode())
+		return nil, errors.New("Не удалось выполнить импорт")
+	}
+}
+
+func importReportFromAPI(r *productapi.ImportReport) *admin_templates.ImportReport {
+	report := &admin_templates.ImportReport{
+		Created: r.Created,
+		Updated: r.Updated,
+		Skipped: r.Skipped,
+		Failed:  r.Failed,
+		Applied: r.Applied,
+	}
+	for _, row := range sliceValue(r.Rows) {
+		var productID int32
+		if row.ProductId != nil {
+			productID = *row.ProductId
+		}
+		var slug string
+		if row.Slug != nil {
+			slug = *row.Slug
+		}
+		report.Rows = append(report.Rows, admin_templates.ImportRow{
+			Line:      row.Line,
+			Action:    string(row.Action),
+			ProductID: productID,
+			Name:      row.Name,
+			Slug:      slug,
+			Changes:   sliceValue(row.Changes),
+			Errors:    sliceValue(row.Errors),
+			Images:    sliceValue(row.Images),
+		})
+	}
+	return report
+}
+
+// publishImportImages отправляет в сервис изображений файлы архива, указанные в строках отчёта
+func (h *Handler) publishImportImages(c *gin.Context, dir string, report *admin_templates.ImportReport) error {
+	archive, err := zip.OpenReader(filepath.Join(dir, importImagesFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open images archive: %w", err)
+	}
+	defer archive.Close()
+
+	files := make(map[string]*zip.File)
+	for _, file := range archive.File {
+		if name, ok := zipImageName(file); ok {
+			if _, exists := files[name]; !exists {
+				files[name] = file
+			}
+		}
+	}
+
+	var failed []string
+	for _, row := range report.Rows {
+		if row.ProductID == 0 || row.Action == "error" {
+			continue
+		}
+		for _, name := range row.Images {
+			file, ok := files[name]
+			if !ok {
+				failed = append(failed, name)
+				continue
+			}
+			data, err := readZipFile(file)
+			if err == nil {
+				err = h.publishImage(c, row.ProductID, name, data, row.Name)
+			}
+			if err != nil {
+				h.logger.Errorf("Failed to upload image %s for product %d: %v", name, row.ProductID, err)
+				failed = append(failed, name)
+			}
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func (h *Handler) renderProductsImport(c *gin.Context, params admin_templates.ProductsImportParams) {
+	params.Title = "Импорт и экспорт товаров"
+	if err := h.templates.RenderProductsImport(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render products import template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+func (h *Handler) discardImport(c *gin.Context, dir, message string) {
+	if err := os.RemoveAll(dir); err != nil {
+		h.logger.Errorf("Failed to remove import dir: %v", err)
+	}
+	h.renderProductsImport(c, admin_templates.ProductsImportParams{Error: message})
+}
+
+// cleanupImports удаляет загрузки, которые так и не применили
+func (h *Handler) cleanupImports() {
+	entries, err := os.ReadDir(importRoot())
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < importTTL {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(importRoot(), entry.Name())); err != nil {
+			h.logger.Errorf("Failed to remove stale import %s: %v", entry.Name(), err)
+		}
+	}
+}
+
+func newRandomToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// zipImageNames возвращает имена файлов архива без каталогов; в таблице изображения указываются по ним
+func zipImageNames(archivePath string) ([]string, error) {
+	archive, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, file := range archive.File {
+		name, ok := zipImageName(file)
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// zipImageName пропускает каталоги и служебные файлы, которые добавляет macOS
+func zipImageName(file *zip.File) (string, bool) {
+	if file.FileInfo().IsDir() || strings.HasPrefix(file.Name, "__MACOSX/") {
+		return "", false
+	}
+	name := path.Base(file.Name)
+	if strings.HasPrefix(name, ".") {
+		return "", false
+	}
+	return name, true
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	if file.UncompressedSize64 > maxImportImageSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", file.Name, maxImportImageSize)
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(reader, maxImportImageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+	if len(data) > maxImportImageSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", file.Name, maxImportImageSize)
+	}
+	return data, nil
+}
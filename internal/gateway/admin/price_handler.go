@@ -0,0 +1,176 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	"github.com/Nzyazin/zadnik.store/internal/gateway/product"
+	admin_templates "github.com/Nzyazin/zadnik.store/internal/templates/admin-templates"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	ProductPricesPathFormat = "/admin/products/%d/prices"
+	// priceFormTimeLayout - формат поля datetime-local
+	priceFormTimeLayout = "2006-01-02T15:04"
+)
+
+func (h *Handler) productPricesPage(c *gin.Context) {
+	productID := c.Param("id")
+
+	product, err := h.fetchProduct(c.Request.Context(), productID)
+	if err != nil {
+		h.logger.Errorf("Failed to get product: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+
+	params := admin_templates.ProductPricesPageParams{
+		BaseParams: admin_templates.BaseParams{
+			Title: "Цены - " + product.Name,
+		},
+		Product: product,
+		Error:   c.Query("error"),
+	}
+
+	if params.Changes, err = h.fetchPriceChanges(c.Request.Context(), int32(product.ID)); err != nil {
+		h.logger.Errorf("Failed to get price changes: %v", err)
+		params.Error = "Не удалось загрузить запланированные изменения"
+	}
+	if params.History, err = h.fetchPriceHistory(c.Request.Context(), int32(product.ID)); err != nil {
+		h.logger.Errorf("Failed to get price history: %v", err)
+		params.Error = "Не удалось загрузить историю цен"
+	}
+
+	if at := c.Query("at"); at != "" {
+		if _, err := time.Parse(time.DateOnly, at); err != nil {
+			params.Error = "Некорректная дата"
+		} else {
+			params.At = at
+			if params.PriceAt, err = h.fetchPriceAt(c.Request.Context(), int32(product.ID), at); err != nil {
+				h.logger.Errorf("Failed to get price at %s: %v", at, err)
+				params.Error = "Не удалось получить цену на дату"
+			}
+		}
+	}
+
+	if err := h.templates.RenderProductPricesPage(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render product prices template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+func (h *Handler) fetchPriceChanges(ctx context.Context, productID int32) ([]admin_templates.PriceChange, error) {
+	resp, err := h.productAPI.GetPriceChangesWithResponse(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price changes: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on price changes", resp.StatusCode())
+	}
+
+	changes := make([]admin_templates.PriceChange, len(*resp.JSON200))
+	for i, change := range *resp.JSON200 {
+		changes[i] = admin_templates.PriceChange{
+			ID:          change.Id,
+			Price:       product.Decimal(change.Price),
+			EffectiveAt: change.EffectiveAt,
+			Status:      string(change.Status),
+			Comment:     change.Comment,
+			UserID:      change.UserId,
+			CreatedAt:   change.CreatedAt,
+			AppliedAt:   change.AppliedAt,
+		}
+	}
+	return changes, nil
+}
+
+func (h *Handler) fetchPriceHistory(ctx context.Context, productID int32) ([]admin_templates.PriceHistoryEntry, error) {
+	resp, err := h.productAPI.GetPriceHistoryWithResponse(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price history: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on price history", resp.StatusCode())
+	}
+
+	history := make([]admin_templates.PriceHistoryEntry, len(*resp.JSON200))
+	for i, entry := range *resp.JSON200 {
+		history[i] = priceHistoryEntryFromAPI(entry)
+	}
+	return history, nil
+}
+
+// fetchPriceAt возвращает nil без ошибки, если на эту дату у товара ещё не было цены
+func (h *Handler) fetchPriceAt(ctx context.Context, productID int32, at string) (*admin_templates.PriceHistoryEntry, error) {
+	resp, err := h.productAPI.GetPriceAtWithResponse(ctx, productID, &productapi.GetPriceAtParams{At: &at})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price: %w", err)
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on price", resp.StatusCode())
+	}
+
+	entry := priceHistoryEntryFromAPI(*resp.JSON200)
+	return &entry, nil
+}
+
+func priceHistoryEntryFromAPI(entry productapi.PriceHistoryEntry) admin_templates.PriceHistoryEntry {
+	return admin_templates.PriceHistoryEntry{Price: product.Decimal(entry.Price), EffectiveFrom: entry.EffectiveFrom}
+}
+
+func (h *Handler) productPriceSchedule(c *gin.Context) {
+	productIDInt, err := h.validateProductID(c)
+	if err != nil {
+		h.logger.Errorf("Product ID validation failed: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+	pricesPath := fmt.Sprintf(ProductPricesPathFormat, productIDInt)
+
+	price, err := decimal.NewFromString(strings.ReplaceAll(strings.TrimSpace(c.PostForm("price")), ",", "."))
+	if err != nil || !price.IsPositive() {
+		c.Redirect(http.StatusFound, pricesPath+"?error="+url.QueryEscape("Некорректная цена"))
+		return
+	}
+	// администратор вводит время по часовому поясу магазина
+	effectiveAt, err := time.ParseInLocation(priceFormTimeLayout, c.PostForm("effective_at"), time.Local)
+	if err != nil {
+		c.Redirect(http.StatusFound, pricesPath+"?error="+url.QueryEscape("Некорректная дата"))
+		return
+	}
+
+	body := map[string]interface{}{
+		"price":        price,
+		"effective_at": effectiveAt,
+		"comment":      c.PostForm("comment"),
+	}
+	h.sendProductServiceChange(c, http.MethodPost, fmt.Sprintf("/products/%d/price-changes", productIDInt), body, http.StatusCreated, pricesPath, "Не удалось запланировать изменение цены")
+}
+
+func (h *Handler) productPriceCancel(c *gin.Context) {
+	productIDInt, err := h.validateProductID(c)
+	if err != nil {
+		h.logger.Errorf("Product ID validation failed: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+	pricesPath := fmt.Sprintf(ProductPricesPathFormat, productIDInt)
+
+	changeID, err := parseOptionalID(c.Param("changeID"))
+	if err != nil || changeID == nil {
+		c.Redirect(http.StatusFound, pricesPath)
+		return
+	}
+
+	h.sendProductServiceChange(c, http.MethodPost, fmt.Sprintf("/products/%d/price-changes/%d/cancel", productIDInt, *changeID), nil, http.StatusOK, pricesPath, "Не удалось отменить изменение цены")
+}
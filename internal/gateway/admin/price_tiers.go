@@ -0,0 +1,75 @@
+package admin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	"github.com/Nzyazin/zadnik.store/internal/broker"
+	admin_templates "github.com/Nzyazin/zadnik.store/internal/templates/admin-templates"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// parsePriceTiersForm собирает оптовые ступени из формы товара.
+// Возвращает nil, если таблицы ступеней в форме не было; строки без количества пропускаются
+func parsePriceTiersForm(c *gin.Context) ([]broker.ProductPriceTier, error) {
+	if c.PostForm("price_tiers_present") == "" {
+		return nil, nil
+	}
+
+	quantities := c.PostFormArray("tier_min_quantity")
+	prices := c.PostFormArray("tier_price")
+	if len(quantities) != len(prices) {
+		return nil, fmt.Errorf("price tier fields are misaligned")
+	}
+
+	tiers := []broker.ProductPriceTier{}
+	for i, value := range quantities {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		quantity, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || quantity < 1 {
+			return nil, fmt.Errorf("invalid quantity %q", value)
+		}
+		price, err := decimal.NewFromString(strings.ReplaceAll(strings.TrimSpace(prices[i]), ",", "."))
+		if err != nil || !price.IsPositive() {
+			return nil, fmt.Errorf("invalid price from %d pairs", quantity)
+		}
+
+		tiers = append(tiers, broker.ProductPriceTier{MinQuantity: int32(quantity), UnitPrice: price})
+	}
+	return tiers, nil
+}
+
+// priceTiersChanged сравнивает ступени из формы с сохранёнными, которые приходят отсортированными по порогу
+func priceTiersChanged(current []admin_templates.PriceTier, submitted []broker.ProductPriceTier) bool {
+	if len(current) != len(submitted) {
+		return true
+	}
+
+	stored := make(map[int32]decimal.Decimal, len(current))
+	for _, tier := range current {
+		stored[tier.MinQuantity] = tier.UnitPrice
+	}
+	for _, tier := range submitted {
+		price, ok := stored[tier.MinQuantity]
+		if !ok || !price.Equal(tier.UnitPrice) {
+			return true
+		}
+	}
+	return false
+}
+
+// priceTierInputs переводит оптовые цены из формы в тело запроса API товаров
+func priceTierInputs(tiers []broker.ProductPriceTier) []productapi.PriceTierInput {
+	inputs := make([]productapi.PriceTierInput, len(tiers))
+	for i, tier := range tiers {
+		inputs[i] = productapi.PriceTierInput{MinQuantity: tier.MinQuantity, UnitPrice: tier.UnitPrice.String()}
+	}
+	return inputs
+}
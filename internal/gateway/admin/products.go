@@ -0,0 +1,125 @@
+package admin
+
+import (
+	"database/sql"
+
+	pb "github.com/Nzyazin/zadnik.store/api/generated/product"
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	"github.com/Nzyazin/zadnik.store/internal/gateway/product"
+	admin_templates "github.com/Nzyazin/zadnik.store/internal/templates/admin-templates"
+)
+
+func productFromProto(p *pb.Product) admin_templates.Product {
+	out := admin_templates.Product{
+		ID:          int(p.Id),
+		Name:        p.Name,
+		Slug:        p.Slug,
+		Price:       product.Decimal(p.Price),
+		Description: p.Description,
+		ImageURL:    sql.NullString{String: p.ImageUrl, Valid: p.ImageUrl != ""},
+		Version:     p.Version,
+		Status:      p.Status,
+		CreatedAt:   p.CreatedAt.AsTime(),
+		TaxClass:    p.TaxClass,
+		Visibility:  p.Visibility,
+		Published:   p.Published,
+	}
+	if p.PublishAt != nil {
+		publishAt := p.PublishAt.AsTime()
+		out.PublishAt = &publishAt
+	}
+	if p.UnpublishAt != nil {
+		unpublishAt := p.UnpublishAt.AsTime()
+		out.UnpublishAt = &unpublishAt
+	}
+	if p.Pricing != nil {
+		out.Pricing = &admin_templates.Pricing{
+			Gross:   product.Decimal(p.Pricing.Gross),
+			Net:     product.Decimal(p.Pricing.Net),
+			VAT:     product.Decimal(p.Pricing.Vat),
+			VATRate: p.Pricing.VatRate,
+		}
+	}
+	for _, variant := range p.Variants {
+		out.Variants = append(out.Variants, admin_templates.ProductVariant{
+			ID:          variant.Id,
+			SKU:         variant.Sku,
+			Options:     variant.Options,
+			Price:       product.NullDecimal(variant.Price),
+			WeightGrams: variant.WeightGrams,
+			IsActive:    variant.GetIsActive(),
+		})
+	}
+	for _, image := range p.Images {
+		out.Images = append(out.Images, admin_templates.ProductImage{
+			ID:        image.Id,
+			URL:       image.Url,
+			Alt:       image.Alt,
+			Position:  image.Position,
+			IsPrimary: image.IsPrimary,
+		})
+	}
+	for _, tier := range p.PriceTiers {
+		out.PriceTiers = append(out.PriceTiers, admin_templates.PriceTier{MinQuantity: tier.MinQuantity, UnitPrice: product.Decimal(tier.UnitPrice)})
+	}
+	for _, attribute := range p.Attributes {
+		out.Attributes = append(out.Attributes, admin_templates.ProductAttributeValue{
+			AttributeID: attribute.AttributeId,
+			Number:      product.NullDecimal(attribute.Number),
+			Text:        attribute.Text,
+		})
+	}
+	return out
+}
+
+// productFromAPI переводит товар из ответа HTTP API, например снимок ревизии
+func productFromAPI(p *productapi.Product) *admin_templates.Product {
+	out := &admin_templates.Product{
+		ID:          int(p.Id),
+		Name:        p.Name,
+		Slug:        p.Slug,
+		Price:       product.Decimal(p.Price),
+		Description: p.Description,
+		ImageURL:    sql.NullString{String: p.ImageUrl.String, Valid: p.ImageUrl.Valid},
+		Version:     p.Version,
+		Status:      string(p.Status),
+		CreatedAt:   p.CreatedAt,
+		TaxClass:    string(p.TaxClass),
+		Visibility:  string(p.Visibility),
+		PublishAt:   p.PublishAt,
+		UnpublishAt: p.UnpublishAt,
+	}
+	if p.Variants != nil {
+		for _, variant := range *p.Variants {
+			out.Variants = append(out.Variants, admin_templates.ProductVariant{
+				ID:          variant.Id,
+				SKU:         variant.Sku,
+				Options:     mapValue(variant.Options),
+				Price:       product.NullDecimal(variant.Price),
+				WeightGrams: variant.WeightGrams,
+				IsActive:    variant.IsActive,
+			})
+		}
+	}
+	if p.PriceTiers != nil {
+		for _, tier := range *p.PriceTiers {
+			out.PriceTiers = append(out.PriceTiers, admin_templates.PriceTier{MinQuantity: tier.MinQuantity, UnitPrice: product.Decimal(tier.UnitPrice)})
+		}
+	}
+	return out
+}
+
+// sliceValue и mapValue разворачивают необязательные списки из ответов HTTP API
+func sliceValue[T any](values *[]T) []T {
+	if values == nil {
+		return nil
+	}
+	return *values
+}
+
+func mapValue(values *map[string]string) map[string]string {
+	if values == nil {
+		return nil
+	}
+	return *values
+}
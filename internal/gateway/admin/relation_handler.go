@@ -0,0 +1,109 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	admin_templates "github.com/Nzyazin/zadnik.store/internal/templates/admin-templates"
+	"github.com/gin-gonic/gin"
+)
+
+const ProductRelationsPathFormat = "/admin/products/%d/relations"
+
+func (h *Handler) productRelationsPage(c *gin.Context) {
+	productID := c.Param("id")
+
+	product, err := h.fetchProduct(c.Request.Context(), productID)
+	if err != nil {
+		h.logger.Errorf("Failed to get product: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+
+	params := admin_templates.ProductRelationsPageParams{
+		BaseParams: admin_templates.BaseParams{
+			Title: "Связи - " + product.Name,
+		},
+		Product: product,
+		Error:   c.Query("error"),
+	}
+
+	if params.Relations, err = h.fetchProductRelations(c.Request.Context(), int32(product.ID)); err != nil {
+		h.logger.Errorf("Failed to get product relations: %v", err)
+		params.Error = "Не удалось загрузить связанные товары"
+	}
+
+	if err := h.templates.RenderProductRelationsPage(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render product relations template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+func (h *Handler) fetchProductRelations(ctx context.Context, productID int32) ([]admin_templates.ProductRelation, error) {
+	resp, err := h.productAPI.GetProductRelationsWithResponse(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product relations: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on product relations", resp.StatusCode())
+	}
+
+	relations := make([]admin_templates.ProductRelation, len(*resp.JSON200))
+	for i, relation := range *resp.JSON200 {
+		relations[i] = admin_templates.ProductRelation{
+			RelatedID:  relation.RelatedId,
+			Kind:       string(relation.Kind),
+			Position:   relation.Position,
+			Name:       relation.Name,
+			Slug:       relation.Slug,
+			Status:     string(relation.Status),
+			Visibility: string(relation.Visibility),
+		}
+	}
+	return relations, nil
+}
+
+func (h *Handler) productRelationsUpdate(c *gin.Context) {
+	productIDInt, err := h.validateProductID(c)
+	if err != nil {
+		h.logger.Errorf("Product ID validation failed: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+	relationsPath := fmt.Sprintf(ProductRelationsPathFormat, productIDInt)
+
+	relations, err := parseRelationsForm(c)
+	if err != nil {
+		c.Redirect(http.StatusFound, relationsPath+"?error="+url.QueryEscape("Некорректный ID товара"))
+		return
+	}
+
+	body := map[string]interface{}{"relations": relations}
+	h.sendProductServiceChange(c, http.MethodPut, fmt.Sprintf("/products/%d/relations", productIDInt), body, http.StatusOK, relationsPath, "Не удалось сохранить связанные товары")
+}
+
+// parseRelationsForm собирает связи из таблицы формы; строки без ID пропускаются
+func parseRelationsForm(c *gin.Context) ([]map[string]interface{}, error) {
+	ids := c.PostFormArray("related_id")
+	kinds := c.PostFormArray("kind")
+	if len(ids) != len(kinds) {
+		return nil, fmt.Errorf("relation fields are misaligned")
+	}
+
+	relations := []map[string]interface{}{}
+	for i, value := range ids {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || id < 1 {
+			return nil, fmt.Errorf("invalid related product id %q", value)
+		}
+		relations = append(relations, map[string]interface{}{"related_id": id, "kind": kinds[i]})
+	}
+	return relations, nil
+}
@@ -0,0 +1,135 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	admin_templates "github.com/Nzyazin/zadnik.store/internal/templates/admin-templates"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	ReviewsPath     = "/admin/reviews"
+	reviewsPageSize = 20
+	// reviewStatusAll - вкладка со всеми отзывами; без параметра открывается очередь модерации
+	reviewStatusAll = "all"
+)
+
+func (h *Handler) reviewsIndex(c *gin.Context) {
+	status := c.DefaultQuery("status", "pending")
+	if status == reviewStatusAll {
+		status = ""
+	}
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	params := admin_templates.ReviewsIndexParams{
+		BaseParams: admin_templates.BaseParams{
+			Title: "Отзывы",
+		},
+		Status: status,
+		Error:  c.Query("error"),
+	}
+
+	reviews, err := h.fetchReviews(c.Request.Context(), status, page)
+	if err != nil {
+		h.logger.Errorf("Failed to get reviews: %v", err)
+		params.Error = "Не удалось загрузить отзывы"
+	}
+	params.Reviews = reviews.Items
+	params.Pagination = reviewsPagination(c.DefaultQuery("status", ""), page, reviews.Total)
+
+	if err := h.templates.RenderReviewsIndex(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render reviews template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+// fetchReviews возвращает страницу очереди отзывов; status == "" - все отзывы
+func (h *Handler) fetchReviews(ctx context.Context, status string, page int) (admin_templates.ReviewPage, error) {
+	limit, offset := reviewsPageSize, (page-1)*reviewsPageSize
+	query := &productapi.ListReviewsParams{Limit: &limit, Offset: &offset}
+	if status != "" {
+		reviewStatus := productapi.ReviewStatus(status)
+		query.Status = &reviewStatus
+	}
+
+	resp, err := h.productAPI.ListReviewsWithResponse(ctx, query)
+	if err != nil {
+		return admin_templates.ReviewPage{}, fmt.Errorf("failed to get reviews: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return admin_templates.ReviewPage{}, fmt.Errorf("product service returned status %d on reviews", resp.StatusCode())
+	}
+
+	reviews := admin_templates.ReviewPage{Total: resp.JSON200.Total}
+	for _, review := range sliceValue(resp.JSON200.Items) {
+		reviews.Items = append(reviews.Items, admin_templates.Review{
+			ID:          review.Id,
+			ProductID:   review.ProductId,
+			ProductName: review.ProductName,
+			AuthorName:  review.AuthorName,
+			Company:     review.Company,
+			Rating:      review.Rating,
+			Text:        review.Text,
+			Status:      string(review.Status),
+			Reply:       review.Reply,
+			RepliedAt:   review.RepliedAt,
+			ModeratedAt: review.ModeratedAt,
+			CreatedAt:   review.CreatedAt,
+		})
+	}
+	return reviews, nil
+}
+
+// reviewModerate публикует или отклоняет отзыв вместе с ответом магазина и возвращает к очереди модерации
+func (h *Handler) reviewModerate(c *gin.Context) {
+	reviewID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil || reviewID < 1 {
+		c.Redirect(http.StatusFound, ReviewsPath)
+		return
+	}
+
+	body := map[string]interface{}{
+		"status": c.PostForm("status"),
+		"reply":  c.PostForm("reply"),
+	}
+	h.sendProductServiceChange(c, http.MethodPut, fmt.Sprintf("/reviews/%d/moderation", reviewID), body, http.StatusOK, ReviewsPath, "Не удалось сохранить решение по отзыву")
+}
+
+func reviewsPagination(status string, page, total int) admin_templates.Pagination {
+	pagination := admin_templates.Pagination{
+		Page:       page,
+		TotalPages: (total + reviewsPageSize - 1) / reviewsPageSize,
+		Total:      total,
+		Offset:     (page - 1) * reviewsPageSize,
+	}
+
+	pageURL := func(page int) string {
+		values := url.Values{}
+		if status != "" {
+			values.Set("status", status)
+		}
+		if page > 1 {
+			values.Set("page", strconv.Itoa(page))
+		}
+		if len(values) == 0 {
+			return ReviewsPath
+		}
+		return ReviewsPath + "?" + values.Encode()
+	}
+
+	if page > 1 {
+		pagination.PrevURL = pageURL(page - 1)
+	}
+	if page < pagination.TotalPages {
+		pagination.NextURL = pageURL(page + 1)
+	}
+	return pagination
+}
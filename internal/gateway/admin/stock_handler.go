@@ -0,0 +1,227 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	admin_templates "github.com/Nzyazin/zadnik.store/internal/templates/admin-templates"
+	"github.com/gin-gonic/gin"
+)
+
+const ProductStockPathFormat = "/admin/products/%d/stock"
+
+func (h *Handler) productStockPage(c *gin.Context) {
+	productID := c.Param("id")
+
+	product, err := h.fetchProduct(c.Request.Context(), productID)
+	if err != nil {
+		h.logger.Errorf("Failed to get product: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+
+	params := admin_templates.ProductStockPageParams{
+		BaseParams: admin_templates.BaseParams{
+			Title: "Склад - " + product.Name,
+		},
+		Product: product,
+		Error:   c.Query("error"),
+	}
+
+	items, err := h.fetchStock(c.Request.Context(), int32(product.ID))
+	if err != nil {
+		h.logger.Errorf("Failed to get product stock: %v", err)
+		params.Error = "Не удалось загрузить остатки"
+	}
+	params.Rows = admin_templates.StockRows(product, items)
+
+	if params.Reservations, err = h.fetchStockReservations(c.Request.Context(), int32(product.ID)); err != nil {
+		h.logger.Errorf("Failed to get stock reservations: %v", err)
+		params.Error = "Не удалось загрузить резервы"
+	}
+
+	if params.Movements, err = h.fetchStockMovements(c.Request.Context(), int32(product.ID)); err != nil {
+		h.logger.Errorf("Failed to get stock movements: %v", err)
+		params.Error = "Не удалось загрузить журнал движений"
+	}
+
+	if err := h.templates.RenderProductStockPage(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render product stock template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+func (h *Handler) fetchStock(ctx context.Context, productID int32) ([]admin_templates.StockItem, error) {
+	resp, err := h.productAPI.GetStockWithResponse(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product stock: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on product stock", resp.StatusCode())
+	}
+
+	items := make([]admin_templates.StockItem, len(*resp.JSON200))
+	for i, item := range *resp.JSON200 {
+		items[i] = admin_templates.StockItem{
+			VariantID:         item.VariantId,
+			OnHand:            item.OnHand,
+			Reserved:          item.Reserved,
+			LowStockThreshold: item.LowStockThreshold,
+		}
+	}
+	return items, nil
+}
+
+func (h *Handler) fetchStockReservations(ctx context.Context, productID int32) ([]admin_templates.StockReservation, error) {
+	resp, err := h.productAPI.GetStockReservationsWithResponse(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock reservations: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on stock reservations", resp.StatusCode())
+	}
+
+	reservations := make([]admin_templates.StockReservation, len(*resp.JSON200))
+	for i, reservation := range *resp.JSON200 {
+		reservations[i] = admin_templates.StockReservation{
+			ID:        reservation.Id,
+			VariantID: reservation.VariantId,
+			Quantity:  reservation.Quantity,
+			Reference: reservation.Reference,
+			ExpiresAt: reservation.ExpiresAt,
+			CreatedAt: reservation.CreatedAt,
+		}
+	}
+	return reservations, nil
+}
+
+func (h *Handler) fetchStockMovements(ctx context.Context, productID int32) ([]admin_templates.StockMovement, error) {
+	resp, err := h.productAPI.GetStockMovementsWithResponse(ctx, productID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock movements: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on stock movements", resp.StatusCode())
+	}
+
+	movements := make([]admin_templates.StockMovement, len(*resp.JSON200))
+	for i, movement := range *resp.JSON200 {
+		movements[i] = admin_templates.StockMovement{
+			ID:            movement.Id,
+			VariantID:     movement.VariantId,
+			Kind:          string(movement.Kind),
+			Quantity:      movement.Quantity,
+			OnHandAfter:   movement.OnHandAfter,
+			ReservedAfter: movement.ReservedAfter,
+			Reference:     movement.Reference,
+			Comment:       movement.Comment,
+			UserID:        movement.UserId,
+			CreatedAt:     movement.CreatedAt,
+		}
+	}
+	return movements, nil
+}
+
+func (h *Handler) productStockMove(c *gin.Context) {
+	productIDInt, err := h.validateProductID(c)
+	if err != nil {
+		h.logger.Errorf("Product ID validation failed: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+	stockPath := fmt.Sprintf(ProductStockPathFormat, productIDInt)
+
+	variantID, err := parseOptionalID(c.PostForm("variant_id"))
+	if err != nil {
+		c.Redirect(http.StatusFound, stockPath+"?error="+url.QueryEscape("Некорректная позиция"))
+		return
+	}
+	quantity, err := strconv.ParseInt(strings.TrimSpace(c.PostForm("quantity")), 10, 32)
+	if err != nil {
+		c.Redirect(http.StatusFound, stockPath+"?error="+url.QueryEscape("Некорректное количество"))
+		return
+	}
+
+	body := map[string]interface{}{
+		"variant_id": variantID,
+		"kind":       c.PostForm("kind"),
+		"quantity":   quantity,
+		"reference":  c.PostForm("reference"),
+		"comment":    c.PostForm("comment"),
+	}
+	h.sendProductServiceChange(c, http.MethodPost, fmt.Sprintf("/products/%d/stock/movements", productIDInt), body, http.StatusCreated, stockPath, "Не удалось изменить остатки")
+}
+
+func (h *Handler) productStockThreshold(c *gin.Context) {
+	productIDInt, err := h.validateProductID(c)
+	if err != nil {
+		h.logger.Errorf("Product ID validation failed: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+	stockPath := fmt.Sprintf(ProductStockPathFormat, productIDInt)
+
+	variantID, err := parseOptionalID(c.PostForm("variant_id"))
+	if err != nil {
+		c.Redirect(http.StatusFound, stockPath+"?error="+url.QueryEscape("Некорректная позиция"))
+		return
+	}
+	threshold, err := strconv.ParseInt(strings.TrimSpace(c.PostForm("threshold")), 10, 32)
+	if err != nil || threshold < 0 {
+		c.Redirect(http.StatusFound, stockPath+"?error="+url.QueryEscape("Некорректный порог"))
+		return
+	}
+
+	body := map[string]interface{}{
+		"variant_id": variantID,
+		"threshold":  threshold,
+	}
+	h.sendProductServiceChange(c, http.MethodPut, fmt.Sprintf("/products/%d/stock/threshold", productIDInt), body, http.StatusOK, stockPath, "Не удалось изменить остатки")
+}
+
+// productStockReservation подтверждает или снимает резерв под заявку с сайта; action - confirm или release
+func (h *Handler) productStockReservation(c *gin.Context) {
+	productIDInt, err := h.validateProductID(c)
+	if err != nil {
+		h.logger.Errorf("Product ID validation failed: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+	stockPath := fmt.Sprintf(ProductStockPathFormat, productIDInt)
+
+	reservationID, err := parseOptionalID(c.Param("reservationID"))
+	if err != nil || reservationID == nil {
+		c.Redirect(http.StatusFound, stockPath)
+		return
+	}
+
+	action := c.Param("action")
+	errorMessage := "Не удалось подтвердить резерв"
+	switch action {
+	case "confirm":
+	case "release":
+		errorMessage = "Не удалось снять резерв"
+	default:
+		c.Redirect(http.StatusFound, stockPath)
+		return
+	}
+
+	h.sendProductServiceChange(c, http.MethodPost, fmt.Sprintf("/products/%d/stock/reservations/%d/%s", productIDInt, *reservationID, action), nil, http.StatusOK, stockPath, errorMessage)
+}
+
+// parseOptionalID разбирает необязательный идентификатор из формы; пустая строка - nil
+func parseOptionalID(value string) (*int32, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	result := int32(id)
+	return &result, nil
+}
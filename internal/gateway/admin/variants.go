@@ -0,0 +1,146 @@
+package admin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	"github.com/Nzyazin/zadnik.store/internal/broker"
+	admin_templates "github.com/Nzyazin/zadnik.store/internal/templates/admin-templates"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// parseVariantsForm собирает варианты из таблицы формы товара.
+// Возвращает nil, если таблицы вариантов в форме не было; строки без артикула пропускаются
+func parseVariantsForm(c *gin.Context) ([]broker.ProductVariant, error) {
+	if c.PostForm("variants_present") == "" {
+		return nil, nil
+	}
+
+	ids := c.PostFormArray("variant_id")
+	skus := c.PostFormArray("variant_sku")
+	options := c.PostFormArray("variant_options")
+	prices := c.PostFormArray("variant_price")
+	weights := c.PostFormArray("variant_weight")
+	actives := c.PostFormArray("variant_active")
+	if len(ids) != len(skus) || len(options) != len(skus) || len(prices) != len(skus) ||
+		len(weights) != len(skus) || len(actives) != len(skus) {
+		return nil, fmt.Errorf("variant fields are misaligned")
+	}
+
+	variants := []broker.ProductVariant{}
+	for i, sku := range skus {
+		sku = strings.TrimSpace(sku)
+		if sku == "" {
+			continue
+		}
+
+		variant := broker.ProductVariant{
+			SKU:      sku,
+			Options:  parseVariantOptions(options[i]),
+			IsActive: actives[i] != "0",
+		}
+
+		if ids[i] != "" {
+			id, err := strconv.ParseInt(ids[i], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid variant id %q: %w", ids[i], err)
+			}
+			variant.ID = int32(id)
+		}
+
+		if value := strings.TrimSpace(prices[i]); value != "" {
+			price, err := decimal.NewFromString(strings.ReplaceAll(value, ",", "."))
+			if err != nil || !price.IsPositive() {
+				return nil, fmt.Errorf("invalid price for variant %s", sku)
+			}
+			variant.Price = &price
+		}
+
+		if value := strings.TrimSpace(weights[i]); value != "" {
+			weight, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || weight < 0 {
+				return nil, fmt.Errorf("invalid weight for variant %s", sku)
+			}
+			grams := int32(weight)
+			variant.WeightGrams = &grams
+		}
+
+		variants = append(variants, variant)
+	}
+	return variants, nil
+}
+
+// parseVariantOptions разбирает строку "толщина: 1.5 мм; размер: 38"
+func parseVariantOptions(text string) map[string]string {
+	options := map[string]string{}
+	for _, part := range strings.Split(text, ";") {
+		name, value, ok := strings.Cut(part, ":")
+		if !ok {
+			continue
+		}
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if name != "" && value != "" {
+			options[name] = value
+		}
+	}
+	return options
+}
+
+// variantsChanged сравнивает варианты из формы с сохранёнными
+func variantsChanged(current []admin_templates.ProductVariant, submitted []broker.ProductVariant) bool {
+	if len(current) != len(submitted) {
+		return true
+	}
+
+	for i, variant := range submitted {
+		stored := current[i]
+		if variant.ID != stored.ID || variant.SKU != stored.SKU || variant.IsActive != stored.IsActive {
+			return true
+		}
+		if (variant.Price == nil) == stored.Price.Valid {
+			return true
+		}
+		if variant.Price != nil && !variant.Price.Equal(stored.Price.Decimal) {
+			return true
+		}
+		if (variant.WeightGrams == nil) != (stored.WeightGrams == nil) ||
+			(variant.WeightGrams != nil && *variant.WeightGrams != *stored.WeightGrams) {
+			return true
+		}
+		if len(variant.Options) != len(stored.Options) {
+			return true
+		}
+		for name, value := range variant.Options {
+			if stored.Options[name] != value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// variantInputs переводит варианты из формы в тело запроса API товаров
+func variantInputs(variants []broker.ProductVariant) []productapi.VariantInput {
+	inputs := make([]productapi.VariantInput, len(variants))
+	for i, variant := range variants {
+		options, isActive := variant.Options, variant.IsActive
+		inputs[i] = productapi.VariantInput{
+			Sku:         variant.SKU,
+			Options:     &options,
+			WeightGrams: variant.WeightGrams,
+			IsActive:    &isActive,
+		}
+		if variant.ID != 0 {
+			id := variant.ID
+			inputs[i].Id = &id
+		}
+		if variant.Price != nil {
+			price := variant.Price.String()
+			inputs[i].Price = &price
+		}
+	}
+	return inputs
+}
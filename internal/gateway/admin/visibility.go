@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// parseVisibilityForm читает состояние витрины и даты публикации из полей product-visibility-fields
+func parseVisibilityForm(c *gin.Context) (visibility string, publishAt, unpublishAt *time.Time, err error) {
+	visibility = c.PostForm("visibility")
+	if publishAt, err = parseFormTime(c.PostForm("publish_at")); err != nil {
+		return "", nil, nil, fmt.Errorf("некорректная дата публикации")
+	}
+	if unpublishAt, err = parseFormTime(c.PostForm("unpublish_at")); err != nil {
+		return "", nil, nil, fmt.Errorf("некорректная дата снятия с витрины")
+	}
+	return visibility, publishAt, unpublishAt, nil
+}
+
+// parseFormTime разбирает поле datetime-local по часовому поясу магазина; пустое поле - nil
+func parseFormTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.ParseInLocation(priceFormTimeLayout, value, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// productVisibilityUpdate публикует, скрывает или планирует товар со страницы редактирования или из списка
+func (h *Handler) productVisibilityUpdate(c *gin.Context) {
+	productIDInt, err := h.validateProductID(c)
+	if err != nil {
+		h.logger.Errorf("Product ID validation failed: %v", err)
+		c.Redirect(http.StatusFound, ProductsPath)
+		return
+	}
+	backPath := fmt.Sprintf(ProductEditPathFormat, productIDInt)
+	if c.PostForm("back") == "list" {
+		backPath = ProductsPath
+	}
+
+	version, err := strconv.ParseInt(c.PostForm("version"), 10, 32)
+	if err != nil {
+		c.Redirect(http.StatusFound, backPath)
+		return
+	}
+	visibility, publishAt, unpublishAt, err := parseVisibilityForm(c)
+	if err != nil {
+		c.Redirect(http.StatusFound, backPath+"?error="+url.QueryEscape(err.Error()))
+		return
+	}
+
+	body := map[string]interface{}{
+		"visibility":   visibility,
+		"publish_at":   publishAt,
+		"unpublish_at": unpublishAt,
+		"version":      version,
+	}
+	h.sendProductServiceChange(c, http.MethodPut, fmt.Sprintf("/products/%d/visibility", productIDInt), body, http.StatusOK, backPath, "Не удалось изменить видимость товара")
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"encoding/base64"
 	"fmt"
+	"github.com/Nzyazin/zadnik.store/internal/broker"
 	"github.com/Nzyazin/zadnik.store/internal/common"
 	"net/smtp"
 	"time"
@@ -27,15 +28,20 @@ func NewSMTPEmailSender(host string, port int, from string, password string, log
 	}
 }
 
-func (s *SMTPEmailSender) SendOrder(name, phone string) error {
+func (s *SMTPEmailSender) SendOrder(name, phone, item string) error {
 	subject := "Заказ задника для обуви"
+	if item == "" {
+		item = "не выбран"
+	}
 	body := fmt.Sprintf(
 		"Получена новая заявка:\n\n"+
 		"Имя: %s\n"+
 		"Телефон: %s\n"+
+		"Товар: %s\n"+
 		"Дата: %s\n",
 		name,
 		phone,
+		item,
 		time.Now().Format("2006-01-02 15:04:05"),
 	)
 
@@ -48,6 +54,37 @@ func (s *SMTPEmailSender) SendOrder(name, phone string) error {
 	return nil
 }
 
+// SendLowStock уведомляет администратора, что доступный остаток опустился до порога
+func (s *SMTPEmailSender) SendLowStock(event *broker.StockEvent) error {
+	name := event.Name
+	if event.SKU != "" {
+		name += ", артикул " + event.SKU
+	}
+	subject := "Заканчивается товар: " + name
+	body := fmt.Sprintf(
+		"Доступный остаток опустился до порога:\n\n"+
+		"Товар: %s\n"+
+		"На складе: %d\n"+
+		"В резерве: %d\n"+
+		"Доступно: %d\n"+
+		"Порог: %d\n"+
+		"Дата: %s\n",
+		name,
+		event.OnHand,
+		event.Reserved,
+		event.Available,
+		event.Threshold,
+		time.Now().Format("2006-01-02 15:04:05"),
+	)
+
+	if err := s.sendEmail(s.From, subject, body); err != nil {
+		return fmt.Errorf("failed to send low stock email: %v", err)
+	}
+
+	s.Logger.Infof("Low stock email for product %d sent to %s", event.ProductID, s.From)
+	return nil
+}
+
 func (s *SMTPEmailSender) sendEmail(email, subject, body string) error {
 	var message []byte
 	// Кодируем тему в Base64 для корректного отображения в почтовых клиентах
@@ -1,43 +1,100 @@
 package client
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
+	pb "github.com/Nzyazin/zadnik.store/api/generated/product"
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	"github.com/Nzyazin/zadnik.store/internal/gateway/product"
 	"github.com/Nzyazin/zadnik.store/internal/common"
 	client_templates "github.com/Nzyazin/zadnik.store/internal/templates/client-templates"
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+var (
+	errNotFound = errors.New("not found")
+	// errStockInsufficient - остатка не хватает на резерв, товар делают под заказ
+	errStockInsufficient = errors.New("insufficient stock")
+)
+
+const (
+	// maxOrderItemLength ограничивает описание выбранного варианта в заявке
+	maxOrderItemLength = 300
+	maxOrderQuantity = 100000
+	// ordersPerAddress ограничивает заявки с одного адреса: каждая держит остаток в резерве до решения менеджера
+	ordersPerAddress = 5
+	ordersWindow = time.Hour
+	// maxMetaDescriptionLength - длина description страницы товара, которую показывают в выдаче
+	maxMetaDescriptionLength = 160
+
+	// defaultSiteName - название витрины, когда SHOP_NAME не задан; совпадает с og:site_name
+	defaultSiteName = "задник-для-обуви.рф"
+	organizationPhone = "+79536799464"
+	logoPath = "/static/client/images/logo.png"
+	// defaultImagePath - картинка для соцсетей на страницах без своей
+	defaultImagePath = "/static/client/images/cap/zadnik-cap.png"
+	// recommendationsLimit - два ряда карточек под товаром
+	recommendationsLimit = 8
+)
+
+// Site - публичные данные витрины для канонических ссылок и разметки schema.org
+type Site struct {
+	// URL - адрес витрины без завершающего слеша
+	URL string
+	Name string
+}
+
 type EmailSender interface {
-	SendOrder(name, phone string) error
+	SendOrder(name, phone, item string) error
 }
 
 type Handler struct {
 	templates *client_templates.Templates
-	productServiceUrl string
-	productServiceAPIKey string
+	// products - gRPC API товаров; разделы, остатки и расчёт заявки - через HTTP-клиент из спецификации
+	products pb.ProductServiceClient
+	productAPI *productapi.ClientWithResponses
 	logger common.Logger
-	httpClient *http.Client
 	emailSender EmailSender
+	site Site
+	reviewLimiter *addressLimiter
+	orderLimiter *addressLimiter
+	// reviewKeySecret - секрет HMAC для ключа автора отзыва
+	reviewKeySecret []byte
 }
 
-func NewHandler(templates *client_templates.Templates, productServiceUrl string, productServiceAPIKey string, emailSender EmailSender) *Handler {
+func NewHandler(templates *client_templates.Templates, products pb.ProductServiceClient, productAPI *productapi.ClientWithResponses, emailSender EmailSender, site Site, reviewKeySecret string) *Handler {
+	if site.Name == "" {
+		site.Name = defaultSiteName
+	}
 	return &Handler{
 		templates: templates,
-		productServiceUrl: productServiceUrl,
-		productServiceAPIKey: productServiceAPIKey,
+		products: products,
+		productAPI: productAPI,
 		logger: common.NewSimpleLogger(),
-		httpClient: &http.Client{
-			Timeout: time.Second * 9,
-		},
 		emailSender: emailSender,
+		site: site,
+		reviewLimiter: newAddressLimiter(reviewsPerAddress, reviewsWindow),
+		orderLimiter: newAddressLimiter(ordersPerAddress, ordersWindow),
+		reviewKeySecret: []byte(reviewKeySecret),
 	}
 }
 
 func (h *Handler) RegisterRoutes(r *gin.Engine) {
 	r.GET("/", h.indexPage)
+	r.GET("/search", h.searchPage)
+	r.GET("/catalog/:slug", h.categoryPage)
+	r.GET("/products/:slug", h.productPage)
+	r.POST("/products/:slug/reviews", h.sendReview)
 	r.GET("/delivery", h.deliveryPage)
 	r.GET("/payment", h.paymentPage)
 	r.GET("/guarantee", h.guaranteePage)
@@ -48,6 +105,10 @@ func (h *Handler) RegisterRoutes(r *gin.Engine) {
 func (h *Handler) sendOrder(c *gin.Context) {
 	name := c.PostForm("name")
 	phone := c.PostForm("phone")
+	item := c.PostForm("item")
+	if len([]rune(item)) > maxOrderItemLength {
+		item = string([]rune(item)[:maxOrderItemLength])
+	}
 
 	if phone == "" {
 		h.renderError(c, "Номер телефона обязателен")
@@ -59,71 +120,432 @@ func (h *Handler) sendOrder(c *gin.Context) {
 		return
 	}
 
-	err := h.emailSender.SendOrder(name, phone)
+	line, err := parseOrderLine(c)
+	if err != nil {
+		h.renderError(c, "Неверное количество товара")
+		return
+	}
+
+	if !h.orderLimiter.Allow(c.ClientIP(), time.Now()) {
+		c.Status(http.StatusTooManyRequests)
+		h.renderError(c, "Слишком много заявок с вашего адреса. Пожалуйста, позвоните нам или попробуйте позже")
+		return
+	}
+
+	var reservation *productapi.StockReservation
+	if line != nil {
+		item = fmt.Sprintf("%s; товар №%d; количество: %d", item, line.ProductID, line.Quantity)
+		if quote, err := h.quoteOrder(c.Request.Context(), line); err != nil {
+			h.logger.Errorf("Failed to quote order for product %d: %v", line.ProductID, err)
+		} else {
+			item = fmt.Sprintf("%s; цена: %s за пару, сумма: %s", item, common.FormatRubles(quote.UnitPrice), common.FormatRubles(quote.Total))
+			if quote.VATRate > 0 {
+				item = fmt.Sprintf("%s, в т.ч. НДС %d%%: %s", item, quote.VATRate, common.FormatRubles(quote.TotalVAT))
+			} else {
+				item += ", без НДС"
+			}
+		}
+
+		line.Reference = "заявка с сайта, тел. " + phone
+		reservation, err = h.reserveStock(c.Request.Context(), line)
+		switch {
+		case reservation != nil:
+			item = fmt.Sprintf("%s; резерв №%d до %s", item, reservation.Id, reservation.ExpiresAt.Local().Format("02.01.2006 15:04"))
+		case errors.Is(err, errStockInsufficient):
+			item += "; остатка не хватает, под заказ"
+		default:
+			h.logger.Errorf("Failed to reserve stock for product %d: %v", line.ProductID, err)
+		}
+	}
+
+	err = h.emailSender.SendOrder(name, phone, item)
 	if err != nil {
 		h.logger.Errorf("Failed to send order: %v", err)
+		// менеджер о заявке не узнает, резерв держать незачем
+		if reservation != nil {
+			h.releaseStock(c.Request.Context(), reservation)
+		}
 		h.renderError(c, "Не удалось отправить заказ. Пожалуйста, попробуйте позже")
 		return
 	}
-	
+
 	h.renderThank(c, name)
 }
 
+// orderLine - товар из формы заказа
+type orderLine struct {
+	ProductID int32
+	VariantID *int32
+	Quantity int32
+	Reference string
+}
+
+// parseOrderLine читает товар, вариант и количество из формы заказа; nil - товар не выбран
+func parseOrderLine(c *gin.Context) (*orderLine, error) {
+	productID, err := strconv.ParseInt(c.PostForm("product_id"), 10, 32)
+	if err != nil || productID <= 0 {
+		return nil, nil
+	}
+
+	line := &orderLine{
+		ProductID: int32(productID),
+		Quantity: 1,
+	}
+	if value := c.PostForm("variant_id"); value != "" {
+		variantID, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid variant id %q: %w", value, err)
+		}
+		id := int32(variantID)
+		line.VariantID = &id
+	}
+	if value := strings.TrimSpace(c.PostForm("quantity")); value != "" {
+		quantity, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || quantity < 1 || quantity > maxOrderQuantity {
+			return nil, fmt.Errorf("invalid quantity %q", value)
+		}
+		line.Quantity = int32(quantity)
+	}
+	return line, nil
+}
+
+type orderQuote struct {
+	UnitPrice decimal.Decimal
+	Total decimal.Decimal
+	TotalVAT decimal.Decimal
+	VATRate int32
+}
+
+// quoteOrder считает стоимость заявки с учётом оптовых цен
+func (h *Handler) quoteOrder(ctx context.Context, line *orderLine) (*orderQuote, error) {
+	resp, err := h.productAPI.QuoteProductWithResponse(ctx, line.ProductID, &productapi.QuoteProductParams{
+		Quantity: line.Quantity,
+		VariantId: line.VariantID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to quote product %d: %w", line.ProductID, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on quote", resp.StatusCode())
+	}
+
+	return &orderQuote{
+		UnitPrice: product.Decimal(resp.JSON200.UnitPrice),
+		Total: product.Decimal(resp.JSON200.Total),
+		TotalVAT: product.Decimal(resp.JSON200.TotalVat),
+		VATRate: resp.JSON200.VatRate,
+	}, nil
+}
+
+// reserveStock резервирует остаток под заявку. Резерв снимается сам через сутки-двое,
+// если менеджер не подтвердит или не отклонит заявку в админке
+func (h *Handler) reserveStock(ctx context.Context, line *orderLine) (*productapi.StockReservation, error) {
+	resp, err := h.productAPI.CreateStockReservationWithResponse(ctx, line.ProductID, productapi.StockReservationInput{
+		VariantId: line.VariantID,
+		Quantity: line.Quantity,
+		Reference: &line.Reference,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve stock: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusCreated:
+		return resp.JSON201, nil
+	case http.StatusConflict:
+		return nil, errStockInsufficient
+	default:
+		return nil, fmt.Errorf("product service returned status %d on stock reservation: %s", resp.StatusCode(), resp.Body)
+	}
+}
+
+func (h *Handler) releaseStock(ctx context.Context, reservation *productapi.StockReservation) {
+	resp, err := h.productAPI.ReleaseStockReservationWithResponse(ctx, reservation.ProductId, reservation.Id)
+	if err != nil {
+		h.logger.Errorf("Failed to release stock reservation %d: %v", reservation.Id, err)
+		return
+	}
+	if resp.JSON200 == nil {
+		h.logger.Errorf("Product service returned status %d on releasing stock reservation %d", resp.StatusCode(), reservation.Id)
+	}
+}
+
 func (h *Handler) indexPage(c *gin.Context) {
 	params := client_templates.IndexParams{
 		BaseParams: client_templates.BaseParams{
 			Title: "Задник из кожкартона саламандер от производителя для обуви, доставка по всей России",
             Description: "Задник из кожкартона саламандер от производителя для обуви. Доступные цены, 7 видов задника, оптовая продажа с доставкой по России, заказать можно прямо на сайте",
 		},
+		FAQ: client_templates.FAQ,
 	}
-	req, err := http.NewRequest(http.MethodGet, h.productServiceUrl+"/products", nil)
+	params.BaseParams = h.page(params.BaseParams, "/")
+	h.addJSONLD(&params.BaseParams, client_templates.NewOrganizationLD(h.site.Name, h.absURL("/"), h.absURL(logoPath), organizationPhone))
+	h.addJSONLD(&params.BaseParams, client_templates.NewFAQPageLD(params.FAQ))
+	products, err := h.listProducts(c.Request.Context(), activeProducts())
 	if err != nil {
-		h.logger.Errorf("Failed to create request: %v", err)
+		h.logger.Errorf("Failed to fetch products: %v", err)
 		params.Error = "Не удалось загрузить список товаров"
 		h.renderIndex(c, params)
 		return
 	}
-	req.Header.Set("X-API-KEY", h.productServiceAPIKey)
-	
-	resp, err := h.httpClient.Do(req)
+
+	params.Products = productsFromProto(products)
+	h.renderIndex(c, params)
+
+}
+
+func (h *Handler) searchPage(c *gin.Context) {
+	params := client_templates.SearchParams{
+		BaseParams: client_templates.BaseParams{
+			Title: "Поиск задников и стелек по каталогу",
+			Description: "Поиск по каталогу задников из кожкартона саламандер и стелек от производителя",
+		},
+		Query: strings.TrimSpace(c.Query("q")),
+	}
+
+	if params.Query == "" {
+		h.renderSearch(c, params)
+		return
+	}
+
+	searchPage, err := h.products.SearchProducts(c.Request.Context(), &pb.SearchProductsRequest{Query: params.Query, Filter: activeProducts(), Limit: 50})
 	if err != nil {
-		h.logger.Errorf("Failed to fetch products: %v", err)
+		h.logger.Errorf("Failed to search products: %v", err)
+		params.Error = "Не удалось выполнить поиск. Пожалуйста, попробуйте позже"
+		h.renderSearch(c, params)
+		return
+	}
+
+	for _, result := range searchPage.Items {
+		params.Results = append(params.Results, client_templates.SearchResult{
+			Product: productFromProto(result.Product),
+			NameHighlight: result.NameHighlight,
+			Snippet: result.Snippet,
+		})
+	}
+	params.Total = int(searchPage.Total)
+	h.renderSearch(c, params)
+}
+
+func (h *Handler) categoryPage(c *gin.Context) {
+	category, err := h.fetchCategory(c.Request.Context(), c.Param("slug"))
+	if errors.Is(err, errNotFound) {
+		c.Status(http.StatusNotFound)
+		h.renderError(c, "Раздел каталога не найден")
+		return
+	}
+	if err != nil {
+		h.logger.Errorf("Failed to fetch category: %v", err)
+		c.Status(http.StatusServiceUnavailable)
+		h.renderError(c, "Сервис товаров временно недоступен")
+		return
+	}
+
+	params := client_templates.CategoryParams{
+		BaseParams: client_templates.BaseParams{
+			Title: category.SEOTitle,
+			Description: category.SEODescription,
+		},
+		Category: category,
+	}
+	if params.Title == "" {
+		params.Title = category.Name
+	}
+	if params.Description == "" {
+		params.Description = category.Description
+	}
+	params.BaseParams = h.page(params.BaseParams, "/catalog/"+url.PathEscape(category.Slug))
+
+	filter := activeProducts()
+	filter.CategoryId = &category.ID
+	products, err := h.listProducts(c.Request.Context(), filter)
+	if err != nil {
+		h.logger.Errorf("Failed to fetch category products: %v", err)
 		params.Error = "Не удалось загрузить список товаров"
-		h.renderIndex(c, params)
+	} else {
+		params.Products = productsFromProto(products)
+	}
+
+	if err := h.templates.RenderCategory(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render category template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+// productPage показывает страницу товара; прежние адреса перенаправляются на текущий с кодом 301
+func (h *Handler) productPage(c *gin.Context) {
+	slug := c.Param("slug")
+
+	resp, err := h.products.GetProduct(c.Request.Context(), &pb.GetProductRequest{Key: &pb.GetProductRequest_Slug{Slug: slug}})
+	// черновики, запланированные и скрытые товары для покупателей не существуют
+	if status.Code(err) == codes.NotFound || (err == nil && !resp.Product.GetPublished()) {
+		c.Status(http.StatusNotFound)
+		h.renderError(c, "Товар не найден")
 		return
 	}
-	defer resp.Body.Close()
+	if err != nil {
+		h.logger.Errorf("Failed to fetch product: %v", err)
+		c.Status(http.StatusServiceUnavailable)
+		h.renderError(c, "Сервис товаров временно недоступен")
+		return
+	}
+	product := productFromProto(resp.Product)
 
-	if resp.StatusCode != http.StatusOK {
-		h.logger.Errorf("Product service returned non-200 status: %d", resp.StatusCode)
-		params.Error = "Сервис товаров временно недоступен"
-		h.renderIndex(c, params)
+	if product.Slug != slug {
+		c.Redirect(http.StatusMovedPermanently, "/products/"+url.PathEscape(product.Slug))
 		return
 	}
 
-	var apiProducts []client_templates.Product
-	if err := json.NewDecoder(resp.Body).Decode(&apiProducts); err != nil {
-		h.logger.Errorf("Failed to decode products response: %v", err)
-		params.Error = "Ошибка при обработке данных"
-		h.renderIndex(c, params)
+	params := client_templates.ProductParams{
+		BaseParams: client_templates.BaseParams{
+			Description: metaDescription(product.Description),
+			OGType: "product",
+		},
+		Product: product,
+		Breadcrumbs: []client_templates.Breadcrumb{{Name: "Главная", URL: "/"}},
+	}
+	if params.Description == "" {
+		params.Description = "Задник " + product.Name + " из кожкартона саламандер от производителя, оптовые цены и доставка по России"
+	}
+
+	// в крошки попадает первый раздел товара; без разделов крошки ведут сразу с главной
+	categories, err := h.fetchProductCategories(c.Request.Context(), resp.Product.Id)
+	if err != nil {
+		h.logger.Errorf("Failed to fetch categories of product %d: %v", product.ID, err)
+	} else if len(categories) > 0 {
+		params.Breadcrumbs = append(params.Breadcrumbs, client_templates.Breadcrumb{Name: categories[0].Name, URL: "/catalog/" + categories[0].Slug})
+	}
+	params.Title = h.productTitle(product.Name, categories)
+	params.Breadcrumbs = append(params.Breadcrumbs, client_templates.Breadcrumb{Name: product.Name})
+
+	// без рекомендаций страница товара остаётся рабочей
+	recommendations, err := h.products.GetRecommendations(c.Request.Context(), &pb.GetRecommendationsRequest{ProductId: resp.Product.Id, Limit: recommendationsLimit})
+	if err != nil {
+		h.logger.Errorf("Failed to fetch recommendations for product %d: %v", product.ID, err)
+	} else {
+		params.Accessories, params.Related = recommendationsFromProto(recommendations.Items)
+	}
+
+	params.ReviewForm = reviewForm(c.Query("review"), time.Now())
+	reviews, err := h.products.ListProductReviews(c.Request.Context(), &pb.ListProductReviewsRequest{ProductId: resp.Product.Id, Limit: reviewsLimit})
+	if err != nil {
+		h.logger.Errorf("Failed to fetch reviews for product %d: %v", product.ID, err)
+	} else {
+		params.Reviews = reviewsFromProto(reviews.Items)
+	}
+
+	var images []string
+	for _, image := range product.GalleryImages() {
+		images = append(images, h.absURL(image.URL))
+	}
+	if len(images) > 0 {
+		params.Image = images[0]
+	}
+	params.BaseParams = h.page(params.BaseParams, "/products/"+url.PathEscape(product.Slug))
+	h.addJSONLD(&params.BaseParams, client_templates.NewProductLD(product, params.Canonical, images, h.site.Name, params.Reviews))
+	h.addJSONLD(&params.BaseParams, client_templates.NewBreadcrumbListLD(h.site.URL, params.Breadcrumbs))
+
+	if err := h.templates.RenderProduct(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render product template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
+// productTitle дополняет название товара его основным разделом, а без разделов - названием витрины
+func (h *Handler) productTitle(name string, categories []client_templates.Category) string {
+	if len(categories) > 0 {
+		return name + " - " + categories[0].Name
+	}
+	return name + " - " + h.site.Name
+}
+
+// page дополняет параметры страницы канонической ссылкой и картинкой для соцсетей
+func (h *Handler) page(base client_templates.BaseParams, path string) client_templates.BaseParams {
+	base.Canonical = h.absURL(path)
+	if base.Image == "" {
+		base.Image = h.absURL(defaultImagePath)
+	}
+	return base
+}
+
+// addJSONLD добавляет разметку schema.org; при ошибке страница показывается без неё
+func (h *Handler) addJSONLD(base *client_templates.BaseParams, v interface{}) {
+	data, err := client_templates.JSONLD(v)
+	if err != nil {
+		h.logger.Errorf("Failed to build structured data: %v", err)
 		return
 	}
+	base.StructuredData = append(base.StructuredData, data)
+}
 
-	products := make([]client_templates.Product, len(apiProducts))
-	for i, p := range apiProducts {
-		products[i] = client_templates.Product{
-			ID:          p.ID,
-			Name:        p.Name,
-			Slug:        p.Slug,
-			Price:       p.Price,
-			Description: p.Description,
-			ImageURL:    p.ImageURL,
-		}
+// absURL дополняет путь на сайте адресом витрины; внешние адреса, например изображений, не меняются
+func (h *Handler) absURL(path string) string {
+	if strings.HasPrefix(path, "/") {
+		return h.site.URL + path
 	}
+	return path
+}
 
-	params.Products = products
-	h.renderIndex(c, params)
+// metaDescription сокращает описание товара до длины, которую показывают поисковики
+func metaDescription(description string) string {
+	description = strings.Join(strings.Fields(description), " ")
+	runes := []rune(description)
+	if len(runes) <= maxMetaDescriptionLength {
+		return description
+	}
+	cut := string(runes[:maxMetaDescriptionLength])
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, ",.;:-") + "…"
+}
 
+// fetchCategory возвращает раздел по slug; неизвестный раздел - errNotFound
+func (h *Handler) fetchCategory(ctx context.Context, slug string) (client_templates.Category, error) {
+	resp, err := h.productAPI.GetCategoryBySlugWithResponse(ctx, slug)
+	if err != nil {
+		return client_templates.Category{}, fmt.Errorf("failed to fetch category %s: %w", slug, err)
+	}
+	if resp.JSON404 != nil {
+		return client_templates.Category{}, fmt.Errorf("category %s: %w", slug, errNotFound)
+	}
+	if resp.JSON200 == nil {
+		return client_templates.Category{}, fmt.Errorf("product service returned status %d on category %s", resp.StatusCode(), slug)
+	}
+	return categoryFromAPI(*resp.JSON200), nil
+}
+
+// fetchProductCategories возвращает разделы, в которые входит товар
+func (h *Handler) fetchProductCategories(ctx context.Context, productID int32) ([]client_templates.Category, error) {
+	resp, err := h.productAPI.GetProductCategoriesWithResponse(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch categories of product %d: %w", productID, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on categories of product %d", resp.StatusCode(), productID)
+	}
+	categories := make([]client_templates.Category, len(*resp.JSON200))
+	for i, category := range *resp.JSON200 {
+		categories[i] = categoryFromAPI(category)
+	}
+	return categories, nil
+}
+
+func categoryFromAPI(c productapi.Category) client_templates.Category {
+	category := client_templates.Category{
+		ID: c.Id,
+		Name: c.Name,
+		Slug: c.Slug,
+		Description: c.Description,
+		SEOTitle: c.SeoTitle,
+		SEODescription: c.SeoDescription,
+	}
+	if c.Children != nil {
+		for _, child := range *c.Children {
+			category.Children = append(category.Children, categoryFromAPI(child))
+		}
+	}
+	return category
 }
 
 func (h *Handler) deliveryPage(c *gin.Context) {
@@ -133,6 +555,7 @@ func (h *Handler) deliveryPage(c *gin.Context) {
 			Description: "Мы предлагаем быструю и надежную доставку задников для обуви из кожартона саламандер по всей России. Выбираем оптимальный способ доставки с учетом срочности и стоимости. Задники тщательно упакованы для сохранности формы и качества.",
 		},
 	}
+	params.BaseParams = h.page(params.BaseParams, "/delivery")
 	if err := h.templates.RenderDelivery(c.Writer, params); err != nil {
 		h.logger.Errorf("Failed to render delivery template: %v", err)
 		c.String(http.StatusInternalServerError, "Internal Server Error")
@@ -146,6 +569,7 @@ func (h *Handler) paymentPage(c *gin.Context) {
 			Description: "Способы оплаты задников из кожкартона саламандер от производителя для обуви",
 		},
 	}
+	params.BaseParams = h.page(params.BaseParams, "/payment")
 	if err := h.templates.RenderPayment(c.Writer, params); err != nil {
 		h.logger.Errorf("Failed to render payment template: %v", err)
 		c.String(http.StatusInternalServerError, "Internal Server Error")
@@ -159,6 +583,7 @@ func (h *Handler) guaranteePage(c *gin.Context) {
 			Description: "Гарантийные обязательства и порядок возврата задников для обуви из кожкартона саламандер от производителя для обуви",
 		},
 	}
+	params.BaseParams = h.page(params.BaseParams, "/guarantee")
 	if err := h.templates.RenderGuarantee(c.Writer, params); err != nil {
 		h.logger.Errorf("Failed to render guarantee template: %v", err)
 		c.String(http.StatusInternalServerError, "Internal Server Error")
@@ -172,6 +597,7 @@ func (h *Handler) policyPage(c *gin.Context) {
 			Description: "Политика конфиденциальности",
 		},
 	}
+	params.BaseParams = h.page(params.BaseParams, "/policy")
 	if err := h.templates.RenderPolicy(c.Writer, params); err != nil {
 		h.logger.Errorf("Failed to render policy template: %v", err)
 		c.String(http.StatusInternalServerError, "Internal Server Error")
@@ -185,6 +611,13 @@ func (h *Handler) renderIndex(c *gin.Context, params client_templates.IndexParam
 	}
 }
 
+func (h *Handler) renderSearch(c *gin.Context, params client_templates.SearchParams) {
+	if err := h.templates.RenderSearch(c.Writer, params); err != nil {
+		h.logger.Errorf("Failed to render search template: %v", err)
+		c.String(http.StatusInternalServerError, "Internal Server Error")
+	}
+}
+
 func (h *Handler) renderError(c *gin.Context, message string) {
 	params := client_templates.ErrorParams{
 		BaseParams: client_templates.BaseParams{
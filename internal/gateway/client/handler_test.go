@@ -0,0 +1,427 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Nzyazin/zadnik.store/api/generated/product"
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	client_templates "github.com/Nzyazin/zadnik.store/internal/templates/client-templates"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestProductTitle(t *testing.T) {
+	h := &Handler{site: Site{Name: "задник-для-обуви.рф"}}
+
+	tests := []struct {
+		name       string
+		categories []client_templates.Category
+		want       string
+	}{
+		{
+			name:       "primary category",
+			categories: []client_templates.Category{{Name: "Задники для детской обуви"}, {Name: "Распродажа"}},
+			want:       "Задник 1.5 мм - Задники для детской обуви",
+		},
+		{
+			name: "site name without categories",
+			want: "Задник 1.5 мм - задник-для-обуви.рф",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, h.productTitle("Задник 1.5 мм", tt.categories))
+		})
+	}
+}
+
+// productPageService отдаёт один товар по адресу; рекомендации и отзывы пусты
+type productPageService struct {
+	pb.ProductServiceClient
+	product *pb.Product
+	err     error
+}
+
+func (s *productPageService) GetProduct(ctx context.Context, in *pb.GetProductRequest, opts ...grpc.CallOption) (*pb.GetProductResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &pb.GetProductResponse{Product: s.product}, nil
+}
+
+func (s *productPageService) GetRecommendations(ctx context.Context, in *pb.GetRecommendationsRequest, opts ...grpc.CallOption) (*pb.GetRecommendationsResponse, error) {
+	return &pb.GetRecommendationsResponse{}, nil
+}
+
+func (s *productPageService) ListProductReviews(ctx context.Context, in *pb.ListProductReviewsRequest, opts ...grpc.CallOption) (*pb.ListProductReviewsResponse, error) {
+	return &pb.ListProductReviewsResponse{}, nil
+}
+
+func TestIndexPage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	templates, err := client_templates.NewTemplates(client_templates.TemplateFunctions{StaticWithHash: func(path string) string { return path }})
+	require.NoError(t, err)
+
+	products := &pagedProducts{total: 2}
+	h := &Handler{
+		templates: templates,
+		products:  products,
+		logger:    common.NewSimpleLogger(),
+		site:      Site{URL: "https://example.test", Name: defaultSiteName},
+	}
+	router := gin.New()
+	router.GET("/", h.indexPage)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	require.Len(t, products.requests, 1)
+	// витрина не показывает товары в статусах pending, creating и deleting
+	assert.Equal(t, []string{"active"}, products.requests[0].Filter.GetStatuses())
+	assert.True(t, products.requests[0].Filter.GetPublished())
+}
+
+func TestProductPage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	templates, err := client_templates.NewTemplates(client_templates.TemplateFunctions{StaticWithHash: func(path string) string { return path }})
+	require.NoError(t, err)
+
+	// разделы товара сервис отдаёт по HTTP
+	categories := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]productapi.Category{{Id: 2, Name: "Задники для детской обуви", Slug: "detskie"}})
+	}))
+	defer categories.Close()
+	productAPI, err := productapi.NewClientWithResponses(categories.URL, productapi.WithHTTPClient(categories.Client()))
+	require.NoError(t, err)
+
+	product := func() *pb.Product {
+		return &pb.Product{Id: 7, Name: "Задник 7780", Slug: "zadnik-7780", Price: "150", Status: "active", Published: true}
+	}
+
+	tests := []struct {
+		name     string
+		service  *productPageService
+		path     string
+		status   int
+		location string
+		contains []string
+	}{
+		{
+			name:     "published product",
+			service:  &productPageService{product: product()},
+			path:     "/products/zadnik-7780",
+			status:   http.StatusOK,
+			contains: []string{"<title>Задник 7780 - Задники для детской обуви</title>", "/catalog/detskie"},
+		},
+		{
+			name:     "previous slug redirects",
+			service:  &productPageService{product: product()},
+			path:     "/products/zadnik-7780-old",
+			status:   http.StatusMovedPermanently,
+			location: "/products/zadnik-7780",
+		},
+		{
+			name: "unpublished product",
+			service: &productPageService{product: func() *pb.Product {
+				p := product()
+				p.Published = false
+				return p
+			}()},
+			path:     "/products/zadnik-7780",
+			status:   http.StatusNotFound,
+			contains: []string{"Товар не найден"},
+		},
+		{
+			name:     "unknown product",
+			service:  &productPageService{err: status.Error(codes.NotFound, "product not found")},
+			path:     "/products/zadnik-0000",
+			status:   http.StatusNotFound,
+			contains: []string{"Товар не найден"},
+		},
+		{
+			name:     "product service unavailable",
+			service:  &productPageService{err: status.Error(codes.Unavailable, "connection refused")},
+			path:     "/products/zadnik-7780",
+			status:   http.StatusServiceUnavailable,
+			contains: []string{"Сервис товаров временно недоступен"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				templates:  templates,
+				products:   tt.service,
+				productAPI: productAPI,
+				logger:     common.NewSimpleLogger(),
+				site:       Site{URL: "https://example.test", Name: defaultSiteName},
+			}
+			router := gin.New()
+			router.GET("/products/:slug", h.productPage)
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.status, recorder.Code)
+			if tt.location != "" {
+				assert.Equal(t, tt.location, recorder.Header().Get("Location"))
+			}
+			for _, text := range tt.contains {
+				assert.Contains(t, recorder.Body.String(), text)
+			}
+		})
+	}
+}
+
+func TestProductPageBreadcrumbs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	templates, err := client_templates.NewTemplates(client_templates.TemplateFunctions{StaticWithHash: func(path string) string { return path }})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		categories []productapi.Category
+		status     int
+		wantTitle  string
+		wantCrumbs []string
+		wantLD     []client_templates.ListItemLD
+	}{
+		{
+			name: "first category of the product",
+			categories: []productapi.Category{
+				{Id: 2, Name: "Задники для детской обуви", Slug: "detskie"},
+				{Id: 3, Name: "Задники для зимней обуви", Slug: "zimnie"},
+			},
+			status:    http.StatusOK,
+			wantTitle: "<title>Задник 7780 - Задники для детской обуви</title>",
+			wantCrumbs: []string{
+				`<a class="breadcrumbs__link" href="/">Главная</a>`,
+				`<a class="breadcrumbs__link" href="/catalog/detskie">Задники для детской обуви</a>`,
+				`<span aria-current="page">Задник 7780</span>`,
+			},
+			wantLD: []client_templates.ListItemLD{
+				{Type: "ListItem", Position: 1, Name: "Главная", Item: "https://example.test/"},
+				{Type: "ListItem", Position: 2, Name: "Задники для детской обуви", Item: "https://example.test/catalog/detskie"},
+				{Type: "ListItem", Position: 3, Name: "Задник 7780"},
+			},
+		},
+		{
+			name:       "product without categories",
+			categories: []productapi.Category{},
+			status:     http.StatusOK,
+			wantTitle:  "<title>Задник 7780 - " + defaultSiteName + "</title>",
+			wantCrumbs: []string{
+				`<a class="breadcrumbs__link" href="/">Главная</a>`,
+				`<span aria-current="page">Задник 7780</span>`,
+			},
+			wantLD: []client_templates.ListItemLD{
+				{Type: "ListItem", Position: 1, Name: "Главная", Item: "https://example.test/"},
+				{Type: "ListItem", Position: 2, Name: "Задник 7780"},
+			},
+		},
+		{
+			name:      "categories unavailable",
+			status:    http.StatusInternalServerError,
+			wantTitle: "<title>Задник 7780 - " + defaultSiteName + "</title>",
+			wantCrumbs: []string{
+				`<a class="breadcrumbs__link" href="/">Главная</a>`,
+				`<span aria-current="page">Задник 7780</span>`,
+			},
+			wantLD: []client_templates.ListItemLD{
+				{Type: "ListItem", Position: 1, Name: "Главная", Item: "https://example.test/"},
+				{Type: "ListItem", Position: 2, Name: "Задник 7780"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/products/7/categories", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				if tt.status == http.StatusOK {
+					json.NewEncoder(w).Encode(tt.categories)
+					return
+				}
+				json.NewEncoder(w).Encode(productapi.Error{Error: "database is down"})
+			}))
+			defer service.Close()
+			productAPI, err := productapi.NewClientWithResponses(service.URL, productapi.WithHTTPClient(service.Client()))
+			require.NoError(t, err)
+
+			h := &Handler{
+				templates: templates,
+				products: &productPageService{product: &pb.Product{
+					Id: 7, Name: "Задник 7780", Slug: "zadnik-7780", Price: "150", Status: "active", Published: true,
+				}},
+				productAPI: productAPI,
+				logger:     common.NewSimpleLogger(),
+				site:       Site{URL: "https://example.test", Name: defaultSiteName},
+			}
+			router := gin.New()
+			router.GET("/products/:slug", h.productPage)
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/products/zadnik-7780", nil))
+
+			require.Equal(t, http.StatusOK, recorder.Code)
+			body := recorder.Body.String()
+			assert.Contains(t, body, tt.wantTitle)
+
+			// крошки выводятся по порядку: главная, раздел, сам товар без ссылки
+			position := 0
+			for _, crumb := range tt.wantCrumbs {
+				index := strings.Index(body[position:], crumb)
+				require.GreaterOrEqual(t, index, 0, "breadcrumb %s not found in order", crumb)
+				position += index + len(crumb)
+			}
+
+			breadcrumbsLD, err := client_templates.JSONLD(client_templates.BreadcrumbListLD{
+				Context: "https://schema.org", Type: "BreadcrumbList", ItemListElement: tt.wantLD,
+			})
+			require.NoError(t, err)
+			assert.Contains(t, body, breadcrumbsLD)
+		})
+	}
+}
+
+// sentOrders запоминает письма менеджеру или отвечает ошибкой err
+type sentOrders struct {
+	items []string
+	err   error
+}
+
+func (s *sentOrders) SendOrder(name, phone, item string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.items = append(s.items, item)
+	return nil
+}
+
+func TestSendOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	templates, err := client_templates.NewTemplates(client_templates.TemplateFunctions{StaticWithHash: func(path string) string { return path }})
+	require.NoError(t, err)
+
+	form := url.Values{"name": {"Иван"}, "phone": {"+79001234567"}, "item": {"Задник 7780"}, "product_id": {"7"}, "quantity": {"2"}}
+
+	tests := []struct {
+		name       string
+		reserve    int
+		email      *sentOrders
+		sentBefore int
+		status     int
+		contains   string
+		emailed    string
+		released   bool
+	}{
+		{
+			name:     "reserves stock for the order",
+			reserve:  http.StatusCreated,
+			email:    &sentOrders{},
+			status:   http.StatusOK,
+			contains: `class="thank"`,
+			emailed:  "резерв №3 до",
+		},
+		{
+			name:     "not enough stock",
+			reserve:  http.StatusConflict,
+			email:    &sentOrders{},
+			status:   http.StatusOK,
+			contains: `class="thank"`,
+			emailed:  "под заказ",
+		},
+		{
+			name:     "email failure releases reservation",
+			reserve:  http.StatusCreated,
+			email:    &sentOrders{err: errors.New("smtp unavailable")},
+			status:   http.StatusOK,
+			contains: "Не удалось отправить заказ",
+			released: true,
+		},
+		{
+			name:       "too many orders from address",
+			reserve:    http.StatusCreated,
+			email:      &sentOrders{},
+			sentBefore: ordersPerAddress,
+			status:     http.StatusTooManyRequests,
+			contains:   "Слишком много заявок",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reserved, released int
+			service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/products/7/stock/reservations":
+					reserved++
+					w.WriteHeader(tt.reserve)
+					if tt.reserve == http.StatusCreated {
+						json.NewEncoder(w).Encode(productapi.StockReservation{Id: 3, ProductId: 7, Quantity: 2, Status: "active", ExpiresAt: time.Now().Add(48 * time.Hour)})
+						return
+					}
+					json.NewEncoder(w).Encode(map[string]string{"error": "insufficient stock"})
+				case "/products/7/stock/reservations/3/release":
+					released++
+					json.NewEncoder(w).Encode(productapi.StockReservation{Id: 3, ProductId: 7, Status: "released"})
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer service.Close()
+
+			productAPI, err := productapi.NewClientWithResponses(service.URL, productapi.WithHTTPClient(service.Client()))
+			require.NoError(t, err)
+			h := &Handler{
+				templates:    templates,
+				productAPI:   productAPI,
+				logger:       common.NewSimpleLogger(),
+				emailSender:  tt.email,
+				orderLimiter: newAddressLimiter(ordersPerAddress, ordersWindow),
+			}
+			for i := 0; i < tt.sentBefore; i++ {
+				h.orderLimiter.Allow("192.0.2.1", time.Now())
+			}
+			router := gin.New()
+			router.POST("/send-order", h.sendOrder)
+			request := httptest.NewRequest(http.MethodPost, "/send-order", strings.NewReader(form.Encode()))
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			request.RemoteAddr = "192.0.2.1:1234"
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.status, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tt.contains)
+			if tt.emailed != "" {
+				require.Len(t, tt.email.items, 1)
+				assert.Contains(t, tt.email.items[0], tt.emailed)
+			}
+			if tt.sentBefore > 0 {
+				assert.Zero(t, reserved)
+				assert.Empty(t, tt.email.items)
+			}
+			assert.Equal(t, tt.released, released == 1)
+		})
+	}
+}
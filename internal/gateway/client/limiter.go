@@ -0,0 +1,44 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// addressLimiter ограничивает число отправок формы с одного адреса за окно; хранит только время отправок
+type addressLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	sent   map[string][]time.Time
+}
+
+func newAddressLimiter(limit int, window time.Duration) *addressLimiter {
+	return &addressLimiter{limit: limit, window: window, sent: make(map[string][]time.Time)}
+}
+
+// Allow учитывает отправку с адреса key и сообщает, укладывается ли она в лимит
+func (l *addressLimiter) Allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	since := now.Add(-l.window)
+	for k, times := range l.sent {
+		if k != key && !times[len(times)-1].After(since) {
+			delete(l.sent, k)
+		}
+	}
+
+	var recent []time.Time
+	for _, t := range l.sent[key] {
+		if t.After(since) {
+			recent = append(recent, t)
+		}
+	}
+	if len(recent) >= l.limit {
+		l.sent[key] = recent
+		return false
+	}
+	l.sent[key] = append(recent, now)
+	return true
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressLimiter(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	t.Run("limits each address separately", func(t *testing.T) {
+		limiter := newAddressLimiter(2, time.Hour)
+
+		assert.True(t, limiter.Allow("10.0.0.1", now))
+		assert.True(t, limiter.Allow("10.0.0.1", now.Add(time.Minute)))
+		assert.False(t, limiter.Allow("10.0.0.1", now.Add(2*time.Minute)))
+		assert.True(t, limiter.Allow("10.0.0.2", now.Add(2*time.Minute)))
+	})
+
+	t.Run("window moves", func(t *testing.T) {
+		limiter := newAddressLimiter(1, time.Hour)
+
+		assert.True(t, limiter.Allow("10.0.0.1", now))
+		assert.False(t, limiter.Allow("10.0.0.1", now.Add(59*time.Minute)))
+		assert.True(t, limiter.Allow("10.0.0.1", now.Add(61*time.Minute)))
+	})
+
+	t.Run("forgets stale addresses", func(t *testing.T) {
+		limiter := newAddressLimiter(1, time.Hour)
+
+		limiter.Allow("10.0.0.1", now)
+		limiter.Allow("10.0.0.2", now.Add(2*time.Hour))
+		assert.Len(t, limiter.sent, 1)
+	})
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"database/sql"
+
+	pb "github.com/Nzyazin/zadnik.store/api/generated/product"
+	"github.com/Nzyazin/zadnik.store/internal/gateway/product"
+	client_templates "github.com/Nzyazin/zadnik.store/internal/templates/client-templates"
+)
+
+// productPageLimit - максимальный размер страницы списка товаров в сервисе товаров
+const productPageLimit = 100
+
+// listProducts читает все страницы списка товаров: витрина показывает каталог и разделы целиком
+func (h *Handler) listProducts(ctx context.Context, filter *pb.ProductFilter) ([]*pb.Product, error) {
+	var products []*pb.Product
+	for offset := int32(0); ; {
+		page, err := h.products.ListProducts(ctx, &pb.ListProductsRequest{Filter: filter, Limit: productPageLimit, Offset: offset})
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, page.Items...)
+
+		offset += int32(len(page.Items))
+		if len(page.Items) == 0 || offset >= page.Total {
+			return products, nil
+		}
+	}
+}
+
+// activeProducts - фильтр витрины: покупателям видны только активные опубликованные товары
+func activeProducts() *pb.ProductFilter {
+	return &pb.ProductFilter{Statuses: []string{"active"}, Published: true}
+}
+
+func productFromProto(p *pb.Product) client_templates.Product {
+	out := client_templates.Product{
+		ID:          int(p.Id),
+		Name:        p.Name,
+		Slug:        p.Slug,
+		Status:      p.Status,
+		Price:       product.Decimal(p.Price),
+		Description: p.Description,
+		ImageURL:    sql.NullString{String: p.ImageUrl, Valid: p.ImageUrl != ""},
+		Pricing:     pricingFromProto(p.Pricing),
+	}
+	for _, variant := range p.Variants {
+		out.Variants = append(out.Variants, client_templates.ProductVariant{
+			ID:       variant.Id,
+			SKU:      variant.Sku,
+			Options:  variant.Options,
+			Price:    product.NullDecimal(variant.Price),
+			IsActive: variant.GetIsActive(),
+			Pricing:  pricingFromProto(variant.Pricing),
+		})
+	}
+	for _, image := range p.Images {
+		out.Images = append(out.Images, client_templates.ProductImage{ID: image.Id, URL: image.Url, Alt: image.Alt, IsPrimary: image.IsPrimary})
+	}
+	for _, item := range p.Stock {
+		out.Stock = append(out.Stock, client_templates.StockItem{
+			VariantID:         item.VariantId,
+			OnHand:            item.OnHand,
+			Reserved:          item.Reserved,
+			LowStockThreshold: item.LowStockThreshold,
+		})
+	}
+	for _, tier := range p.PriceTiers {
+		out.PriceTiers = append(out.PriceTiers, client_templates.PriceTier{
+			MinQuantity: tier.MinQuantity,
+			UnitPrice:   product.Decimal(tier.UnitPrice),
+			Pricing:     pricingFromProto(tier.Pricing),
+		})
+	}
+	for _, attribute := range p.Attributes {
+		out.Attributes = append(out.Attributes, client_templates.ProductAttribute{
+			Code:   attribute.Code,
+			Name:   attribute.Name,
+			Unit:   attribute.Unit,
+			Number: product.NullDecimal(attribute.Number),
+			Text:   attribute.Text,
+		})
+	}
+	if p.Rating != nil {
+		out.Rating = &client_templates.ProductRating{Average: p.Rating.Average, Count: p.Rating.Count}
+	}
+	return out
+}
+
+// recommendationsFromProto делит рекомендации на блоки страницы: аксессуары отдельно, остальное - похожие модели
+func recommendationsFromProto(items []*pb.Recommendation) (accessories, related []client_templates.Product) {
+	for _, item := range items {
+		if item.Kind == "accessory" {
+			accessories = append(accessories, productFromProto(item.Product))
+		} else {
+			related = append(related, productFromProto(item.Product))
+		}
+	}
+	return accessories, related
+}
+
+func productsFromProto(items []*pb.Product) []client_templates.Product {
+	products := make([]client_templates.Product, len(items))
+	for i, item := range items {
+		products[i] = productFromProto(item)
+	}
+	return products
+}
+
+func pricingFromProto(pricing *pb.Pricing) *client_templates.Pricing {
+	if pricing == nil {
+		return nil
+	}
+	return &client_templates.Pricing{
+		Gross:   product.Decimal(pricing.Gross),
+		Net:     product.Decimal(pricing.Net),
+		VAT:     product.Decimal(pricing.Vat),
+		VATRate: pricing.VatRate,
+	}
+}
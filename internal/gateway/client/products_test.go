@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Nzyazin/zadnik.store/api/generated/product"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// pagedProducts отдаёт каталог из total товаров страницами не больше productPageLimit
+type pagedProducts struct {
+	pb.ProductServiceClient
+	total    int32
+	requests []*pb.ListProductsRequest
+}
+
+func (p *pagedProducts) ListProducts(ctx context.Context, in *pb.ListProductsRequest, opts ...grpc.CallOption) (*pb.ListProductsResponse, error) {
+	p.requests = append(p.requests, in)
+	page := &pb.ListProductsResponse{Total: p.total, Limit: in.Limit, Offset: in.Offset}
+	for id := in.Offset + 1; id <= p.total && id <= in.Offset+in.Limit; id++ {
+		page.Items = append(page.Items, &pb.Product{Id: id})
+	}
+	return page, nil
+}
+
+func TestListProducts(t *testing.T) {
+	cases := []struct {
+		name  string
+		total int32
+		pages int
+	}{
+		{name: "empty catalog", total: 0, pages: 1},
+		{name: "one page", total: productPageLimit, pages: 1},
+		{name: "more than one page", total: 2*productPageLimit + 1, pages: 3},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			products := &pagedProducts{total: tc.total}
+			h := &Handler{products: products}
+
+			items, err := h.listProducts(context.Background(), activeProducts())
+
+			assert.NoError(t, err)
+			assert.Len(t, items, int(tc.total))
+			assert.Len(t, products.requests, tc.pages)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/Nzyazin/zadnik.store/api/generated/product"
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	client_templates "github.com/Nzyazin/zadnik.store/internal/templates/client-templates"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// reviewsLimit - отзывы на странице товара; остальные покупатели увидят позже, когда появится постраничный вывод
+	reviewsLimit = 20
+	// minReviewFillTime - быстрее форму заполняют только боты
+	minReviewFillTime = 5 * time.Second
+	// maxReviewFillTime - страница открыта слишком давно или started_at подделан
+	maxReviewFillTime = 24 * time.Hour
+	reviewsPerAddress = 3
+	reviewsWindow     = time.Hour
+	// границы оценки и длины текста совпадают с проверкой в сервисе товаров
+	minReviewRating     = 1
+	maxReviewRating     = 5
+	minReviewTextLength = 10
+)
+
+// Итоги отправки отзыва; передаются в адресе страницы товара после перенаправления
+const (
+	reviewSent     = "sent"
+	reviewInvalid  = "invalid"
+	reviewFrequent = "frequent"
+	reviewFailed   = "error"
+)
+
+var reviewNotices = map[string]string{
+	reviewSent:     "Спасибо! Отзыв появится на сайте после проверки",
+	reviewInvalid:  "Проверьте отзыв: нужны имя, оценка и текст не короче 10 символов",
+	reviewFrequent: "Вы уже отправили отзыв. Следующий можно оставить позже",
+	reviewFailed:   "Не удалось отправить отзыв. Пожалуйста, попробуйте позже",
+}
+
+// isHumanReview отсекает ботов без капчи: они заполняют скрытое поле website
+// или отправляют форму быстрее, чем её можно прочитать
+func isHumanReview(c *gin.Context, now time.Time) bool {
+	if c.PostForm("website") != "" {
+		return false
+	}
+	startedAt, err := strconv.ParseInt(c.PostForm("started_at"), 10, 64)
+	if err != nil {
+		return false
+	}
+	elapsed := now.Sub(time.Unix(startedAt, 0))
+	return elapsed >= minReviewFillTime && elapsed <= maxReviewFillTime
+}
+
+// authorKey - HMAC адреса покупателя на секрете витрины: сервису товаров хватает его, чтобы отсечь повторы,
+// а без секрета адрес не восстановить перебором
+func authorKey(secret []byte, clientIP string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(clientIP))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// sendReview принимает отзыв из формы на странице товара и возвращает покупателя к отзывам с итогом отправки
+func (h *Handler) sendReview(c *gin.Context) {
+	slug := c.Param("slug")
+
+	resp, err := h.products.GetProduct(c.Request.Context(), &pb.GetProductRequest{Key: &pb.GetProductRequest_Slug{Slug: slug}})
+	if status.Code(err) == codes.NotFound || (err == nil && !resp.Product.GetPublished()) {
+		c.Status(http.StatusNotFound)
+		h.renderError(c, "Товар не найден")
+		return
+	}
+	if err != nil {
+		h.logger.Errorf("Failed to fetch product for review: %v", err)
+		h.redirectToReviews(c, slug, reviewFailed)
+		return
+	}
+	slug = resp.Product.Slug
+
+	now := time.Now()
+	// боту отвечаем так же, как человеку, чтобы он не подбирал обход
+	if !isHumanReview(c, now) {
+		h.logger.Infof("Dropped review for product %d as spam", resp.Product.Id)
+		h.redirectToReviews(c, slug, reviewSent)
+		return
+	}
+
+	input, ok := parseReviewInput(c)
+	if !ok {
+		h.redirectToReviews(c, slug, reviewInvalid)
+		return
+	}
+	// лимит считаем только по отзывам, которые дошли бы до модерации: опечатка в форме не тратит попытку
+	if !h.reviewLimiter.Allow(c.ClientIP(), now) {
+		h.redirectToReviews(c, slug, reviewFrequent)
+		return
+	}
+
+	key := authorKey(h.reviewKeySecret, c.ClientIP())
+	input.AuthorKey = &key
+	review, err := h.productAPI.CreateProductReviewWithResponse(c.Request.Context(), resp.Product.Id, input)
+	if err != nil {
+		h.logger.Errorf("Failed to create review for product %d: %v", resp.Product.Id, err)
+		h.redirectToReviews(c, slug, reviewFailed)
+		return
+	}
+
+	switch review.StatusCode() {
+	case http.StatusCreated:
+		h.logger.Infof("Review for product %d is waiting for moderation", resp.Product.Id)
+		h.redirectToReviews(c, slug, reviewSent)
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		h.redirectToReviews(c, slug, reviewInvalid)
+	case http.StatusTooManyRequests:
+		h.redirectToReviews(c, slug, reviewFrequent)
+	default:
+		h.logger.Errorf("Product service returned status %d on review for product %d: %s", review.StatusCode(), resp.Product.Id, review.Body)
+		h.redirectToReviews(c, slug, reviewFailed)
+	}
+}
+
+// parseReviewInput читает отзыв из формы и отсекает заведомо неверный; полная проверка - в сервисе товаров
+func parseReviewInput(c *gin.Context) (productapi.ReviewInput, bool) {
+	company := strings.TrimSpace(c.PostForm("company"))
+	input := productapi.ReviewInput{
+		AuthorName: strings.TrimSpace(c.PostForm("author_name")),
+		Company:    &company,
+		Text:       strings.TrimSpace(c.PostForm("text")),
+	}
+	rating, err := strconv.ParseInt(c.PostForm("rating"), 10, 32)
+	if err != nil || rating < minReviewRating || rating > maxReviewRating {
+		return input, false
+	}
+	input.Rating = int32(rating)
+	return input, input.AuthorName != "" && utf8.RuneCountInString(input.Text) >= minReviewTextLength
+}
+
+// redirectToReviews перенаправляет после POST, чтобы обновление страницы не отправило отзыв повторно
+func (h *Handler) redirectToReviews(c *gin.Context, slug, result string) {
+	c.Redirect(http.StatusSeeOther, "/products/"+url.PathEscape(slug)+"?review="+result+"#reviews")
+}
+
+// reviewForm готовит форму отзыва; result - итог прошлой отправки из адреса страницы
+func reviewForm(result string, now time.Time) client_templates.ReviewForm {
+	return client_templates.ReviewForm{
+		StartedAt: now.Unix(),
+		Notice:    reviewNotices[result],
+		Sent:      result == reviewSent,
+	}
+}
+
+func reviewsFromProto(items []*pb.Review) []client_templates.Review {
+	reviews := make([]client_templates.Review, len(items))
+	for i, item := range items {
+		reviews[i] = client_templates.Review{
+			AuthorName: item.AuthorName,
+			Company:    item.Company,
+			Rating:     item.Rating,
+			Text:       item.Text,
+			Reply:      item.Reply,
+			CreatedAt:  item.CreatedAt.AsTime(),
+		}
+	}
+	return reviews
+}
@@ -0,0 +1,16 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorKey(t *testing.T) {
+	key := authorKey([]byte("secret"), "10.0.0.1")
+
+	assert.Len(t, key, 64)
+	assert.Equal(t, key, authorKey([]byte("secret"), "10.0.0.1"))
+	assert.NotEqual(t, key, authorKey([]byte("other"), "10.0.0.1"))
+	assert.NotEqual(t, key, authorKey([]byte("secret"), "10.0.0.2"))
+}
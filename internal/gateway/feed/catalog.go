@@ -0,0 +1,259 @@
+package feed
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	"github.com/shopspring/decimal"
+)
+
+// productPageLimit - максимальный размер страницы списка товаров в сервисе товаров
+const productPageLimit = 100
+
+type Image struct {
+	URL       string
+	IsPrimary bool
+}
+
+type StockItem struct {
+	OnHand   int32
+	Reserved int32
+}
+
+type Attribute struct {
+	Name   string
+	Unit   string
+	Number decimal.NullDecimal
+	Text   string
+}
+
+// Value - значение характеристики без единицы измерения
+func (a Attribute) Value() string {
+	if a.Number.Valid {
+		return a.Number.Decimal.String()
+	}
+	return a.Text
+}
+
+type Category struct {
+	ID        int32
+	ParentID  *int32
+	Name      string
+	Slug      string
+	UpdatedAt time.Time
+}
+
+// Product - опубликованный товар в объёме, который нужен фидам
+type Product struct {
+	ID          int32
+	Name        string
+	Slug        string
+	Description string
+	Price       decimal.Decimal
+	ImageURL    sql.NullString
+	Images      []Image
+	Stock       []StockItem
+	Attributes  []Attribute
+	UpdatedAt   time.Time
+	// CategoryID - самый глубокий раздел товара, 0 - товар без раздела
+	CategoryID int32
+}
+
+// Available - товар без учёта остатков делается под заказ и считается доступным
+func (p Product) Available() bool {
+	if len(p.Stock) == 0 {
+		return true
+	}
+	var available int32
+	for _, item := range p.Stock {
+		available += item.OnHand - item.Reserved
+	}
+	return available > 0
+}
+
+// ImageURLs возвращает изображения галереи, основное первым
+func (p Product) ImageURLs() []string {
+	var urls []string
+	for _, image := range p.Images {
+		if image.IsPrimary {
+			urls = append([]string{image.URL}, urls...)
+		} else {
+			urls = append(urls, image.URL)
+		}
+	}
+	if len(urls) == 0 && p.ImageURL.Valid && p.ImageURL.String != "" {
+		urls = append(urls, p.ImageURL.String)
+	}
+	return urls
+}
+
+// Catalog - всё, из чего строятся фиды
+type Catalog struct {
+	Categories []Category
+	Products   []Product
+}
+
+// Source загружает каталог из сервиса товаров
+type Source struct {
+	api *productapi.ClientWithResponses
+}
+
+func NewSource(api *productapi.ClientWithResponses) *Source {
+	return &Source{api: api}
+}
+
+func (s *Source) Load(ctx context.Context) (*Catalog, error) {
+	catalog := &Catalog{}
+	categories, err := s.categories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	catalog.Categories = categories
+
+	// каталог читается одним проходом по списку товаров; разделы приходят в каждом товаре
+	products, err := s.products(ctx, categoryDepths(catalog.Categories))
+	if err != nil {
+		return nil, err
+	}
+	catalog.Products = products
+	return catalog, nil
+}
+
+func (s *Source) categories(ctx context.Context) ([]Category, error) {
+	resp, err := s.api.ListCategoriesWithResponse(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch categories: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("product service returned status %d on categories", resp.StatusCode())
+	}
+
+	categories := make([]Category, len(*resp.JSON200))
+	for i, category := range *resp.JSON200 {
+		categories[i] = Category{
+			ID:        category.Id,
+			ParentID:  category.ParentId,
+			Name:      category.Name,
+			Slug:      category.Slug,
+			UpdatedAt: category.UpdatedAt,
+		}
+	}
+	return categories, nil
+}
+
+// products проходит по всем страницам списка активных опубликованных товаров
+func (s *Source) products(ctx context.Context, depth map[int32]int) ([]Product, error) {
+	status := productapi.Status{"active"}
+	published := true
+	sort := productapi.ListProductsParamsSort("id")
+	order := productapi.ListProductsParamsOrder("asc")
+	limit := productPageLimit
+	params := &productapi.ListProductsParams{
+		Status:    &status,
+		Published: &published,
+		Sort:      &sort,
+		Order:     &order,
+		Limit:     &limit,
+	}
+
+	var products []Product
+	for offset := 0; ; {
+		params.Offset = &offset
+		resp, err := s.api.ListProductsWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch products: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("product service returned status %d on products", resp.StatusCode())
+		}
+
+		var items []productapi.Product
+		if resp.JSON200.Items != nil {
+			items = *resp.JSON200.Items
+		}
+		for i := range items {
+			product, err := productFromAPI(&items[i], depth)
+			if err != nil {
+				return nil, err
+			}
+			products = append(products, product)
+		}
+
+		offset += len(items)
+		if len(items) == 0 || offset >= resp.JSON200.Total {
+			return products, nil
+		}
+	}
+}
+
+// productFromAPI переводит товар из API; depth - глубина разделов, товару достаётся самый глубокий из его разделов
+func productFromAPI(p *productapi.Product, depth map[int32]int) (Product, error) {
+	price, err := decimal.NewFromString(p.Price)
+	if err != nil {
+		return Product{}, fmt.Errorf("invalid price of product %d: %w", p.Id, err)
+	}
+	product := Product{
+		ID:          p.Id,
+		Name:        p.Name,
+		Slug:        p.Slug,
+		Description: p.Description,
+		Price:       price,
+		ImageURL:    sql.NullString{String: p.ImageUrl.String, Valid: p.ImageUrl.Valid},
+		UpdatedAt:   p.UpdatedAt,
+	}
+	if p.Images != nil {
+		for _, image := range *p.Images {
+			product.Images = append(product.Images, Image{URL: image.Url, IsPrimary: image.IsPrimary})
+		}
+	}
+	if p.Stock != nil {
+		for _, item := range *p.Stock {
+			product.Stock = append(product.Stock, StockItem{OnHand: item.OnHand, Reserved: item.Reserved})
+		}
+	}
+	if p.Attributes != nil {
+		for _, value := range *p.Attributes {
+			attribute := Attribute{Name: value.Name, Unit: value.Unit, Text: value.Text}
+			if value.Number != nil {
+				number, err := decimal.NewFromString(*value.Number)
+				if err != nil {
+					return Product{}, fmt.Errorf("invalid attribute %s of product %d: %w", value.Code, p.Id, err)
+				}
+				attribute.Number = decimal.NewNullDecimal(number)
+			}
+			product.Attributes = append(product.Attributes, attribute)
+		}
+	}
+	if p.CategoryIds != nil {
+		for _, categoryID := range *p.CategoryIds {
+			current, ok := depth[categoryID]
+			if !ok {
+				continue
+			}
+			if product.CategoryID == 0 || current > depth[product.CategoryID] {
+				product.CategoryID = categoryID
+			}
+		}
+	}
+	return product, nil
+}
+
+func categoryDepths(categories []Category) map[int32]int {
+	parents := make(map[int32]*int32, len(categories))
+	for _, category := range categories {
+		parents[category.ID] = category.ParentID
+	}
+
+	depths := make(map[int32]int, len(categories))
+	for _, category := range categories {
+		depth := 0
+		for parent := category.ParentID; parent != nil && depth < len(categories); parent = parents[*parent] {
+			depth++
+		}
+		depths[category.ID] = depth
+	}
+	return depths
+}
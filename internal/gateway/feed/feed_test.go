@@ -0,0 +1,448 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+	"github.com/Nzyazin/zadnik.store/internal/common"
+)
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func testShop() Shop {
+	return Shop{
+		Name:         "Задник",
+		Company:      "ООО «Задник»",
+		SiteURL:      "https://zadnik.store",
+		ImageBaseURL: "https://zadnik.store/storage/images",
+	}
+}
+
+func testCatalog() *Catalog {
+	return &Catalog{
+		Categories: []Category{
+			{ID: 1, Name: "Задники", Slug: "zadniki"},
+			{ID: 2, ParentID: int32Ptr(1), Name: "Кожкартон & термопласт", Slug: "kozhkarton"},
+		},
+		Products: []Product{
+			{
+				ID: 7, Name: "Задник <Саламандер>", Slug: "zadnik-salamander", Description: "Плотный задник",
+				Price:  decimal.RequireFromString("150.5"),
+				Images: []Image{{URL: "http://localhost:8082/storage/images/b.jpg"}, {URL: "http://localhost:8082/storage/images/a.jpg", IsPrimary: true}},
+				Stock:  []StockItem{{OnHand: 3, Reserved: 3}},
+				Attributes: []Attribute{
+					{Name: "Толщина", Unit: "мм", Number: decimal.NewNullDecimal(decimal.RequireFromString("1.5"))},
+					{Name: "Цвет", Text: "бежевый"},
+				},
+				CategoryID: 2,
+			},
+			{
+				ID: 8, Name: "Стелька", Slug: "stelka", Price: decimal.NewFromInt(90),
+				ImageURL: sql.NullString{String: "http://localhost:8082/storage/images/c.jpg", Valid: true},
+			},
+		},
+	}
+}
+
+// xmlNode - элемент выгрузки как есть, без структур из yml.go и google.go: проверки не повторяют код, который проверяют
+type xmlNode struct {
+	Name     xml.Name
+	Attrs    map[string]string
+	Text     string
+	Children []*xmlNode
+}
+
+func parseXML(t *testing.T, data []byte) *xmlNode {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var stack []*xmlNode
+	var root *xmlNode
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		switch token := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{Name: token.Name, Attrs: map[string]string{}}
+			for _, attr := range token.Attr {
+				node.Attrs[attr.Name.Local] = attr.Value
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, node)
+			} else {
+				root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].Text += strings.TrimSpace(string(token))
+			}
+		}
+	}
+	require.NotNil(t, root)
+	return root
+}
+
+// all возвращает дочерние элементы с именем local из пространства space
+func (n *xmlNode) all(space, local string) []*xmlNode {
+	var nodes []*xmlNode
+	for _, child := range n.Children {
+		if child.Name.Space == space && child.Name.Local == local {
+			nodes = append(nodes, child)
+		}
+	}
+	return nodes
+}
+
+func (n *xmlNode) text(space, local string) string {
+	if nodes := n.all(space, local); len(nodes) > 0 {
+		return nodes[0].Text
+	}
+	return ""
+}
+
+func (n *xmlNode) texts(space, local string) []string {
+	var texts []string
+	for _, node := range n.all(space, local) {
+		texts = append(texts, node.Text)
+	}
+	return texts
+}
+
+// elementRules - обязательные и допустимые дочерние элементы по описанию формата
+type elementRules struct {
+	space    string
+	required []string
+	allowed  []string
+}
+
+func checkElements(t *testing.T, node *xmlNode, rules elementRules, path string) {
+	for _, name := range rules.required {
+		assert.NotEmpty(t, node.all(rules.space, name), "%s: missing %s", path, name)
+	}
+	allowed := map[string]bool{}
+	for _, name := range append(rules.required, rules.allowed...) {
+		allowed[name] = true
+	}
+	for _, child := range node.Children {
+		assert.True(t, child.Name.Space == rules.space && allowed[child.Name.Local], "%s: unexpected element %s %s", path, child.Name.Space, child.Name.Local)
+	}
+}
+
+// Элементы yml_catalog из описания формата YML Яндекс Маркета, упрощённый тип предложения
+var (
+	ymlShopRules = elementRules{
+		required: []string{"name", "company", "url", "currencies", "categories", "offers"},
+		allowed:  []string{"platform", "version", "agency", "email", "delivery-options", "pickup-options", "enable_auto_discounts", "gifts", "promos"},
+	}
+	ymlOfferRules = elementRules{
+		required: []string{"name", "url", "price", "currencyId", "categoryId"},
+		allowed: []string{"vendor", "vendorCode", "oldprice", "enable_auto_discounts", "picture", "supplier", "delivery", "pickup",
+			"delivery-options", "pickup-options", "store", "description", "sales_notes", "min-quantity", "manufacturer_warranty",
+			"country_of_origin", "adult", "barcode", "param", "condition", "expiry", "weight", "dimensions", "downloadable", "age", "count"},
+	}
+	ymlCurrencies = []string{"RUR", "RUB", "BYN", "KZT", "UAH", "USD", "EUR"}
+)
+
+var (
+	ymlID    = regexp.MustCompile(`^[0-9]{1,18}$`)
+	ymlPrice = regexp.MustCompile(`^[0-9]+(\.[0-9]{1,2})?$`)
+	absURL   = regexp.MustCompile(`^https?://[^\s]+$`)
+)
+
+// validateYML проверяет обязательные элементы YML и возвращает предложения по id
+func validateYML(t *testing.T, data []byte) (*xmlNode, map[string]*xmlNode) {
+	root := parseXML(t, data)
+	require.Equal(t, "yml_catalog", root.Name.Local)
+	_, err := time.Parse(ymlDateFormat, root.Attrs["date"])
+	assert.NoError(t, err, "yml_catalog@date")
+
+	shops := root.all("", "shop")
+	require.Len(t, shops, 1)
+	shop := shops[0]
+	checkElements(t, shop, ymlShopRules, "shop")
+	assert.NotEmpty(t, shop.text("", "name"))
+	assert.NotEmpty(t, shop.text("", "company"))
+	assert.Regexp(t, absURL, shop.text("", "url"))
+
+	currencies := map[string]bool{}
+	for _, currency := range shop.all("", "currencies")[0].all("", "currency") {
+		assert.Contains(t, ymlCurrencies, currency.Attrs["id"])
+		assert.NotEmpty(t, currency.Attrs["rate"])
+		currencies[currency.Attrs["id"]] = true
+	}
+	require.NotEmpty(t, currencies)
+
+	categories := map[string]bool{}
+	for _, category := range shop.all("", "categories")[0].all("", "category") {
+		assert.Regexp(t, ymlID, category.Attrs["id"])
+		assert.NotEmpty(t, category.Text)
+		categories[category.Attrs["id"]] = true
+	}
+	for _, category := range shop.all("", "categories")[0].all("", "category") {
+		if parentID, ok := category.Attrs["parentId"]; ok {
+			assert.True(t, categories[parentID], "parentId %s", parentID)
+		}
+	}
+
+	offers := map[string]*xmlNode{}
+	for _, offer := range shop.all("", "offers")[0].all("", "offer") {
+		id := offer.Attrs["id"]
+		assert.Regexp(t, regexp.MustCompile(`^[0-9A-Za-z]{1,20}$`), id)
+		assert.Nil(t, offers[id], "duplicate offer %s", id)
+		offers[id] = offer
+		checkElements(t, offer, ymlOfferRules, "offer "+id)
+		if available, ok := offer.Attrs["available"]; ok {
+			assert.Contains(t, []string{"true", "false"}, available)
+		}
+		assert.Regexp(t, absURL, offer.text("", "url"))
+		assert.Regexp(t, ymlPrice, offer.text("", "price"))
+		assert.True(t, currencies[offer.text("", "currencyId")], "currencyId %s", offer.text("", "currencyId"))
+		assert.True(t, categories[offer.text("", "categoryId")], "categoryId %s", offer.text("", "categoryId"))
+		for _, picture := range offer.texts("", "picture") {
+			assert.Regexp(t, absURL, picture)
+		}
+		for _, param := range offer.all("", "param") {
+			assert.NotEmpty(t, param.Attrs["name"])
+			assert.NotEmpty(t, param.Text)
+		}
+	}
+	return root, offers
+}
+
+// Атрибуты Google Merchant Center для товаров без GTIN
+var (
+	googleChannelRules = elementRules{required: []string{"title", "link", "description"}}
+	googleItemRules    = elementRules{
+		space:    googleNamespace,
+		required: []string{"id", "image_link", "availability", "price", "condition"},
+		allowed: []string{"title", "description", "link", "additional_image_link", "sale_price", "brand", "gtin", "mpn",
+			"identifier_exists", "product_type", "google_product_category", "product_detail", "shipping", "shipping_weight"},
+	}
+	googleProductDetailRules = elementRules{space: googleNamespace, required: []string{"attribute_name", "attribute_value"}, allowed: []string{"section_name"}}
+)
+
+// validateGoogle проверяет обязательные атрибуты Merchant Center и возвращает товары по g:id
+func validateGoogle(t *testing.T, data []byte) map[string]*xmlNode {
+	root := parseXML(t, data)
+	require.Equal(t, "rss", root.Name.Local)
+	assert.Equal(t, "2.0", root.Attrs["version"])
+
+	channels := root.all("", "channel")
+	require.Len(t, channels, 1)
+	channel := channels[0]
+	for _, name := range googleChannelRules.required {
+		assert.NotEmpty(t, channel.text("", name), "channel: missing %s", name)
+	}
+	assert.Regexp(t, absURL, channel.text("", "link"))
+
+	items := map[string]*xmlNode{}
+	for _, item := range channel.all("", "item") {
+		id := item.text(googleNamespace, "id")
+		assert.NotEmpty(t, id)
+		assert.LessOrEqual(t, len([]rune(id)), 50)
+		assert.Nil(t, items[id], "duplicate g:id %s", id)
+		items[id] = item
+
+		// title, link и description допускаются и как элементы RSS, и с префиксом g:
+		var rest xmlNode
+		for _, child := range item.Children {
+			if child.Name.Space == "" && (child.Name.Local == "title" || child.Name.Local == "link" || child.Name.Local == "description") {
+				continue
+			}
+			rest.Children = append(rest.Children, child)
+		}
+		checkElements(t, &rest, googleItemRules, "item "+id)
+		for _, detail := range item.all(googleNamespace, "product_detail") {
+			checkElements(t, detail, googleProductDetailRules, "item "+id+" product_detail")
+		}
+
+		title := item.text("", "title") + item.text(googleNamespace, "title")
+		assert.NotEmpty(t, title)
+		assert.LessOrEqual(t, len([]rune(title)), 150)
+		assert.NotEmpty(t, item.text("", "description")+item.text(googleNamespace, "description"))
+		assert.Regexp(t, absURL, item.text("", "link")+item.text(googleNamespace, "link"))
+		assert.Regexp(t, absURL, item.text(googleNamespace, "image_link"))
+		assert.LessOrEqual(t, len(item.all(googleNamespace, "additional_image_link")), 10)
+		assert.Contains(t, []string{"in_stock", "out_of_stock", "preorder", "backorder"}, item.text(googleNamespace, "availability"))
+		assert.Regexp(t, regexp.MustCompile(`^[0-9]+\.[0-9]{2} [A-Z]{3}$`), item.text(googleNamespace, "price"))
+		assert.Contains(t, []string{"new", "refurbished", "used"}, item.text(googleNamespace, "condition"))
+		assert.True(t, item.text(googleNamespace, "brand") != "" || item.text(googleNamespace, "identifier_exists") == "no")
+	}
+	return items
+}
+
+func TestWriteYML(t *testing.T) {
+	shop, catalog := testShop(), testCatalog()
+	var buf bytes.Buffer
+	require.NoError(t, WriteYML(&buf, shop, catalog, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)))
+
+	_, offers := validateYML(t, buf.Bytes())
+
+	// каждый товар каталога возвращается из выгрузки с теми же обязательными полями
+	require.Len(t, offers, len(catalog.Products))
+	for _, product := range catalog.Products {
+		offer := offers[strconv.Itoa(int(product.ID))]
+		require.NotNil(t, offer, "offer %d", product.ID)
+		assert.Equal(t, product.Name, offer.text("", "name"))
+		assert.Equal(t, shop.ProductURL(product), offer.text("", "url"))
+		assert.Equal(t, product.Price.StringFixed(2), offer.text("", "price"))
+		assert.Equal(t, strconv.FormatBool(product.Available()), offer.Attrs["available"])
+	}
+
+	offer := offers["7"]
+	assert.Equal(t, "150.50", offer.text("", "price"))
+	assert.Equal(t, "2", offer.text("", "categoryId"))
+	assert.Equal(t, []string{"https://zadnik.store/storage/images/a.jpg", "https://zadnik.store/storage/images/b.jpg"}, offer.texts("", "picture"))
+	params := offer.all("", "param")
+	require.Len(t, params, 2)
+	assert.Equal(t, "мм", params[0].Attrs["unit"])
+	assert.Equal(t, "1.5", params[0].Text)
+
+	// товар без раздела попадает в служебный раздел
+	assert.Equal(t, "999999999", offers["8"].text("", "categoryId"))
+}
+
+func TestWriteGoogle(t *testing.T) {
+	shop, catalog := testShop(), testCatalog()
+	var buf bytes.Buffer
+	require.NoError(t, WriteGoogle(&buf, shop, catalog))
+
+	items := validateGoogle(t, buf.Bytes())
+
+	require.Len(t, items, len(catalog.Products))
+	for _, product := range catalog.Products {
+		item := items[strconv.Itoa(int(product.ID))]
+		require.NotNil(t, item, "item %d", product.ID)
+		assert.Equal(t, product.Name, item.text("", "title"))
+		assert.Equal(t, shop.ProductURL(product), item.text("", "link"))
+		assert.Equal(t, product.Price.StringFixed(2)+" RUB", item.text(googleNamespace, "price"))
+	}
+
+	item := items["7"]
+	assert.Equal(t, "out_of_stock", item.text(googleNamespace, "availability"))
+	assert.Equal(t, "Задники > Кожкартон & термопласт", item.text(googleNamespace, "product_type"))
+	assert.Equal(t, "https://zadnik.store/storage/images/a.jpg", item.text(googleNamespace, "image_link"))
+	assert.Equal(t, []string{"https://zadnik.store/storage/images/b.jpg"}, item.texts(googleNamespace, "additional_image_link"))
+	details := item.all(googleNamespace, "product_detail")
+	require.NotEmpty(t, details)
+	assert.Equal(t, "1.5 мм", details[0].text(googleNamespace, "attribute_value"))
+
+	// без описания Google отклоняет товар, поэтому подставляется название
+	assert.Equal(t, "Стелька", items["8"].text("", "description"))
+	assert.Equal(t, "https://zadnik.store/storage/images/c.jpg", items["8"].text(googleNamespace, "image_link"))
+}
+
+func TestSourceLoad(t *testing.T) {
+	var requests []string
+	service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path+"?"+r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/categories":
+			json.NewEncoder(w).Encode([]productapi.Category{
+				{Id: 1, Name: "Задники", Slug: "zadniki"},
+				{Id: 2, ParentId: int32Ptr(1), Name: "Детские", Slug: "detskie"},
+			})
+		case "/products":
+			// две страницы: на второй товар без раздела
+			page := productapi.ProductPage{Total: 2, Limit: 1}
+			product := productapi.Product{Id: 7, Name: "Задник", Slug: "zadnik", Price: "150", CategoryIds: &[]int32{1, 2}}
+			if r.URL.Query().Get("offset") == "1" {
+				product = productapi.Product{Id: 8, Name: "Стелька", Slug: "stelka", Price: "90"}
+				page.Offset = 1
+			}
+			page.Items = &[]productapi.Product{product}
+			json.NewEncoder(w).Encode(page)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer service.Close()
+
+	api, err := productapi.NewClientWithResponses(service.URL, productapi.WithHTTPClient(service.Client()))
+	require.NoError(t, err)
+
+	catalog, err := NewSource(api).Load(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, catalog.Products, 2)
+	assert.Equal(t, int32(2), catalog.Products[0].CategoryID, "deepest category of the product")
+	assert.Equal(t, int32(0), catalog.Products[1].CategoryID)
+	assert.Len(t, catalog.Categories, 2)
+
+	// разделы и товары читаются по одному разу, без прохода по каждому разделу
+	require.Len(t, requests, 3)
+	for _, request := range requests[1:] {
+		assert.True(t, strings.HasPrefix(request, "/products?"), request)
+		assert.NotContains(t, request, "category_id")
+	}
+}
+
+func TestServiceHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	service, err := NewService(Config{Dir: t.TempDir(), Interval: time.Hour}, nil, common.NewSimpleLogger())
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.GET("/feeds/google.xml", service.Handler(GoogleFile, "application/xml; charset=utf-8"))
+
+	t.Run("not generated yet", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/feeds/google.xml", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	require.NoError(t, service.store(GoogleFile, []byte("<rss/>")))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/feeds/google.xml", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "<rss/>", w.Body.String())
+	tag := w.Header().Get("ETag")
+	assert.NotEmpty(t, tag)
+
+	t.Run("not modified", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/feeds/google.xml", nil)
+		req.Header.Set("If-None-Match", tag)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+	})
+
+	t.Run("changed content gets new etag", func(t *testing.T) {
+		require.NoError(t, service.store(GoogleFile, []byte("<rss version=\"2.0\"/>")))
+		req := httptest.NewRequest(http.MethodGet, "/feeds/google.xml", nil)
+		req.Header.Set("If-None-Match", tag)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEqual(t, tag, w.Header().Get("ETag"))
+	})
+}
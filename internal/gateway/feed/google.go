@@ -0,0 +1,126 @@
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	googleNamespace = "http://base.google.com/ns/1.0"
+	// maxGoogleAdditionalImages - Merchant Center принимает не больше 10 дополнительных изображений
+	maxGoogleAdditionalImages = 10
+	maxGoogleDescriptionLength = 5000
+)
+
+type googleRSS struct {
+	XMLName xml.Name      `xml:"rss"`
+	Version string        `xml:"version,attr"`
+	XMLNS   string        `xml:"xmlns:g,attr"`
+	Channel googleChannel `xml:"channel"`
+}
+
+type googleChannel struct {
+	Title       string       `xml:"title"`
+	Link        string       `xml:"link"`
+	Description string       `xml:"description"`
+	Items       []googleItem `xml:"item"`
+}
+
+type googleItem struct {
+	ID                   string                `xml:"g:id"`
+	Title                string                `xml:"title"`
+	Description          string                `xml:"description"`
+	Link                 string                `xml:"link"`
+	ImageLink            string                `xml:"g:image_link,omitempty"`
+	AdditionalImageLinks []string              `xml:"g:additional_image_link"`
+	Availability         string                `xml:"g:availability"`
+	Price                string                `xml:"g:price"`
+	Condition            string                `xml:"g:condition"`
+	Brand                string                `xml:"g:brand"`
+	IdentifierExists     string                `xml:"g:identifier_exists"`
+	ProductType          string                `xml:"g:product_type,omitempty"`
+	ProductDetails       []googleProductDetail `xml:"g:product_detail"`
+}
+
+type googleProductDetail struct {
+	AttributeName  string `xml:"g:attribute_name"`
+	AttributeValue string `xml:"g:attribute_value"`
+}
+
+// WriteGoogle пишет каталог в формате RSS 2.0 для Google Merchant Center
+func WriteGoogle(w io.Writer, shop Shop, catalog *Catalog) error {
+	doc := googleRSS{
+		Version: "2.0",
+		XMLNS:   googleNamespace,
+		Channel: googleChannel{
+			Title:       shop.Name,
+			Link:        shop.SiteURL,
+			Description: shop.Company,
+		},
+	}
+
+	paths := categoryPaths(catalog.Categories)
+	for _, product := range catalog.Products {
+		// g:id - ID товара, а не адрес: после смены адреса Merchant Center не должен считать товар новым
+		item := googleItem{
+			ID:               strconv.Itoa(int(product.ID)),
+			Title:            product.Name,
+			Description:      truncate(product.Description, maxGoogleDescriptionLength),
+			Link:             shop.ProductURL(product),
+			Availability:     "out_of_stock",
+			Price:            product.Price.StringFixed(2) + " RUB",
+			Condition:        "new",
+			Brand:            shop.Name,
+			IdentifierExists: "no",
+			ProductType:      paths[product.CategoryID],
+		}
+		if item.Description == "" {
+			item.Description = product.Name
+		}
+		if product.Available() {
+			item.Availability = "in_stock"
+		}
+		for i, image := range product.ImageURLs() {
+			if i == 0 {
+				item.ImageLink = shop.ImageURL(image)
+			} else if len(item.AdditionalImageLinks) < maxGoogleAdditionalImages {
+				item.AdditionalImageLinks = append(item.AdditionalImageLinks, shop.ImageURL(image))
+			}
+		}
+		for _, attribute := range product.Attributes {
+			value := attribute.Value()
+			if attribute.Unit != "" {
+				value += " " + attribute.Unit
+			}
+			item.ProductDetails = append(item.ProductDetails, googleProductDetail{AttributeName: attribute.Name, AttributeValue: value})
+		}
+		doc.Channel.Items = append(doc.Channel.Items, item)
+	}
+
+	return writeXML(w, doc)
+}
+
+// categoryPaths строит для разделов путь вида «Задники > Кожкартон» для g:product_type
+func categoryPaths(categories []Category) map[int32]string {
+	byID := make(map[int32]Category, len(categories))
+	for _, category := range categories {
+		byID[category.ID] = category
+	}
+
+	paths := make(map[int32]string, len(categories))
+	for _, category := range categories {
+		names := []string{category.Name}
+		for parent := category.ParentID; parent != nil && len(names) <= len(categories); {
+			parentCategory, ok := byID[*parent]
+			if !ok {
+				break
+			}
+			names = append([]string{parentCategory.Name}, names...)
+			parent = parentCategory.ParentID
+		}
+		paths[category.ID] = strings.Join(names, " > ")
+	}
+	return paths
+}
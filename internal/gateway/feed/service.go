@@ -0,0 +1,300 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	YMLFile    = "yandex.yml"
+	GoogleFile = "google.xml"
+
+	// regenerateDelay собирает несколько событий подряд, например строки импорта, в одну перегенерацию
+	regenerateDelay = 15 * time.Second
+)
+
+// Shop - данные магазина, из которых строятся ссылки в фидах
+type Shop struct {
+	Name    string
+	Company string
+	// SiteURL - адрес витрины без завершающего слеша
+	SiteURL string
+	// ImageBaseURL - публичный адрес каталога изображений (IMAGE_BASE_URL); пустой - адреса из сервиса товаров как есть
+	ImageBaseURL string
+}
+
+func (s Shop) ProductURL(product Product) string {
+	return s.SiteURL + "/products/" + url.PathEscape(product.Slug)
+}
+
+// ImageURL переносит файл изображения на IMAGE_BASE_URL: в сервисе товаров может храниться внутренний адрес
+func (s Shop) ImageURL(imageURL string) string {
+	if s.ImageBaseURL == "" {
+		if strings.HasPrefix(imageURL, "/") {
+			return s.SiteURL + imageURL
+		}
+		return imageURL
+	}
+	return strings.TrimRight(s.ImageBaseURL, "/") + "/" + path.Base(imageURL)
+}
+
+type Config struct {
+	Shop Shop
+	// Dir - каталог, куда сохраняются готовые фиды
+	Dir string
+	// Interval - период перегенерации по расписанию
+	Interval time.Duration
+}
+
+var sitemapPartName = regexp.MustCompile(`^sitemap-[0-9]+\.xml$`)
+
+type cachedFile struct {
+	etag    string
+	modTime time.Time
+}
+
+// Service строит фиды и карту сайта по расписанию и по событиям товаров и отдаёт их с диска
+type Service struct {
+	cfg     Config
+	source  *Source
+	logger  common.Logger
+	trigger chan struct{}
+
+	mu    sync.RWMutex
+	files map[string]cachedFile
+	// ymlContent - хеш YML без даты выгрузки, чтобы не менять ETag, когда каталог не менялся
+	ymlContent string
+}
+
+func NewService(cfg Config, source *Source, logger common.Logger) (*Service, error) {
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create feed dir: %w", err)
+	}
+	s := &Service{
+		cfg:     cfg,
+		source:  source,
+		logger:  logger,
+		trigger: make(chan struct{}, 1),
+		files:   make(map[string]cachedFile),
+	}
+
+	// файлы с прошлого запуска отдаются, пока не построены новые
+	parts, _ := filepath.Glob(filepath.Join(cfg.Dir, "sitemap-*.xml"))
+	names := []string{YMLFile, GoogleFile, SitemapFile}
+	for _, part := range parts {
+		names = append(names, filepath.Base(part))
+	}
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(cfg.Dir, name))
+		if err != nil {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(cfg.Dir, name))
+		if err != nil {
+			continue
+		}
+		s.files[name] = cachedFile{etag: etag(data), modTime: info.ModTime()}
+	}
+	return s, nil
+}
+
+// Trigger просит перегенерировать фиды; повторные вызовы до перегенерации объединяются
+func (s *Service) Trigger() {
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Run строит фиды сразу, затем по расписанию и после событий, пока не отменён ctx
+func (s *Service) Run(ctx context.Context) {
+	s.regenerate(ctx)
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.regenerate(ctx)
+		case <-s.trigger:
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(regenerateDelay):
+			}
+			s.regenerate(ctx)
+		}
+	}
+}
+
+func (s *Service) regenerate(ctx context.Context) {
+	if err := s.Generate(ctx); err != nil {
+		s.logger.Errorf("Failed to generate feeds: %v", err)
+	}
+}
+
+// Generate загружает каталог и перезаписывает фиды и карту сайта
+func (s *Service) Generate(ctx context.Context) error {
+	catalog, err := s.source.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	var yml, stable, google bytes.Buffer
+	if err := WriteYML(&yml, s.cfg.Shop, catalog, time.Now()); err != nil {
+		return fmt.Errorf("failed to build yml feed: %w", err)
+	}
+	if err := WriteYML(&stable, s.cfg.Shop, catalog, time.Time{}); err != nil {
+		return fmt.Errorf("failed to build yml feed: %w", err)
+	}
+	if err := WriteGoogle(&google, s.cfg.Shop, catalog); err != nil {
+		return fmt.Errorf("failed to build google feed: %w", err)
+	}
+
+	if content := etag(stable.Bytes()); content != s.ymlContent {
+		if err := s.store(YMLFile, yml.Bytes()); err != nil {
+			return err
+		}
+		s.ymlContent = content
+	}
+	if err := s.store(GoogleFile, google.Bytes()); err != nil {
+		return err
+	}
+	if err := s.storeSitemaps(catalog); err != nil {
+		return err
+	}
+	s.logger.Infof("Generated feeds and sitemap: %d products", len(catalog.Products))
+	return nil
+}
+
+// storeSitemaps записывает карту сайта и удаляет части, которые больше не нужны
+func (s *Service) storeSitemaps(catalog *Catalog) error {
+	files, err := BuildSitemaps(s.cfg.Shop, SitemapURLs(s.cfg.Shop, catalog), maxSitemapURLs)
+	if err != nil {
+		return err
+	}
+	for name, data := range files {
+		if err := s.store(name, data); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for name := range s.files {
+		if _, ok := files[name]; ok || !sitemapPartName.MatchString(name) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.cfg.Dir, name)); err != nil && !os.IsNotExist(err) {
+			s.logger.Warnf("Failed to remove stale %s: %v", name, err)
+		}
+		delete(s.files, name)
+	}
+	return nil
+}
+
+// store атомарно заменяет файл фида; файл с тем же содержимым не трогается, чтобы не менять Last-Modified
+func (s *Service) store(name string, data []byte) error {
+	tag := etag(data)
+	s.mu.RLock()
+	current, ok := s.files[name]
+	s.mu.RUnlock()
+	if ok && current.etag == tag {
+		return nil
+	}
+
+	target := filepath.Join(s.cfg.Dir, name)
+	tmp, err := os.CreateTemp(s.cfg.Dir, name+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		s.logger.Warnf("Failed to chmod %s: %v", tmp.Name(), err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to replace %s: %w", name, err)
+	}
+
+	s.mu.Lock()
+	s.files[name] = cachedFile{etag: tag, modTime: time.Now()}
+	s.mu.Unlock()
+	return nil
+}
+
+// Handler отдаёт файл фида с ETag; If-None-Match обрабатывает http.ServeContent
+func (s *Service) Handler(name, contentType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		s.serve(c, name, contentType)
+	}
+}
+
+// SitemapPartHandler отдаёт части карты сайта по параметру :name
+func (s *Service) SitemapPartHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Param("name")
+		if !sitemapPartName.MatchString(name) {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		s.serve(c, name, "application/xml; charset=utf-8")
+	}
+}
+
+func (s *Service) serve(c *gin.Context, name, contentType string) {
+	s.mu.RLock()
+	file, ok := s.files[name]
+	s.mu.RUnlock()
+	if !ok {
+		if sitemapPartName.MatchString(name) {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		c.String(http.StatusServiceUnavailable, "Feed is not generated yet")
+		return
+	}
+
+	f, err := os.Open(filepath.Join(s.cfg.Dir, name))
+	if err != nil {
+		s.logger.Errorf("Failed to open feed %s: %v", name, err)
+		c.String(http.StatusServiceUnavailable, "Feed is not available")
+		return
+	}
+	defer f.Close()
+
+	c.Header("Content-Type", contentType)
+	c.Header("ETag", file.etag)
+	c.Header("Cache-Control", "no-cache")
+	http.ServeContent(c.Writer, c.Request, name, file.modTime, f)
+}
+
+func etag(data []byte) string {
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
@@ -0,0 +1,156 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	SitemapFile      = "sitemap.xml"
+	// SitemapPartFormat - части карты сайта, когда адресов больше maxSitemapURLs
+	SitemapPartFormat = "sitemap-%d.xml"
+	SitemapPartsPath  = "/sitemaps/"
+	// maxSitemapURLs - ограничение протокола sitemaps.org на один файл
+	maxSitemapURLs = 50000
+)
+
+// StaticPages - страницы витрины вне каталога
+var StaticPages = []string{"/", "/delivery", "/payment", "/guarantee", "/policy"}
+
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	URLs    []SitemapURL `xml:"url"`
+}
+
+type SitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name       `xml:"sitemapindex"`
+	XMLNS    string         `xml:"xmlns,attr"`
+	Sitemaps []sitemapEntry `xml:"sitemap"`
+}
+
+type sitemapEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// SitemapURLs собирает адреса статических страниц, разделов и активных товаров.
+// lastmod раздела - самое позднее изменение его самого и товаров в нём и в подразделах
+func SitemapURLs(shop Shop, catalog *Catalog) []SitemapURL {
+	parents := make(map[int32]*int32, len(catalog.Categories))
+	lastmod := make(map[int32]time.Time, len(catalog.Categories))
+	for _, category := range catalog.Categories {
+		parents[category.ID] = category.ParentID
+		lastmod[category.ID] = category.UpdatedAt
+	}
+
+	var latest time.Time
+	for _, product := range catalog.Products {
+		if product.UpdatedAt.After(latest) {
+			latest = product.UpdatedAt
+		}
+		id, depth := product.CategoryID, 0
+		for id != 0 && depth <= len(catalog.Categories) {
+			if product.UpdatedAt.After(lastmod[id]) {
+				lastmod[id] = product.UpdatedAt
+			}
+			parent := parents[id]
+			if parent == nil {
+				break
+			}
+			id, depth = *parent, depth+1
+		}
+	}
+
+	urls := make([]SitemapURL, 0, len(StaticPages)+len(catalog.Categories)+len(catalog.Products))
+	for _, page := range StaticPages {
+		item := SitemapURL{Loc: shop.SiteURL + page}
+		// главная показывает список товаров и меняется вместе с ним
+		if page == "/" {
+			item.LastMod = w3cDate(latest)
+		}
+		urls = append(urls, item)
+	}
+	for _, category := range catalog.Categories {
+		urls = append(urls, SitemapURL{
+			Loc:     shop.SiteURL + "/catalog/" + url.PathEscape(category.Slug),
+			LastMod: w3cDate(lastmod[category.ID]),
+		})
+	}
+	for _, product := range catalog.Products {
+		urls = append(urls, SitemapURL{Loc: shop.ProductURL(product), LastMod: w3cDate(product.UpdatedAt)})
+	}
+	return urls
+}
+
+// BuildSitemaps возвращает файлы карты сайта по именам. Пока адресов не больше limit,
+// это один sitemap.xml; иначе sitemap.xml становится индексом частей sitemap-N.xml
+func BuildSitemaps(shop Shop, urls []SitemapURL, limit int) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	if len(urls) <= limit {
+		data, err := marshalSitemap(sitemapURLSet{XMLNS: sitemapNamespace, URLs: urls})
+		if err != nil {
+			return nil, err
+		}
+		files[SitemapFile] = data
+		return files, nil
+	}
+
+	index := sitemapIndex{XMLNS: sitemapNamespace}
+	for start, part := 0, 1; start < len(urls); start, part = start+limit, part+1 {
+		end := min(start+limit, len(urls))
+		data, err := marshalSitemap(sitemapURLSet{XMLNS: sitemapNamespace, URLs: urls[start:end]})
+		if err != nil {
+			return nil, err
+		}
+		name := fmt.Sprintf(SitemapPartFormat, part)
+		files[name] = data
+		index.Sitemaps = append(index.Sitemaps, sitemapEntry{
+			Loc:     shop.SiteURL + SitemapPartsPath + name,
+			LastMod: latestLastMod(urls[start:end]),
+		})
+	}
+
+	data, err := marshalSitemap(index)
+	if err != nil {
+		return nil, err
+	}
+	files[SitemapFile] = data
+	return files, nil
+}
+
+func marshalSitemap(doc interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeXML(&buf, doc); err != nil {
+		return nil, fmt.Errorf("failed to build sitemap: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func latestLastMod(urls []SitemapURL) string {
+	latest := ""
+	for _, item := range urls {
+		// даты в одном формате и одном часовом поясе сравниваются как строки
+		if item.LastMod > latest {
+			latest = item.LastMod
+		}
+	}
+	return latest
+}
+
+// w3cDate - формат lastmod из протокола sitemaps.org
+func w3cDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
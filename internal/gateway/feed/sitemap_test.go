@@ -0,0 +1,113 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Элементы и ограничения протокола sitemaps.org
+type sitemapSchema struct {
+	XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"url"`
+}
+
+type sitemapIndexSchema struct {
+	XMLName  xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+	Sitemaps []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"sitemap"`
+}
+
+var w3cDateTime = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}(Z|[+-][0-9]{2}:[0-9]{2})$`)
+
+func validateSitemap(t *testing.T, data []byte) sitemapSchema {
+	var doc sitemapSchema
+	require.NoError(t, xml.Unmarshal(data, &doc))
+
+	assert.LessOrEqual(t, len(doc.URLs), maxSitemapURLs)
+	assert.LessOrEqual(t, len(data), 50*1024*1024)
+	for _, item := range doc.URLs {
+		assert.Regexp(t, absURL, item.Loc)
+		assert.LessOrEqual(t, len(item.Loc), 2048)
+		if item.LastMod != "" {
+			assert.Regexp(t, w3cDateTime, item.LastMod)
+		}
+	}
+	return doc
+}
+
+func TestSitemapURLs(t *testing.T) {
+	catalog := testCatalog()
+	catalog.Categories[0].UpdatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	catalog.Categories[1].UpdatedAt = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	catalog.Products[0].UpdatedAt = time.Date(2024, 3, 1, 10, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	catalog.Products[1].UpdatedAt = time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	urls := SitemapURLs(testShop(), catalog)
+	lastmod := map[string]string{}
+	for _, item := range urls {
+		lastmod[item.Loc] = item.LastMod
+	}
+
+	require.Len(t, urls, len(StaticPages)+2+2)
+	for _, page := range []string{"/delivery", "/payment", "/guarantee", "/policy"} {
+		assert.Contains(t, lastmod, "https://zadnik.store"+page)
+	}
+	assert.Equal(t, "2024-04-01T00:00:00Z", lastmod["https://zadnik.store/"])
+	assert.Equal(t, "2024-03-01T07:00:00Z", lastmod["https://zadnik.store/products/zadnik-salamander"])
+
+	// изменение товара в подразделе поднимает lastmod и у родительского раздела
+	assert.Equal(t, "2024-03-01T07:00:00Z", lastmod["https://zadnik.store/catalog/kozhkarton"])
+	assert.Equal(t, "2024-03-01T07:00:00Z", lastmod["https://zadnik.store/catalog/zadniki"])
+}
+
+func TestBuildSitemaps(t *testing.T) {
+	shop := testShop()
+
+	t.Run("single file", func(t *testing.T) {
+		files, err := BuildSitemaps(shop, SitemapURLs(shop, testCatalog()), maxSitemapURLs)
+		require.NoError(t, err)
+
+		require.Len(t, files, 1)
+		doc := validateSitemap(t, files[SitemapFile])
+		assert.Len(t, doc.URLs, len(StaticPages)+4)
+	})
+
+	t.Run("index when over limit", func(t *testing.T) {
+		var urls []SitemapURL
+		for i := 1; i <= 5; i++ {
+			urls = append(urls, SitemapURL{
+				Loc:     fmt.Sprintf("https://zadnik.store/products/p%d", i),
+				LastMod: w3cDate(time.Date(2024, 1, i, 0, 0, 0, 0, time.UTC)),
+			})
+		}
+
+		files, err := BuildSitemaps(shop, urls, 2)
+		require.NoError(t, err)
+		require.Len(t, files, 4)
+
+		var index sitemapIndexSchema
+		require.NoError(t, xml.Unmarshal(files[SitemapFile], &index))
+		require.Len(t, index.Sitemaps, 3)
+		assert.Equal(t, "https://zadnik.store/sitemaps/sitemap-1.xml", index.Sitemaps[0].Loc)
+		assert.Equal(t, "2024-01-02T00:00:00Z", index.Sitemaps[0].LastMod)
+		assert.Equal(t, "https://zadnik.store/sitemaps/sitemap-3.xml", index.Sitemaps[2].Loc)
+
+		total := 0
+		for i := 1; i <= 3; i++ {
+			doc := validateSitemap(t, files[fmt.Sprintf("sitemap-%d.xml", i)])
+			total += len(doc.URLs)
+		}
+		assert.Equal(t, len(urls), total)
+	})
+}
@@ -0,0 +1,137 @@
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+)
+
+// ymlDateFormat - формат атрибута date в yml_catalog
+const ymlDateFormat = "2006-01-02T15:04:05-07:00"
+
+// uncategorizedID - раздел для товаров без раздела: в YML у каждого предложения должен быть categoryId
+const uncategorizedID int32 = 999999999
+
+const maxYMLDescriptionLength = 3000
+
+type ymlCatalog struct {
+	XMLName xml.Name `xml:"yml_catalog"`
+	Date    string   `xml:"date,attr"`
+	Shop    ymlShop  `xml:"shop"`
+}
+
+type ymlShop struct {
+	Name       string        `xml:"name"`
+	Company    string        `xml:"company"`
+	URL        string        `xml:"url"`
+	Currencies []ymlCurrency `xml:"currencies>currency"`
+	Categories []ymlCategory `xml:"categories>category"`
+	Offers     []ymlOffer    `xml:"offers>offer"`
+}
+
+type ymlCurrency struct {
+	ID   string `xml:"id,attr"`
+	Rate string `xml:"rate,attr"`
+}
+
+type ymlCategory struct {
+	ID       int32  `xml:"id,attr"`
+	ParentID string `xml:"parentId,attr,omitempty"`
+	Name     string `xml:",chardata"`
+}
+
+type ymlOffer struct {
+	ID          int32      `xml:"id,attr"`
+	Available   bool       `xml:"available,attr"`
+	Name        string     `xml:"name"`
+	URL         string     `xml:"url"`
+	Price       string     `xml:"price"`
+	CurrencyID  string     `xml:"currencyId"`
+	CategoryID  int32      `xml:"categoryId"`
+	Pictures    []string   `xml:"picture"`
+	Description string     `xml:"description,omitempty"`
+	Params      []ymlParam `xml:"param"`
+}
+
+type ymlParam struct {
+	Name  string `xml:"name,attr"`
+	Unit  string `xml:"unit,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+// WriteYML пишет каталог в формате YML (yml_catalog) для Яндекс Маркета
+func WriteYML(w io.Writer, shop Shop, catalog *Catalog, now time.Time) error {
+	doc := ymlCatalog{
+		Date: now.Format(ymlDateFormat),
+		Shop: ymlShop{
+			Name:       shop.Name,
+			Company:    shop.Company,
+			URL:        shop.SiteURL,
+			Currencies: []ymlCurrency{{ID: "RUR", Rate: "1"}},
+		},
+	}
+
+	known := make(map[int32]bool, len(catalog.Categories))
+	for _, category := range catalog.Categories {
+		known[category.ID] = true
+	}
+	for _, category := range catalog.Categories {
+		item := ymlCategory{ID: category.ID, Name: category.Name}
+		if category.ParentID != nil && known[*category.ParentID] {
+			item.ParentID = strconv.Itoa(int(*category.ParentID))
+		}
+		doc.Shop.Categories = append(doc.Shop.Categories, item)
+	}
+
+	hasUncategorized := false
+	for _, product := range catalog.Products {
+		offer := ymlOffer{
+			ID:          product.ID,
+			Available:   product.Available(),
+			Name:        product.Name,
+			URL:         shop.ProductURL(product),
+			Price:       product.Price.StringFixed(2),
+			CurrencyID:  "RUR",
+			CategoryID:  product.CategoryID,
+			Description: truncate(product.Description, maxYMLDescriptionLength),
+		}
+		if offer.CategoryID == 0 {
+			offer.CategoryID = uncategorizedID
+			hasUncategorized = true
+		}
+		for _, image := range product.ImageURLs() {
+			offer.Pictures = append(offer.Pictures, shop.ImageURL(image))
+		}
+		for _, attribute := range product.Attributes {
+			offer.Params = append(offer.Params, ymlParam{Name: attribute.Name, Unit: attribute.Unit, Value: attribute.Value()})
+		}
+		doc.Shop.Offers = append(doc.Shop.Offers, offer)
+	}
+	if hasUncategorized {
+		doc.Shop.Categories = append(doc.Shop.Categories, ymlCategory{ID: uncategorizedID, Name: "Другие товары"})
+	}
+
+	return writeXML(w, doc)
+}
+
+func writeXML(w io.Writer, doc interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func truncate(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit-1]) + "…"
+}
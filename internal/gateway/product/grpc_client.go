@@ -0,0 +1,56 @@
+package product
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/Nzyazin/zadnik.store/api/generated/product"
+)
+
+// requestTimeout - сколько ждать ответа сервиса товаров, если у запроса нет своего срока; поток WatchProducts не ограничивается
+const requestTimeout = 9 * time.Second
+
+// NewGRPCClient подключается к gRPC API сервиса товаров; ключ API передаётся в метаданных каждого вызова
+func NewGRPCClient(addr, apiKey string) (pb.ProductServiceClient, error) {
+	withKey := func(ctx context.Context) context.Context {
+		return metadata.AppendToOutgoingContext(ctx, "x-api-key", apiKey)
+	}
+
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			if _, ok := ctx.Deadline(); !ok {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, requestTimeout)
+				defer cancel()
+			}
+			return invoker(withKey(ctx), method, req, reply, cc, opts...)
+		}),
+		grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(withKey(ctx), desc, cc, method, opts...)
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewProductServiceClient(conn), nil
+}
+
+// Decimal разбирает сумму из ответа сервиса товаров; суммы там всегда корректны, поэтому ошибка даёт ноль
+func Decimal(value string) decimal.Decimal {
+	d, _ := decimal.NewFromString(value)
+	return d
+}
+
+// NullDecimal - необязательная сумма или число: nil - значение не задано
+func NullDecimal(value *string) decimal.NullDecimal {
+	if value == nil {
+		return decimal.NullDecimal{}
+	}
+	return decimal.NewNullDecimal(Decimal(*value))
+}
@@ -0,0 +1,32 @@
+package product
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/api/generated/productapi"
+)
+
+// catalogRequestTimeout - импорт, выгрузка и полный обход каталога идут дольше обычного запроса
+const catalogRequestTimeout = 2 * time.Minute
+
+// NewHTTPClient - клиент HTTP API сервиса товаров, сгенерированный из api/openapi/product.yaml
+func NewHTTPClient(url, apiKey string) (*productapi.ClientWithResponses, error) {
+	return newHTTPClient(url, apiKey, requestTimeout)
+}
+
+// NewCatalogHTTPClient - тот же клиент с долгим таймаутом для операций над всем каталогом
+func NewCatalogHTTPClient(url, apiKey string) (*productapi.ClientWithResponses, error) {
+	return newHTTPClient(url, apiKey, catalogRequestTimeout)
+}
+
+func newHTTPClient(url, apiKey string, timeout time.Duration) (*productapi.ClientWithResponses, error) {
+	return productapi.NewClientWithResponses(url,
+		productapi.WithHTTPClient(&http.Client{Timeout: timeout}),
+		productapi.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("X-API-KEY", apiKey)
+			return nil
+		}),
+	)
+}
@@ -0,0 +1,46 @@
+package gateway
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Nzyazin/zadnik.store/internal/gateway/feed"
+)
+
+// RobotsConfig - правила robots.txt; /admin закрыт всегда
+type RobotsConfig struct {
+	// Disallow - дополнительные закрытые пути, например /search
+	Disallow []string
+	// DisallowAll закрывает весь сайт, например на тестовом стенде
+	DisallowAll bool
+}
+
+// robotsTXT строит robots.txt со ссылкой на карту сайта
+func robotsTXT(cfg RobotsConfig, siteURL string) string {
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	if cfg.DisallowAll {
+		b.WriteString("Disallow: /\n")
+	} else {
+		seen := map[string]bool{}
+		for _, path := range append([]string{"/admin"}, cfg.Disallow...) {
+			path = strings.TrimSpace(path)
+			if path == "" || seen[path] {
+				continue
+			}
+			seen[path] = true
+			b.WriteString("Disallow: " + path + "\n")
+		}
+	}
+	b.WriteString("\nSitemap: " + siteURL + "/" + feed.SitemapFile + "\n")
+	return b.String()
+}
+
+func robotsHandler(cfg RobotsConfig, siteURL string) gin.HandlerFunc {
+	body := robotsTXT(cfg, siteURL)
+	return func(c *gin.Context) {
+		c.String(http.StatusOK, body)
+	}
+}
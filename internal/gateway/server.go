@@ -19,7 +19,9 @@ import (
 	"github.com/Nzyazin/zadnik.store/internal/broker"
 	"github.com/Nzyazin/zadnik.store/internal/gateway/admin"
 	"github.com/Nzyazin/zadnik.store/internal/gateway/client"
+	"github.com/Nzyazin/zadnik.store/internal/gateway/feed"
 	"github.com/Nzyazin/zadnik.store/internal/gateway/auth"
+	"github.com/Nzyazin/zadnik.store/internal/gateway/product"
 	"github.com/Nzyazin/zadnik.store/internal/gateway/middleware"
 	admin_templates "github.com/Nzyazin/zadnik.store/internal/templates/admin-templates"
 	client_templates "github.com/Nzyazin/zadnik.store/internal/templates/client-templates"
@@ -32,14 +34,35 @@ type SMTPConfig struct {
 	Password string
 }
 
+// FeedConfig - фиды товаров для Яндекс Маркета и Google Merchant
+type FeedConfig struct {
+	ShopName string
+	Company string
+	// ImageBaseURL - публичный адрес изображений, как IMAGE_BASE_URL сервиса изображений
+	ImageBaseURL string
+	Dir string
+	Interval time.Duration
+}
+
 type ServerConfig struct {
 	AuthServiceAddr string
 	ProductServiceAddr string
+	// ProductServiceGRPCAddr - gRPC API сервиса товаров, через него читаются товары
+	ProductServiceGRPCAddr string
 	ProductServiceAPIKey string
 	Development    bool
 	UseHTTPS      bool
 	RabbitMQ broker.RabbitMQConfig
 	SMTPConfig SMTPConfig
+	// SiteURL - публичный адрес витрины без завершающего слеша, от него строятся абсолютные ссылки
+	SiteURL string
+	// TrustedProxies - адреса и подсети прокси перед gateway; только им верим в X-Forwarded-For.
+	// Пусто - адрес покупателя берётся из соединения
+	TrustedProxies []string
+	// ReviewKeySecret - секрет, на котором хешируются адреса авторов отзывов
+	ReviewKeySecret string
+	Feed FeedConfig
+	Robots RobotsConfig
 	CertFile string
 	KeyFile string
 	LOG_FILE string
@@ -50,6 +73,23 @@ type Server struct {
 	cfg    *ServerConfig
 	messageBroker broker.MessageBroker
 	httpServer *http.Server
+	// background - фоновые задачи сервера, останавливаются в Shutdown
+	background context.Context
+	stopBackground context.CancelFunc
+}
+
+// subscribeToCatalogChanges вызывает onChange на product.changed и category.changed. Сервис товаров публикует их
+// после записи из любого пути: саг, REST и gRPC, смены видимости, планировщиков цен и публикации, галереи и разделов.
+// Фиды и карта сайта строятся вместе, поэтому оба события обновляют и то и другое
+func subscribeToCatalogChanges(messageBroker broker.MessageBroker, onChange func()) error {
+	ctx := context.Background()
+	if err := messageBroker.SubscribeToProductChanged(ctx, func(*broker.ProductEvent) error { onChange(); return nil }); err != nil {
+		return fmt.Errorf("failed to subscribe to product changed events: %w", err)
+	}
+	if err := messageBroker.SubscribeToCategoryChanged(ctx, func(*broker.CategoryEvent) error { onChange(); return nil }); err != nil {
+		return fmt.Errorf("failed to subscribe to category changed events: %w", err)
+	}
+	return nil
 }
 
 func NewServer(cfg *ServerConfig) (*Server, error) {
@@ -57,6 +97,10 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 		router: gin.New(),
 		cfg:    cfg,
 	}
+	// без списка gin верит X-Forwarded-For любого клиента, и лимиты по адресу обходятся подменой заголовка
+	if err := s.router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
 
 	logger := common.NewSimpleLogger(&common.LogConfig{FilePath: cfg.LOG_FILE})
 	s.router.Use(func(c *gin.Context) {
@@ -164,11 +208,56 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 	if !strings.HasPrefix(productServiceUrl, "http://") && !strings.HasPrefix(productServiceUrl, "https://") {
 		productServiceUrl = fmt.Sprintf("%s://%s", protocol, cfg.ProductServiceAddr)
 	}
-	adminHandler := admin.NewHandler(authService, adminTemplates, productServiceUrl, cfg.ProductServiceAPIKey, messageBroker)
-	clientHandler := client.NewHandler(clientTemplates, productServiceUrl, cfg.ProductServiceAPIKey, emailSender)
+	productClient, err := product.NewGRPCClient(cfg.ProductServiceGRPCAddr, cfg.ProductServiceAPIKey)
+	if err != nil {
+		return nil, err
+	}
+	productAPI, err := product.NewHTTPClient(productServiceUrl, cfg.ProductServiceAPIKey)
+	if err != nil {
+		return nil, err
+	}
+	catalogAPI, err := product.NewCatalogHTTPClient(productServiceUrl, cfg.ProductServiceAPIKey)
+	if err != nil {
+		return nil, err
+	}
+	adminHandler := admin.NewHandler(authService, adminTemplates, productServiceUrl, cfg.ProductServiceAPIKey, productClient, productAPI, catalogAPI, messageBroker)
+	clientHandler := client.NewHandler(clientTemplates, productClient, productAPI, emailSender, client.Site{
+		URL: cfg.SiteURL,
+		Name: cfg.Feed.ShopName,
+	}, cfg.ReviewKeySecret)
 	clientHandler.RegisterRoutes(s.router)
 	adminHandler.RegisterRoutes(s.router)
 
+	feedService, err := feed.NewService(feed.Config{
+		Shop: feed.Shop{
+			Name: cfg.Feed.ShopName,
+			Company: cfg.Feed.Company,
+			SiteURL: cfg.SiteURL,
+			ImageBaseURL: cfg.Feed.ImageBaseURL,
+		},
+		Dir: cfg.Feed.Dir,
+		Interval: cfg.Feed.Interval,
+	}, feed.NewSource(catalogAPI), logger)
+	if err != nil {
+		return nil, err
+	}
+	s.router.GET("/feeds/"+feed.YMLFile, feedService.Handler(feed.YMLFile, "application/xml; charset=utf-8"))
+	s.router.GET("/feeds/"+feed.GoogleFile, feedService.Handler(feed.GoogleFile, "application/xml; charset=utf-8"))
+	s.router.GET("/"+feed.SitemapFile, feedService.Handler(feed.SitemapFile, "application/xml; charset=utf-8"))
+	s.router.GET(feed.SitemapPartsPath+":name", feedService.SitemapPartHandler())
+	s.router.GET("/robots.txt", robotsHandler(cfg.Robots, cfg.SiteURL))
+
+	// Подписка живёт до закрытия брокера в Shutdown
+	if err := messageBroker.SubscribeToStockLow(context.Background(), emailSender.SendLowStock); err != nil {
+		return nil, fmt.Errorf("failed to subscribe to low stock events: %w", err)
+	}
+	if err := subscribeToCatalogChanges(messageBroker, feedService.Trigger); err != nil {
+		return nil, err
+	}
+
+	s.background, s.stopBackground = context.WithCancel(context.Background())
+	go feedService.Run(s.background)
+
 	return s, nil
 }
 
@@ -248,6 +337,10 @@ func RunHTTPRedirect(httpAddr, httpsHost string) *http.Server {
 func (s *Server) Shutdown(ctx context.Context) error {
 	done := make(chan struct{})
 
+	if s.stopBackground != nil {
+		s.stopBackground()
+	}
+
 	var shutdownErr error
 	go func() {
 		if s.httpServer != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
     "fmt"
 	"log"
+	"slices"
 
     "github.com/Nzyazin/zadnik.store/internal/broker"
     "github.com/Nzyazin/zadnik.store/internal/common"
@@ -63,10 +64,12 @@ func (a *App) handleImageUpload(event *broker.ImageEvent) error {
 		EventType: broker.EventTypeImageProcessed,
 		ProductID: event.ProductID,
 		ImageURL: imageUrl,
+		UserID: event.UserID,
+		Alt: event.Alt,
 	}
 
 	if err := a.messageBroker.PublishProductImage(ctx, eventFinished); err != nil {
-		if delErr := a.imageUseCase.DeleteImage(ctx, event.ProductID); delErr != nil {
+		if delErr := a.imageUseCase.DeleteImages(ctx, []string{imageUrl}); delErr != nil {
 			a.logger.Errorf("Failed to delete image: %v", delErr)
 		}
 		return fmt.Errorf("failed to publish image processed event: %w", err)
@@ -81,8 +84,13 @@ func (a *App) handleImageDelete(event *broker.ProductEvent) error {
 
 	ctx := context.Background()
 
-	if err := a.imageUseCase.DeleteImage(ctx, event.ProductID); err != nil {
-		a.logger.Errorf("Failed to delete image for product %d: %v", event.ProductID, err)
+	imageURLs := event.ImageURLs
+	if event.ImageURL != "" && !slices.Contains(imageURLs, event.ImageURL) {
+		imageURLs = append(imageURLs, event.ImageURL)
+	}
+
+	if err := a.imageUseCase.DeleteImages(ctx, imageURLs); err != nil {
+		a.logger.Errorf("Failed to delete images for product %d: %v", event.ProductID, err)
 
 		failEvent := &broker.ProductEvent{
 			EventType: broker.EventTypeImageDeleted,
@@ -111,14 +119,11 @@ func (a *App) handleImageCreating(event *broker.ProductEvent) error {
 		ProductID: event.ProductID,
 	}
 
-	imageUrl, err := a.imageUseCase.CreateImage(ctx, event.ImageData, event.Filename, event.ProductID); 
+	imageUrl, err := a.imageUseCase.ProcessImage(ctx, event.ImageData, event.ProductID); 
 	if err != nil {
 		a.logger.Errorf("Failed to process image %v", err)
 		eventFinished.Error = err.Error()
 		if err := a.messageBroker.PublishProductImage(ctx, eventFinished); err != nil {
-			if delErr := a.imageUseCase.DeleteImage(ctx, event.ProductID); delErr != nil {
-				a.logger.Errorf("Failed to delete image after error publishProductImage: %v", delErr)
-			}
 			return fmt.Errorf("failed to publish EventTypeImageCreated: %w", err)
 		}
 		return err
@@ -127,7 +132,7 @@ func (a *App) handleImageCreating(event *broker.ProductEvent) error {
 	eventFinished.ImageURL = imageUrl
 
 	if err := a.messageBroker.PublishProductImage(ctx, eventFinished); err != nil {
-		if delErr := a.imageUseCase.DeleteImage(ctx, event.ProductID); delErr != nil {
+		if delErr := a.imageUseCase.DeleteImages(ctx, []string{imageUrl}); delErr != nil {
 			a.logger.Errorf("Failed to delete image after error publishProductImage: %v", delErr)
 		}
 		return fmt.Errorf("failed to publish EventTypeImageCreated: %w", err)
@@ -138,6 +143,30 @@ func (a *App) handleImageCreating(event *broker.ProductEvent) error {
 	return nil
 }
 
+// handleImageRemove удаляет одно изображение галереи и сообщает сервису товаров результат
+func (a *App) handleImageRemove(event *broker.ImageEvent) error {
+	a.logger.Infof("Received image removing event for image %d of product %d", event.ImageID, event.ProductID)
+
+	ctx := context.Background()
+	eventFinished := &broker.ProductImageEvent{
+		EventType: broker.EventTypeImageRemoved,
+		ProductID: event.ProductID,
+		ImageID: event.ImageID,
+		ImageURL: event.ImageURL,
+		UserID: event.UserID,
+	}
+
+	if err := a.imageUseCase.DeleteImages(ctx, []string{event.ImageURL}); err != nil {
+		a.logger.Errorf("Failed to remove image %d: %v", event.ImageID, err)
+		eventFinished.Error = err.Error()
+	}
+
+	if err := a.messageBroker.PublishProductImage(ctx, eventFinished); err != nil {
+		return fmt.Errorf("failed to publish EventTypeImageRemoved: %w", err)
+	}
+	return nil
+}
+
 func (a *App) Run(ctx context.Context) error {
 	if err := a.messageBroker.SubscribeToImageUpload(ctx, broker.ImageExchange, broker.EventTypeImageUploaded, a.handleImageUpload); err != nil {
 		return fmt.Errorf("failed to subscribe to image upload: %w", err)
@@ -151,6 +180,10 @@ func (a *App) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to subscribe to image creating: %w", err)
 	}
 
+	if err := a.messageBroker.SubscribeToImageRemoving(ctx, broker.ImageExchange, broker.EventTypeImageRemoving, a.handleImageRemove); err != nil {
+		return fmt.Errorf("failed to subscribe to image removing: %w", err)
+	}
+
 	a.logger.Infof("Starting image service")
 	<-ctx.Done()
 	return nil
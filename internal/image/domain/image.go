@@ -2,10 +2,13 @@ package domain
 
 import (
 	"context"
+	"errors"
 )
 
+var ErrUnsupportedImage = errors.New("unsupported image format")
+
 type ImageStorage interface {
-	Store(ctx context.Context, filename string, imageData []byte, productID int32) (string, error)
+	Store(ctx context.Context, filename string, imageData []byte) (string, error)
 	Delete(ctx context.Context, imageURL string) error
 	GetBaseURL() string
 }
@@ -2,16 +2,19 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/Nzyazin/zadnik.store/internal/common"
 	"github.com/Nzyazin/zadnik.store/internal/image/domain"
 )
 
 type ImageUseCase interface {
-	CreateImage(ctx context.Context, imageData []byte, filename string, productID int32) (string, error)
 	ProcessImage(ctx context.Context, imageData []byte, productID int32) (string, error)
-	DeleteImage(ctx context.Context, productID int32) error
+	DeleteImages(ctx context.Context, imageURLs []string) error
 }
 
 type imageUseCase struct {
@@ -29,17 +32,22 @@ func NewImageUseCase(
 	}
 }
 
-func (iuc *imageUseCase) CreateImage(ctx context.Context, imageData []byte, filename string, productID int32) (string, error) {
-	imageURL, err := iuc.storage.Store(ctx, filename, imageData, productID)
-	if err != nil {
-		return "", fmt.Errorf("failed to store image: %w", err)
-	}
-
-	return imageURL, nil
+// imageExtensions - поддерживаемые форматы изображений товара
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/gif":  ".gif",
 }
 
+// ProcessImage сохраняет изображение галереи под уникальным именем вида {productID}-{random}.{ext}
 func (iuc *imageUseCase) ProcessImage(ctx context.Context, imageData []byte, productID int32) (string, error) {
-	imageURL, err := iuc.storage.Store(ctx, "", imageData, productID)
+	filename, err := imageFilename(imageData, productID)
+	if err != nil {
+		return "", err
+	}
+
+	imageURL, err := iuc.storage.Store(ctx, filename, imageData)
 	if err != nil {
 		return "", fmt.Errorf("failed to store image: %w", err)
 	}
@@ -47,12 +55,29 @@ func (iuc *imageUseCase) ProcessImage(ctx context.Context, imageData []byte, pro
 	return imageURL, nil
 }
 
-func (iuc *imageUseCase) DeleteImage(ctx context.Context, productID int32) error {
-	imageURL := fmt.Sprintf("%s/%d.jpg", iuc.storage.GetBaseURL(), productID)
+func (iuc *imageUseCase) DeleteImages(ctx context.Context, imageURLs []string) error {
+	var errs []error
+	for _, imageURL := range imageURLs {
+		if imageURL == "" {
+			continue
+		}
+		if err := iuc.storage.Delete(ctx, imageURL); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete image %s: %w", imageURL, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func imageFilename(imageData []byte, productID int32) (string, error) {
+	ext, ok := imageExtensions[http.DetectContentType(imageData)]
+	if !ok {
+		return "", domain.ErrUnsupportedImage
+	}
 
-	if err := iuc.storage.Delete(ctx, imageURL); err != nil {
-		return fmt.Errorf("failed to delete image: %w", err)
+	suffix := make([]byte, 6)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("failed to generate image name: %w", err)
 	}
 
-	return nil
+	return fmt.Sprintf("%d-%s%s", productID, hex.EncodeToString(suffix), ext), nil
 }
@@ -1,22 +1,41 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/Nzyazin/zadnik.store/internal/broker"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
 	DB                *DBConfig
 	ProductServiceAddress string
+	// ProductServiceGRPCAddress - адрес gRPC API товаров, работает рядом с HTTP
+	ProductServiceGRPCAddress string
 	JWTSecret         string
 	APIKey            string
 	RabbitMQ broker.RabbitMQConfig
 	LOG_FILE string
+	// PriceSchedulerInterval - как часто проверять запланированные изменения цен
+	PriceSchedulerInterval time.Duration
+	// VisibilitySchedulerInterval - как часто публиковать и скрывать товары по расписанию
+	VisibilitySchedulerInterval time.Duration
+	// ReservationSchedulerInterval - как часто снимать истёкшие резервы под заявки
+	ReservationSchedulerInterval time.Duration
+	// SellerTaxMode - режим налогообложения продавца: vat или none
+	SellerTaxMode domain.TaxMode
 }
 
+const (
+	defaultPriceSchedulerInterval      = time.Minute
+	defaultVisibilitySchedulerInterval = time.Minute
+	defaultReservationSchedulerInterval = time.Minute
+)
+
 type DBConfig struct {
 	Host     string
 	Port     string
@@ -32,6 +51,34 @@ func GetConfig() (*Config, error) {
 		return nil, err
 	}
 
+	priceSchedulerInterval := defaultPriceSchedulerInterval
+	if value := os.Getenv("PRICE_SCHEDULER_INTERVAL"); value != "" {
+		if priceSchedulerInterval, err = time.ParseDuration(value); err != nil || priceSchedulerInterval <= 0 {
+			return nil, fmt.Errorf("invalid PRICE_SCHEDULER_INTERVAL %q", value)
+		}
+	}
+
+	visibilitySchedulerInterval := defaultVisibilitySchedulerInterval
+	if value := os.Getenv("VISIBILITY_SCHEDULER_INTERVAL"); value != "" {
+		if visibilitySchedulerInterval, err = time.ParseDuration(value); err != nil || visibilitySchedulerInterval <= 0 {
+			return nil, fmt.Errorf("invalid VISIBILITY_SCHEDULER_INTERVAL %q", value)
+		}
+	}
+
+	reservationSchedulerInterval := defaultReservationSchedulerInterval
+	if value := os.Getenv("RESERVATION_SCHEDULER_INTERVAL"); value != "" {
+		if reservationSchedulerInterval, err = time.ParseDuration(value); err != nil || reservationSchedulerInterval <= 0 {
+			return nil, fmt.Errorf("invalid RESERVATION_SCHEDULER_INTERVAL %q", value)
+		}
+	}
+
+	sellerTaxMode := domain.TaxModeVAT
+	if value := os.Getenv("SELLER_TAX_MODE"); value != "" {
+		if sellerTaxMode, err = domain.ParseTaxMode(value); err != nil {
+			return nil, err
+		}
+	}
+
 	return &Config{
 		DB: &DBConfig{
 			Host:     os.Getenv("DB_HOST"),
@@ -41,11 +88,16 @@ func GetConfig() (*Config, error) {
 			Name:     os.Getenv("DB_NAME"),
 		},
 		ProductServiceAddress: os.Getenv("PRODUCT_SERVICE_ADDRESS"),
+		ProductServiceGRPCAddress: os.Getenv("PRODUCT_SERVICE_GRPC_ADDRESS"),
 		JWTSecret:         os.Getenv("JWT_SECRET"),
 		APIKey:            os.Getenv("API_KEY"),
 		RabbitMQ: broker.RabbitMQConfig{
 			URL: os.Getenv("RABBITMQ_URL"),
 		},
 		LOG_FILE: os.Getenv("LOG_FILE"),
+		PriceSchedulerInterval: priceSchedulerInterval,
+		VisibilitySchedulerInterval: visibilitySchedulerInterval,
+		ReservationSchedulerInterval: reservationSchedulerInterval,
+		SellerTaxMode: sellerTaxMode,
 	}, nil
 }
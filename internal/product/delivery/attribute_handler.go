@@ -0,0 +1,228 @@
+package delivery
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+)
+
+type AttributeHandler struct {
+	attributeUsecase usecase.AttributeUseCase
+	logger           common.Logger
+}
+
+func NewAttributeHandler(attributeUsecase usecase.AttributeUseCase, logger common.Logger) *AttributeHandler {
+	return &AttributeHandler{
+		attributeUsecase: attributeUsecase,
+		logger:           logger,
+	}
+}
+
+type attributeRequest struct {
+	Code        string               `json:"code"`
+	Name        string               `json:"name"`
+	Type        domain.AttributeType `json:"type"`
+	Unit        string               `json:"unit"`
+	Options     []string             `json:"options"`
+	Position    int32                `json:"position"`
+	CategoryIDs []int32              `json:"category_ids"`
+}
+
+func (req attributeRequest) toDomain(id int32) *domain.Attribute {
+	return &domain.Attribute{
+		ID:          id,
+		Code:        req.Code,
+		Name:        req.Name,
+		Type:        req.Type,
+		Unit:        req.Unit,
+		Options:     req.Options,
+		Position:    req.Position,
+		CategoryIDs: req.CategoryIDs,
+	}
+}
+
+// attributeValueRequest - значение характеристики товара; числа передаются строкой, как из формы
+type attributeValueRequest struct {
+	AttributeID int32  `json:"attribute_id"`
+	Value       string `json:"value"`
+}
+
+func (h *AttributeHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetAll attributes request")
+
+	attributes, err := h.attributeUsecase.GetAll(r.Context())
+	if err != nil {
+		h.writeError(w, err, "Failed to get attributes")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, attributes)
+}
+
+func (h *AttributeHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetByID attribute request")
+
+	id, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse attribute ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid attribute ID format")
+		return
+	}
+
+	attribute, err := h.attributeUsecase.GetByID(r.Context(), id)
+	if err != nil {
+		h.writeError(w, err, "Failed to get attribute")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, attribute)
+}
+
+func (h *AttributeHandler) Create(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Create attribute request")
+
+	var req attributeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf("Failed to decode attribute: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	attribute, err := h.attributeUsecase.Create(r.Context(), req.toDomain(0))
+	if err != nil {
+		h.writeError(w, err, "Failed to create attribute")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, attribute)
+}
+
+func (h *AttributeHandler) Update(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Update attribute request")
+
+	id, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse attribute ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid attribute ID format")
+		return
+	}
+
+	var req attributeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf("Failed to decode attribute: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	attribute, err := h.attributeUsecase.Update(r.Context(), req.toDomain(id))
+	if err != nil {
+		h.writeError(w, err, "Failed to update attribute")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, attribute)
+}
+
+func (h *AttributeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Delete attribute request")
+
+	id, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse attribute ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid attribute ID format")
+		return
+	}
+
+	if err := h.attributeUsecase.Delete(r.Context(), id); err != nil {
+		h.writeError(w, err, "Failed to delete attribute")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AttributeHandler) GetProductValues(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetProductValues attributes request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	values, err := h.attributeUsecase.GetProductValues(r.Context(), productID)
+	if err != nil {
+		h.writeError(w, err, "Failed to get product attributes")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, values)
+}
+
+// SetProductValues заменяет все значения характеристик товара
+func (h *AttributeHandler) SetProductValues(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling SetProductValues attributes request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var body struct {
+		Attributes []attributeValueRequest `json:"attributes"`
+		Version    *int32                  `json:"version"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.logger.Errorf("Failed to decode product attributes: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	values := make([]*domain.ProductAttributeValue, len(body.Attributes))
+	for i, req := range body.Attributes {
+		values[i] = &domain.ProductAttributeValue{AttributeID: req.AttributeID, Text: req.Value}
+	}
+
+	saved, err := h.attributeUsecase.SetProductValues(r.Context(), productID, body.Version, values)
+	if err != nil {
+		h.writeError(w, err, "Failed to set product attributes")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, saved)
+}
+
+// writeError переводит доменные ошибки характеристик в HTTP-статусы
+func (h *AttributeHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, domain.ErrAttributeNotFound), errors.Is(err, sql.ErrNoRows):
+		writeJSONError(w, http.StatusNotFound, "Not found")
+	case errors.Is(err, domain.ErrAttributeInvalid), errors.Is(err, domain.ErrAttributeValueInvalid):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrCategoryNotFound):
+		writeJSONError(w, http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, domain.ErrAttributeCodeTaken):
+		writeJSONError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrVersionConflict):
+		writeJSONError(w, http.StatusConflict, "Product was modified by another request")
+	default:
+		h.logger.Errorf("%s: %v", message, err)
+		writeJSONError(w, http.StatusInternalServerError, message)
+	}
+}
+
+func (h *AttributeHandler) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.logger.Errorf("Failed to encode response: %v", err)
+	}
+}
@@ -0,0 +1,119 @@
+package delivery
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/spreadsheet"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+)
+
+// maxImportFileSize ограничивает файл каталога; изображения сюда не передаются, только их имена
+const maxImportFileSize = 20 << 20
+
+type CatalogHandler struct {
+	catalogUsecase usecase.CatalogUseCase
+	logger         common.Logger
+}
+
+func NewCatalogHandler(catalogUsecase usecase.CatalogUseCase, logger common.Logger) *CatalogHandler {
+	return &CatalogHandler{
+		catalogUsecase: catalogUsecase,
+		logger:         logger,
+	}
+}
+
+// Import принимает multipart-форму: file - таблица CSV или XLSX, image_names - файлы архива изображений,
+// has_images=1 - архив загружен, apply=1 - применить изменения, иначе только проверить
+func (h *CatalogHandler) Import(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Import catalog request")
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportFileSize+1<<20)
+	if err := r.ParseMultipartForm(maxImportFileSize); err != nil {
+		h.logger.Errorf("Failed to parse import form: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid import form")
+		return
+	}
+
+	file, fileHeader, err := r.FormFile("file")
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "File is required")
+		return
+	}
+	defer file.Close()
+
+	format, err := spreadsheet.ParseFormat(fileHeader.Filename)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		h.logger.Errorf("Failed to read import file: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Failed to read file")
+		return
+	}
+
+	var imageNames []string
+	if r.FormValue("has_images") == "1" {
+		imageNames = append([]string{}, r.MultipartForm.Value["image_names"]...)
+	}
+
+	report, err := h.catalogUsecase.Import(r.Context(), data, format, imageNames, r.FormValue("apply") == "1")
+	switch {
+	case errors.Is(err, domain.ErrImportFormat):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	case errors.Is(err, domain.ErrImportInvalid):
+		h.writeJSON(w, http.StatusUnprocessableEntity, report)
+		return
+	case err != nil:
+		h.logger.Errorf("Failed to import catalog: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to import catalog")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, report)
+}
+
+func (h *CatalogHandler) Export(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Export catalog request")
+
+	format := spreadsheet.FormatCSV
+	if value := r.URL.Query().Get("format"); value != "" {
+		var err error
+		if format, err = spreadsheet.ParseFormat(value); err != nil {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := h.catalogUsecase.Export(r.Context(), &buf, format); err != nil {
+		h.logger.Errorf("Failed to export catalog: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to export catalog")
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="catalog-%s.%s"`, time.Now().Format("2006-01-02"), format))
+	if _, err := buf.WriteTo(w); err != nil {
+		h.logger.Errorf("Failed to write export: %v", err)
+	}
+}
+
+func (h *CatalogHandler) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.logger.Errorf("Failed to encode response: %v", err)
+	}
+}
@@ -0,0 +1,245 @@
+package delivery
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+	"github.com/gorilla/mux"
+)
+
+type CategoryHandler struct {
+	categoryUsecase usecase.CategoryUseCase
+	logger          common.Logger
+}
+
+func NewCategoryHandler(categoryUsecase usecase.CategoryUseCase, logger common.Logger) *CategoryHandler {
+	return &CategoryHandler{
+		categoryUsecase: categoryUsecase,
+		logger:          logger,
+	}
+}
+
+type categoryRequest struct {
+	ParentID       *int32 `json:"parent_id"`
+	Name           string `json:"name"`
+	Slug           string `json:"slug"`
+	Description    string `json:"description"`
+	SEOTitle       string `json:"seo_title"`
+	SEODescription string `json:"seo_description"`
+	Position       int32  `json:"position"`
+}
+
+func (req categoryRequest) toDomain(id int32) *domain.Category {
+	return &domain.Category{
+		ID:             id,
+		ParentID:       req.ParentID,
+		Name:           req.Name,
+		Slug:           req.Slug,
+		Description:    req.Description,
+		SEOTitle:       req.SEOTitle,
+		SEODescription: req.SEODescription,
+		Position:       req.Position,
+	}
+}
+
+// GetAll отдаёт разделы плоским списком, а с ?tree=1 - деревом
+func (h *CategoryHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetAll categories request")
+
+	var categories []*domain.Category
+	var err error
+	if r.URL.Query().Get("tree") != "" {
+		categories, err = h.categoryUsecase.GetTree(r.Context())
+	} else {
+		categories, err = h.categoryUsecase.GetAll(r.Context())
+	}
+	if err != nil {
+		h.logger.Errorf("Failed to get categories: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to get categories")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, categories)
+}
+
+func (h *CategoryHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetByID category request")
+
+	id, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse category ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid category ID format")
+		return
+	}
+
+	category, err := h.categoryUsecase.GetByID(r.Context(), id)
+	if err != nil {
+		h.writeError(w, err, "Failed to get category")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, category)
+}
+
+func (h *CategoryHandler) GetBySlug(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetBySlug category request")
+
+	category, err := h.categoryUsecase.GetBySlug(r.Context(), mux.Vars(r)["slug"])
+	if err != nil {
+		h.writeError(w, err, "Failed to get category")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, category)
+}
+
+func (h *CategoryHandler) Create(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Create category request")
+
+	var req categoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf("Failed to decode category: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	category, err := h.categoryUsecase.Create(r.Context(), req.toDomain(0))
+	if err != nil {
+		h.writeError(w, err, "Failed to create category")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, category)
+}
+
+func (h *CategoryHandler) Update(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Update category request")
+
+	id, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse category ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid category ID format")
+		return
+	}
+
+	var req categoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf("Failed to decode category: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	category, err := h.categoryUsecase.Update(r.Context(), req.toDomain(id))
+	if err != nil {
+		h.writeError(w, err, "Failed to update category")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, category)
+}
+
+func (h *CategoryHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Delete category request")
+
+	id, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse category ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid category ID format")
+		return
+	}
+
+	if err := h.categoryUsecase.Delete(r.Context(), id); err != nil {
+		h.writeError(w, err, "Failed to delete category")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CategoryHandler) GetProductCategories(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetProductCategories request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	categories, err := h.categoryUsecase.GetByProduct(r.Context(), productID)
+	if err != nil {
+		h.writeError(w, err, "Failed to get product categories")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, categories)
+}
+
+func (h *CategoryHandler) SetProductCategories(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling SetProductCategories request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var body struct {
+		CategoryIDs []int32 `json:"category_ids"`
+		Version     *int32  `json:"version"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.logger.Errorf("Failed to decode product categories: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.categoryUsecase.SetProductCategories(r.Context(), productID, body.Version, body.CategoryIDs); err != nil {
+		if errors.Is(err, domain.ErrCategoryNotFound) {
+			h.logger.Errorf("Unknown category for product %d: %v", productID, err)
+			writeJSONError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		h.writeError(w, err, "Failed to set product categories")
+		return
+	}
+
+	categories, err := h.categoryUsecase.GetByProduct(r.Context(), productID)
+	if err != nil {
+		h.writeError(w, err, "Failed to get product categories")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, categories)
+}
+
+// writeError переводит доменные ошибки разделов в HTTP-статусы
+func (h *CategoryHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, domain.ErrCategoryNotFound), errors.Is(err, sql.ErrNoRows):
+		writeJSONError(w, http.StatusNotFound, "Not found")
+	case errors.Is(err, domain.ErrCategoryInvalid), errors.Is(err, domain.ErrCategoryCycle):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrCategorySlugTaken), errors.Is(err, domain.ErrCategoryHasChildren):
+		writeJSONError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrVersionConflict):
+		writeJSONError(w, http.StatusConflict, "Product was modified by another request")
+	default:
+		h.logger.Errorf("%s: %v", message, err)
+		writeJSONError(w, http.StatusInternalServerError, message)
+	}
+}
+
+func (h *CategoryHandler) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.logger.Errorf("Failed to encode response: %v", err)
+	}
+}
@@ -0,0 +1,233 @@
+package grpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/Nzyazin/zadnik.store/api/generated/product"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+func productToProto(product *domain.Product) *pb.Product {
+	if product == nil {
+		return nil
+	}
+	out := &pb.Product{
+		Id:          product.ID,
+		Name:        product.Name,
+		Slug:        product.Slug,
+		Status:      string(product.Status),
+		Description: product.Description,
+		Price:       product.Price.String(),
+		TaxClass:    string(product.TaxClass),
+		ImageUrl:    product.ImageURL.String,
+		Version:     product.Version,
+		CreatedAt:   timestamppb.New(product.CreatedAt),
+		UpdatedAt:   timestamppb.New(product.UpdatedAt),
+		Pricing:     pricingToProto(product.Pricing),
+		Visibility:  string(product.Visibility),
+		PublishAt:   optionalTimestamp(product.PublishAt),
+		UnpublishAt: optionalTimestamp(product.UnpublishAt),
+		Published:   product.IsPublished(time.Now()),
+	}
+	for _, variant := range product.Variants {
+		v := &pb.ProductVariant{
+			Id:          variant.ID,
+			Sku:         variant.SKU,
+			Options:     variant.Options,
+			WeightGrams: variant.WeightGrams,
+			IsActive:    &variant.IsActive,
+			Position:    variant.Position,
+			Pricing:     pricingToProto(variant.Pricing),
+		}
+		if variant.Price.Valid {
+			price := variant.Price.Decimal.String()
+			v.Price = &price
+		}
+		out.Variants = append(out.Variants, v)
+	}
+	for _, image := range product.Images {
+		out.Images = append(out.Images, &pb.ProductImage{
+			Id:        image.ID,
+			Url:       image.URL,
+			Alt:       image.Alt,
+			Position:  image.Position,
+			IsPrimary: image.IsPrimary,
+		})
+	}
+	for _, item := range product.Stock {
+		out.Stock = append(out.Stock, &pb.StockItem{
+			VariantId:         item.VariantID,
+			OnHand:            item.OnHand,
+			Reserved:          item.Reserved,
+			LowStockThreshold: item.LowStockThreshold,
+		})
+	}
+	for _, tier := range product.PriceTiers {
+		out.PriceTiers = append(out.PriceTiers, &pb.PriceTier{
+			MinQuantity: tier.MinQuantity,
+			UnitPrice:   tier.UnitPrice.String(),
+			Pricing:     pricingToProto(tier.Pricing),
+		})
+	}
+	for _, attribute := range product.Attributes {
+		a := &pb.ProductAttribute{
+			AttributeId: attribute.AttributeID,
+			Code:        attribute.Code,
+			Name:        attribute.Name,
+			Type:        string(attribute.Type),
+			Unit:        attribute.Unit,
+			Text:        attribute.Text,
+		}
+		if attribute.Number.Valid {
+			number := attribute.Number.Decimal.String()
+			a.Number = &number
+		}
+		out.Attributes = append(out.Attributes, a)
+	}
+	if product.Rating != nil {
+		out.Rating = &pb.ProductRating{Average: product.Rating.Average, Count: product.Rating.Count}
+	}
+	return out
+}
+
+func reviewToProto(review *domain.Review) *pb.Review {
+	return &pb.Review{
+		Id:         review.ID,
+		AuthorName: review.AuthorName,
+		Company:    review.Company,
+		Rating:     review.Rating,
+		Text:       review.Text,
+		Reply:      review.Reply,
+		RepliedAt:  optionalTimestamp(review.RepliedAt),
+		CreatedAt:  timestamppb.New(review.CreatedAt),
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func pricingToProto(pricing *domain.Pricing) *pb.Pricing {
+	if pricing == nil {
+		return nil
+	}
+	return &pb.Pricing{
+		Gross:   pricing.Gross.String(),
+		Net:     pricing.Net.String(),
+		Vat:     pricing.VAT.String(),
+		VatRate: pricing.VATRate,
+	}
+}
+
+func changeTypeToProto(changeType domain.ProductChangeType) pb.ChangeType {
+	switch changeType {
+	case domain.ProductChangeCreated:
+		return pb.ChangeType_CHANGE_TYPE_CREATED
+	case domain.ProductChangeUpdated:
+		return pb.ChangeType_CHANGE_TYPE_UPDATED
+	case domain.ProductChangeDeleted:
+		return pb.ChangeType_CHANGE_TYPE_DELETED
+	}
+	return pb.ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+// filterFromProto переводит фильтр списка и поиска; ошибки разбора чисел - domain.ErrInvalidQuery
+func filterFromProto(filter *pb.ProductFilter) (domain.ProductFilter, error) {
+	var out domain.ProductFilter
+	if filter == nil {
+		return out, nil
+	}
+	for _, status := range filter.Statuses {
+		out.Statuses = append(out.Statuses, domain.ProductStatus(status))
+	}
+
+	var err error
+	if out.MinPrice, err = optionalDecimal(filter.MinPrice, "min_price"); err != nil {
+		return out, err
+	}
+	if out.MaxPrice, err = optionalDecimal(filter.MaxPrice, "max_price"); err != nil {
+		return out, err
+	}
+	out.CategoryID = filter.CategoryId
+	out.Published = filter.Published
+
+	for _, attribute := range filter.Attributes {
+		f := domain.AttributeFilter{Code: attribute.Code, Values: attribute.Values}
+		if f.Min, err = optionalDecimal(attribute.Min, attribute.Code+".min"); err != nil {
+			return out, err
+		}
+		if f.Max, err = optionalDecimal(attribute.Max, attribute.Code+".max"); err != nil {
+			return out, err
+		}
+		out.Attributes = append(out.Attributes, f)
+	}
+	return out, nil
+}
+
+func optionalDecimal(value *string, name string) (*decimal.Decimal, error) {
+	if value == nil {
+		return nil, nil
+	}
+	number, err := decimal.NewFromString(*value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid %s", domain.ErrInvalidQuery, name)
+	}
+	return &number, nil
+}
+
+// productFromProto повторяет правила тела POST и PUT /products: варианты и оптовые цены заменяются целиком,
+// вариант без is_active доступен для заказа, пустая ставка НДС - основная
+func productFromProto(input *pb.ProductInput) (*domain.Product, error) {
+	if input == nil {
+		return nil, fmt.Errorf("%w: product is required", domain.ErrProductInvalid)
+	}
+	price, err := decimal.NewFromString(input.Price)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid price", domain.ErrProductInvalid)
+	}
+
+	product := &domain.Product{
+		Name:        input.Name,
+		Slug:        input.Slug,
+		Description: input.Description,
+		Price:       price,
+		TaxClass:    domain.TaxClass(input.TaxClass),
+		Variants:    make([]*domain.ProductVariant, len(input.Variants)),
+		PriceTiers:  make([]*domain.PriceTier, len(input.PriceTiers)),
+	}
+	if product.TaxClass == "" {
+		product.TaxClass = domain.TaxClassStandard
+	}
+	for i, variant := range input.Variants {
+		v := &domain.ProductVariant{
+			ID:          variant.Id,
+			SKU:         variant.Sku,
+			Options:     variant.Options,
+			WeightGrams: variant.WeightGrams,
+			IsActive:    variant.IsActive == nil || *variant.IsActive,
+		}
+		if variant.Price != nil {
+			variantPrice, err := decimal.NewFromString(*variant.Price)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid price of variant %q", domain.ErrVariantInvalid, variant.Sku)
+			}
+			v.Price = decimal.NewNullDecimal(variantPrice)
+		}
+		product.Variants[i] = v
+	}
+	for i, tier := range input.PriceTiers {
+		unitPrice, err := decimal.NewFromString(tier.UnitPrice)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid unit price", domain.ErrPriceTierInvalid)
+		}
+		product.PriceTiers[i] = &domain.PriceTier{MinQuantity: tier.MinQuantity, UnitPrice: unitPrice}
+	}
+	return product, nil
+}
@@ -0,0 +1,276 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/Nzyazin/zadnik.store/api/generated/product"
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/delivery"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+)
+
+type ProductHandler struct {
+	pb.UnimplementedProductServiceServer
+	productUseCase usecase.ProductUseCase
+	reviewUseCase  usecase.ReviewUseCase
+	events         delivery.ProductEvents
+	changes        *usecase.ProductChanges
+	logger         common.Logger
+}
+
+// NewProductHandler создает gRPC-обработчик товаров; правила те же, что у HTTP API
+func NewProductHandler(productUseCase usecase.ProductUseCase, reviewUseCase usecase.ReviewUseCase, events delivery.ProductEvents, changes *usecase.ProductChanges, logger common.Logger) *ProductHandler {
+	return &ProductHandler{
+		productUseCase: productUseCase,
+		reviewUseCase:  reviewUseCase,
+		events:         events,
+		changes:        changes,
+		logger:         logger,
+	}
+}
+
+func (h *ProductHandler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	filter, err := filterFromProto(req.Filter)
+	if err != nil {
+		return nil, h.statusError(err, "Failed to get products")
+	}
+
+	page, err := h.productUseCase.GetAll(ctx, domain.ProductQuery{
+		Filter: filter,
+		Sort:   domain.ProductSort(req.Sort),
+		Desc:   req.Desc,
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.statusError(err, "Failed to get products")
+	}
+
+	resp := &pb.ListProductsResponse{Total: int32(page.Total), Limit: int32(page.Limit), Offset: int32(page.Offset)}
+	for _, product := range page.Items {
+		resp.Items = append(resp.Items, productToProto(product))
+	}
+	return resp, nil
+}
+
+func (h *ProductHandler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductResponse, error) {
+	var product *domain.Product
+	var err error
+	switch key := req.Key.(type) {
+	case *pb.GetProductRequest_Id:
+		product, err = h.productUseCase.GetByID(ctx, key.Id)
+	case *pb.GetProductRequest_Slug:
+		product, err = h.productUseCase.GetBySlug(ctx, key.Slug)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "product id or slug is required")
+	}
+	if err != nil {
+		return nil, h.statusError(err, "Failed to get product")
+	}
+	return &pb.GetProductResponse{Product: productToProto(product)}, nil
+}
+
+func (h *ProductHandler) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	filter, err := filterFromProto(req.Filter)
+	if err != nil {
+		return nil, h.statusError(err, "Failed to search products")
+	}
+
+	page, err := h.productUseCase.Search(ctx, domain.ProductSearchQuery{
+		Text:   req.Query,
+		Filter: filter,
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.statusError(err, "Failed to search products")
+	}
+
+	resp := &pb.SearchProductsResponse{Total: int32(page.Total), Limit: int32(page.Limit), Offset: int32(page.Offset)}
+	for _, result := range page.Items {
+		resp.Items = append(resp.Items, &pb.SearchResult{
+			Product:       productToProto(&result.Product),
+			Rank:          result.Rank,
+			NameHighlight: result.NameHighlight,
+			Snippet:       result.Snippet,
+		})
+	}
+	return resp, nil
+}
+
+func (h *ProductHandler) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
+	product, err := productFromProto(req.Product)
+	if err != nil {
+		return nil, h.statusError(err, "Failed to create product")
+	}
+
+	created, err := h.productUseCase.Create(ctx, product)
+	if err != nil {
+		return nil, h.statusError(err, "Failed to create product")
+	}
+	h.events.NotifyCreated(ctx, created.ID)
+	return &pb.CreateProductResponse{Product: productToProto(created)}, nil
+}
+
+func (h *ProductHandler) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
+	product, err := productFromProto(req.Product)
+	if err != nil {
+		return nil, h.statusError(err, "Failed to replace product")
+	}
+	if req.Version == 0 {
+		return nil, status.Error(codes.InvalidArgument, "product version is required")
+	}
+
+	product.ID = req.Id
+	product.Version = req.Version
+	replaced, err := delivery.ReplaceProduct(ctx, h.productUseCase, product)
+	if err != nil {
+		return nil, h.statusError(err, "Failed to replace product")
+	}
+	return &pb.UpdateProductResponse{Product: productToProto(replaced)}, nil
+}
+
+func (h *ProductHandler) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	pending, err := delivery.DeleteProduct(ctx, h.productUseCase, h.events, req.Id)
+	if err != nil {
+		return nil, h.statusError(err, "Failed to delete product")
+	}
+	return &pb.DeleteProductResponse{Pending: pending != nil}, nil
+}
+
+func (h *ProductHandler) GetRecommendations(ctx context.Context, req *pb.GetRecommendationsRequest) (*pb.GetRecommendationsResponse, error) {
+	recommendations, err := h.productUseCase.GetRecommendations(ctx, req.ProductId, int(req.Limit))
+	if err != nil {
+		return nil, h.statusError(err, "Failed to get product recommendations")
+	}
+
+	resp := &pb.GetRecommendationsResponse{}
+	for _, recommendation := range recommendations {
+		resp.Items = append(resp.Items, &pb.Recommendation{
+			Kind:    string(recommendation.Kind),
+			Source:  string(recommendation.Source),
+			Product: productToProto(recommendation.Product),
+		})
+	}
+	return resp, nil
+}
+
+// ListProductReviews отдаёт витрине только одобренные отзывы; очередь модерации доступна через HTTP API
+func (h *ProductHandler) ListProductReviews(ctx context.Context, req *pb.ListProductReviewsRequest) (*pb.ListProductReviewsResponse, error) {
+	productID := req.ProductId
+	page, err := h.reviewUseCase.GetAll(ctx, domain.ReviewQuery{
+		ProductID: &productID,
+		Status:    domain.ReviewStatusApproved,
+		Limit:     int(req.Limit),
+		Offset:    int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.statusError(err, "Failed to get product reviews")
+	}
+
+	resp := &pb.ListProductReviewsResponse{Total: int32(page.Total), Limit: int32(page.Limit), Offset: int32(page.Offset)}
+	for _, review := range page.Items {
+		resp.Items = append(resp.Items, reviewToProto(review))
+	}
+	return resp, nil
+}
+
+// WatchProducts отправляет изменения, пока клиент не отменит вызов; отставшего клиента поток завершает
+// с кодом ResourceExhausted, после чего клиент должен перечитать товары и подписаться заново
+func (h *ProductHandler) WatchProducts(req *pb.WatchProductsRequest, stream pb.ProductService_WatchProductsServer) error {
+	changes, unsubscribe := h.changes.Subscribe(req.ProductIds)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "watcher is too slow, subscribe again")
+			}
+			if err := stream.Send(&pb.ProductChange{
+				Type:      changeTypeToProto(change.Type),
+				ProductId: change.ProductID,
+				Product:   productToProto(change.Product),
+				ChangedAt: timestamppb.New(change.ChangedAt),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// statusError переводит доменные ошибки товара в коды gRPC так же, как writeProductError - в HTTP-статусы
+func (h *ProductHandler) statusError(err error, message string) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, domain.ErrVersionConflict):
+		h.logger.Warnf("Rejected stale product change: %v", err)
+		return status.Error(codes.Aborted, "product was modified by another request")
+	case errors.Is(err, domain.ErrProductBusy):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrVariantSKUTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrProductInvalid),
+		errors.Is(err, domain.ErrTaxClassInvalid),
+		errors.Is(err, domain.ErrVariantInvalid),
+		errors.Is(err, domain.ErrPriceTierInvalid),
+		errors.Is(err, domain.ErrInvalidStatus),
+		errors.Is(err, domain.ErrInvalidQuery):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, delivery.ErrImagesNotDeleted):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		h.logger.Errorf("%s: %v", message, err)
+		return status.Error(codes.Internal, message)
+	}
+}
+
+// AuthInterceptors проверяют ключ x-api-key, как AuthMiddleware в HTTP API; x-user-id попадает в историю изменений
+func AuthInterceptors(apiKey string, logger common.Logger) []grpc.ServerOption {
+	authenticate := func(ctx context.Context) (context.Context, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get("x-api-key")
+		if len(keys) == 0 || keys[0] == "" {
+			return nil, status.Error(codes.Unauthenticated, "API key is required")
+		}
+		if keys[0] != apiKey {
+			logger.Errorf("Invalid API key provided over gRPC")
+			return nil, status.Error(codes.Unauthenticated, "Invalid API key")
+		}
+		if ids := md.Get("x-user-id"); len(ids) > 0 {
+			if userID, err := strconv.ParseInt(ids[0], 10, 64); err == nil {
+				ctx = domain.ContextWithUserID(ctx, userID)
+			}
+		}
+		return ctx, nil
+	}
+
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			ctx, err := authenticate(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if _, err := authenticate(stream.Context()); err != nil {
+				return err
+			}
+			return handler(srv, stream)
+		}),
+	}
+}
@@ -1,11 +1,18 @@
 package delivery
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 	
 	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
 	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
 	"github.com/gorilla/mux"
 	"github.com/shopspring/decimal"
@@ -13,13 +20,15 @@ import (
 
 type ProductHandler struct {
 	productUsecase usecase.ProductUseCase
+	events         ProductEvents
 	logger         common.Logger
 	apiKey         string
 }
 
-func NewProductHandler(productUsecase usecase.ProductUseCase, logger common.Logger, apiKey string) *ProductHandler {
+func NewProductHandler(productUsecase usecase.ProductUseCase, events ProductEvents, logger common.Logger, apiKey string) *ProductHandler {
 	return &ProductHandler{
 		productUsecase: productUsecase,
+		events:         events,
 		logger:         logger,
 		apiKey:         apiKey,
 	}
@@ -28,129 +37,341 @@ func NewProductHandler(productUsecase usecase.ProductUseCase, logger common.Logg
 func (p *ProductHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 	p.logger.Infof("Handing GetAll products request")
 
-	products, err := p.productUsecase.GetAll(r.Context())
+	query, err := parseProductQuery(r.URL.Query())
+	if err != nil {
+		p.logger.Errorf("Invalid products query: %v", err)
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := p.productUsecase.GetAll(r.Context(), query)
+	if errors.Is(err, domain.ErrInvalidStatus) || errors.Is(err, domain.ErrInvalidQuery) {
+		p.logger.Errorf("Invalid products query: %v", err)
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err != nil {
 		p.logger.Errorf("Failed to get products: %v", err)
-		http.Error(w, "Failed to get products", http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to get products")
 		return
 	}
 
+	page.Next, page.Prev = pageLinks(r.URL, page.Offset, page.Limit, page.Total)
+
 	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(products); err != nil {
+	if err := json.NewEncoder(w).Encode(page); err != nil {
 		p.logger.Errorf("Failed to encode products: %v", err)
-		http.Error(w, "Failed to encode products", http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to encode products")
 		return
 	}
 }
 
-func (p *ProductHandler) GetByID(w http.ResponseWriter, r *http.Request) {
-	p.logger.Infof("Handing GetByID product request")
+// parseProductQuery разбирает параметры status, published, min_price, max_price, category_id, attr.*, sort, order, limit и offset
+func parseProductQuery(values url.Values) (domain.ProductQuery, error) {
+	var query domain.ProductQuery
 
-	vars := mux.Vars(r)
-	id := vars["id"]
-	if id == "" {
-		p.logger.Errorf("Product ID is empty")
-		http.Error(w, "Product ID is empty", http.StatusBadRequest)
-		return
+	if value := values.Get("published"); value != "" {
+		published, err := strconv.ParseBool(value)
+		if err != nil {
+			return query, fmt.Errorf("invalid published: %w", err)
+		}
+		query.Filter.Published = published
+	}
+
+	for _, value := range values["status"] {
+		for _, status := range strings.Split(value, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				query.Filter.Statuses = append(query.Filter.Statuses, domain.ProductStatus(status))
+			}
+		}
 	}
 
-	id64, err := strconv.ParseInt(id, 10, 64)
+	if value := values.Get("min_price"); value != "" {
+		price, err := decimal.NewFromString(value)
+		if err != nil {
+			return query, fmt.Errorf("invalid min_price: %w", err)
+		}
+		query.Filter.MinPrice = &price
+	}
+	if value := values.Get("max_price"); value != "" {
+		price, err := decimal.NewFromString(value)
+		if err != nil {
+			return query, fmt.Errorf("invalid max_price: %w", err)
+		}
+		query.Filter.MaxPrice = &price
+	}
+	if value := values.Get("category_id"); value != "" {
+		categoryID, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return query, fmt.Errorf("invalid category_id: %w", err)
+		}
+		id := int32(categoryID)
+		query.Filter.CategoryID = &id
+	}
+
+	attributes, err := parseAttributeFilters(values)
 	if err != nil {
-		p.logger.Errorf("Failed to parse product ID: %v", err)
-		http.Error(w, "Invalid product ID format", http.StatusBadRequest)
+		return query, err
+	}
+	query.Filter.Attributes = attributes
+
+	query.Sort = domain.ProductSort(values.Get("sort"))
+	switch values.Get("order") {
+	case "", "asc":
+	case "desc":
+		query.Desc = true
+	default:
+		return query, fmt.Errorf("invalid order: must be asc or desc")
+	}
+
+	if value := values.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			return query, fmt.Errorf("invalid limit: %w", err)
+		}
+		query.Limit = limit
+	}
+	if value := values.Get("offset"); value != "" {
+		offset, err := strconv.Atoi(value)
+		if err != nil {
+			return query, fmt.Errorf("invalid offset: %w", err)
+		}
+		query.Offset = offset
+	}
+
+	return query, nil
+}
+
+// parseAttributeFilters разбирает фильтры по характеристикам: attr.<code>=значение1,значение2
+// для списков и текста, attr.<code>.min и attr.<code>.max - для чисел
+func parseAttributeFilters(values url.Values) ([]domain.AttributeFilter, error) {
+	var filters []domain.AttributeFilter
+	byCode := map[string]int{}
+	filterFor := func(code string) *domain.AttributeFilter {
+		if i, ok := byCode[code]; ok {
+			return &filters[i]
+		}
+		byCode[code] = len(filters)
+		filters = append(filters, domain.AttributeFilter{Code: code})
+		return &filters[len(filters)-1]
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		if strings.HasPrefix(key, "attr.") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		code := strings.TrimPrefix(key, "attr.")
+		bound := ""
+		if i := strings.LastIndex(code, "."); i >= 0 {
+			code, bound = code[:i], code[i+1:]
+		}
+
+		switch bound {
+		case "":
+			filter := filterFor(code)
+			for _, value := range values[key] {
+				for _, item := range strings.Split(value, ",") {
+					if item = strings.TrimSpace(item); item != "" {
+						filter.Values = append(filter.Values, item)
+					}
+				}
+			}
+		case "min", "max":
+			number, err := decimal.NewFromString(values.Get(key))
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+			if filter := filterFor(code); bound == "min" {
+				filter.Min = &number
+			} else {
+				filter.Max = &number
+			}
+		default:
+			return nil, fmt.Errorf("invalid attribute filter %q", key)
+		}
+	}
+	return filters, nil
+}
+
+func (p *ProductHandler) Search(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling Search products request")
+
+	listQuery, err := parseProductQuery(r.URL.Query())
+	if err != nil {
+		p.logger.Errorf("Invalid search query: %v", err)
+		writeJSONError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	product, err := p.productUsecase.GetByID(r.Context(), int32(id64))
+	page, err := p.productUsecase.Search(r.Context(), domain.ProductSearchQuery{
+		Text:   r.URL.Query().Get("q"),
+		Filter: listQuery.Filter,
+		Limit:  listQuery.Limit,
+		Offset: listQuery.Offset,
+	})
+	if errors.Is(err, domain.ErrInvalidStatus) || errors.Is(err, domain.ErrInvalidQuery) {
+		p.logger.Errorf("Invalid search query: %v", err)
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err != nil {
-		p.logger.Errorf("Failed to get product: %v", err)
-		http.Error(w, "Failed to get product", http.StatusInternalServerError)
+		p.logger.Errorf("Failed to search products: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to search products")
 		return
 	}
 
+	page.Next, page.Prev = pageLinks(r.URL, page.Offset, page.Limit, page.Total)
+
 	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(product); err != nil {
-		p.logger.Errorf("Failed to encode product: %v", err)
-		http.Error(w, "Failed to encode product", http.StatusInternalServerError)
+	if err := json.NewEncoder(w).Encode(page); err != nil {
+		p.logger.Errorf("Failed to encode search results: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to encode search results")
 		return
 	}
 }
 
-func (p *ProductHandler) Update(w http.ResponseWriter, r *http.Request) {
-	p.logger.Infof("Handling Update product request")
-
-	vars := mux.Vars(r)
-	id := vars["id"]
-	if id == "" {
-		p.logger.Errorf("Product ID is empty")
-		http.Error(w, "Product ID is empty", http.StatusBadRequest)
-		return
+// pageLinks возвращает ссылки на следующую и предыдущую страницы, если они существуют
+func pageLinks(current *url.URL, offset, limit, total int) (next, prev string) {
+	if offset+limit < total {
+		next = pageLink(current, offset+limit, limit)
+	}
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0
+		}
+		prev = pageLink(current, prevOffset, limit)
 	}
+	return next, prev
+}
+
+func pageLink(current *url.URL, offset, limit int) string {
+	values := current.Query()
+	values.Set("offset", strconv.Itoa(offset))
+	values.Set("limit", strconv.Itoa(limit))
+	return current.Path + "?" + values.Encode()
+}
 
-	id64, err := strconv.ParseInt(id, 10, 64)
+func (p *ProductHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handing GetByID product request")
+
+	productID, err := parseIDVar(r, "id")
 	if err != nil {
 		p.logger.Errorf("Failed to parse product ID: %v", err)
-		http.Error(w, "Invalid product ID format", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
 		return
 	}
 
-	currentProduct, err := p.productUsecase.GetByID(r.Context(), int32(id64))
+	product, err := p.productUsecase.GetByID(r.Context(), productID)
 	if err != nil {
-		p.logger.Errorf("Failed to get product for update: %v", err)
-		http.Error(w, "Failed to get product for update", http.StatusInternalServerError)
+		p.writeProductError(w, err, "Failed to get product")
 		return
 	}
 
-	var updateData map[string]interface{}
-	if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
-		p.logger.Errorf("Failed to decode update data: %v", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+	p.writeJSON(w, http.StatusOK, product)
+}
+
+// GetBySlug отдаёт товар по текущему или прежнему адресу; если slug ответа отличается от запрошенного,
+// клиент должен перенаправить на новый адрес
+func (p *ProductHandler) GetBySlug(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling GetBySlug product request")
+
+	product, err := p.productUsecase.GetBySlug(r.Context(), mux.Vars(r)["slug"])
+	if err != nil {
+		p.writeProductError(w, err, "Failed to get product")
 		return
 	}
 
-	if name, ok := updateData["name"].(string); ok && name != "" {
-		currentProduct.Name = name
-	}
+	p.writeJSON(w, http.StatusOK, product)
+}
+
+// productPatchRequest - тело PATCH /products/{id}; пропущенные поля не меняются, пустой slug тоже
+type productPatchRequest struct {
+	Name           *string             `json:"name"`
+	Description    *string             `json:"description"`
+	Slug           *string             `json:"slug"`
+	RegenerateSlug bool                `json:"regenerate_slug"`
+	Price          *decimal.Decimal    `json:"price"`
+	TaxClass       *domain.TaxClass    `json:"tax_class"`
+	ImageURL       *string             `json:"image_url"`
+	Variants       *[]variantRequest   `json:"variants"`
+	PriceTiers     *[]priceTierRequest `json:"price_tiers"`
+	Version        *int32              `json:"version"`
+}
 
-	if description, ok := updateData["description"].(string); ok && description != "" {
-		currentProduct.Description = description
+func (p *ProductHandler) Update(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling Update product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
 	}
 
-	if slug, ok := updateData["slug"].(string); ok && slug != "" {
-		currentProduct.Slug = slug
+	var req productPatchRequest
+	if err := decodeStrict(r, &req); err != nil {
+		p.logger.Errorf("Failed to decode update data: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.Version == nil {
+		p.logger.Errorf("Product version is missing in update request")
+		writeJSONError(w, http.StatusUnprocessableEntity, "Product version is required")
+		return
 	}
 
-	if priceStr, ok := updateData["price"].(string); ok && priceStr != "" {
-		price, err := decimal.NewFromString(priceStr)
-		if err != nil {
-			p.logger.Errorf("Failed to parse price: %v", err)
-			http.Error(w, "Invalid price format", http.StatusBadRequest)
-			return
-		}
-		currentProduct.Price = price
-	} else if priceFloat, ok := updateData["price"].(float64); ok {
-		price := decimal.NewFromFloat(priceFloat)
-		currentProduct.Price = price
+	currentProduct, err := p.productUsecase.GetByID(r.Context(), productID)
+	if err != nil {
+		p.writeProductError(w, err, "Failed to get product for update")
+		return
 	}
 
-	if imageURL, ok := updateData["image_url"].(string); ok {
-		currentProduct.ImageURL.String = imageURL
-		currentProduct.ImageURL.Valid = imageURL != ""
+	if req.Name != nil {
+		currentProduct.Name = *req.Name
+	}
+	if req.Description != nil {
+		currentProduct.Description = *req.Description
+	}
+	if req.Slug != nil && *req.Slug != "" {
+		currentProduct.Slug = *req.Slug
+	}
+	if req.RegenerateSlug {
+		currentProduct.Slug = ""
+	}
+	if req.Price != nil {
+		currentProduct.Price = *req.Price
+	}
+	if req.TaxClass != nil {
+		currentProduct.TaxClass = *req.TaxClass
+	}
+	if req.ImageURL != nil {
+		currentProduct.ImageURL.String = *req.ImageURL
+		currentProduct.ImageURL.Valid = *req.ImageURL != ""
+	}
+	currentProduct.Version = *req.Version
+	// варианты и оптовые цены заменяются в той же транзакции, только если пришли в запросе
+	currentProduct.Variants = nil
+	if req.Variants != nil {
+		currentProduct.Variants = variantsFromRequest(*req.Variants)
+	}
+	currentProduct.PriceTiers = nil
+	if req.PriceTiers != nil {
+		currentProduct.PriceTiers = priceTiersFromRequest(*req.PriceTiers)
 	}
 
 	updatedProduct, err := p.productUsecase.Update(r.Context(), currentProduct)
 	if err != nil {
-		p.logger.Errorf("Failed to updated product: %v", err)
-		http.Error(w, "Failed to update product", http.StatusInternalServerError)
+		p.writeProductError(w, err, "Failed to update product")
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(updatedProduct); err != nil {
-		p.logger.Errorf("Failed to encode updated product: %v", err)
-		http.Error(w, "Failed to encode updated product", http.StatusInternalServerError)
-		return
-	}
+	p.writeJSON(w, http.StatusOK, updatedProduct)
 }
 
 func (p *ProductHandler) AuthMiddleware(next http.Handler) http.Handler {
@@ -159,17 +380,287 @@ func (p *ProductHandler) AuthMiddleware(next http.Handler) http.Handler {
 
 		if apiKey == "" {
 			p.logger.Errorf("API key is empty")
-			http.Error(w, "API key is required", http.StatusUnauthorized)
+			writeJSONError(w, http.StatusUnauthorized, "API key is required")
 			return
 		}
 
 		if apiKey != p.apiKey {
 			p.logger.Errorf("Invalid API key provided: %s", apiKey)
-			http.Error(w, "Invalid API key", http.StatusUnauthorized)
+			writeJSONError(w, http.StatusUnauthorized, "Invalid API key")
 			return
 		}
 
 		p.logger.Infof("Request authenticated successfully")
+		if userID, err := strconv.ParseInt(r.Header.Get("X-User-ID"), 10, 64); err == nil {
+			r = r.WithContext(domain.ContextWithUserID(r.Context(), userID))
+		}
 		next.ServeHTTP(w, r)
 	})
 }
+
+func (p *ProductHandler) GetRevisions(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling GetRevisions product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	revisions, err := p.productUsecase.GetRevisions(r.Context(), productID)
+	if err != nil {
+		p.logger.Errorf("Failed to get product revisions: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to get product revisions")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(revisions); err != nil {
+		p.logger.Errorf("Failed to encode product revisions: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to encode product revisions")
+		return
+	}
+}
+
+func (p *ProductHandler) RestoreRevision(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling RestoreRevision product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	revisionID, err := parseIDVar(r, "revisionID")
+	if err != nil {
+		p.logger.Errorf("Failed to parse revision ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid revision ID format")
+		return
+	}
+
+	var body struct {
+		Version *int32 `json:"version"`
+	}
+	if err := decodeStrict(r, &body); err != nil {
+		p.logger.Errorf("Failed to decode restore request: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if body.Version == nil {
+		writeJSONError(w, http.StatusUnprocessableEntity, "Product version is required")
+		return
+	}
+
+	product, err := p.productUsecase.RestoreRevision(r.Context(), productID, revisionID, *body.Version)
+	switch {
+	case errors.Is(err, domain.ErrRevisionNotFound):
+		writeJSONError(w, http.StatusNotFound, "Revision not found")
+		return
+	case errors.Is(err, domain.ErrRevisionNotRestorable):
+		writeJSONError(w, http.StatusUnprocessableEntity, "Revision cannot be restored")
+		return
+	case errors.Is(err, domain.ErrVersionConflict):
+		p.logger.Warnf("Rejected stale restore: %v", err)
+		writeJSONError(w, http.StatusConflict, "Product was modified by another request")
+		return
+	case err != nil:
+		p.logger.Errorf("Failed to restore product revision: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to restore product revision")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(product); err != nil {
+		p.logger.Errorf("Failed to encode restored product: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to encode restored product")
+		return
+	}
+}
+
+type variantRequest struct {
+	ID          int32                 `json:"id"`
+	SKU         string                `json:"sku"`
+	Options     domain.VariantOptions `json:"options"`
+	Price       decimal.NullDecimal   `json:"price"`
+	WeightGrams *int32                `json:"weight_grams"`
+	IsActive    *bool                 `json:"is_active"`
+}
+
+func (p *ProductHandler) GetVariants(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling GetVariants product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	variants, err := p.productUsecase.GetVariants(r.Context(), productID)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeJSONError(w, http.StatusNotFound, "Product not found")
+		return
+	}
+	if err != nil {
+		p.logger.Errorf("Failed to get product variants: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to get product variants")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(variants); err != nil {
+		p.logger.Errorf("Failed to encode product variants: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to encode product variants")
+		return
+	}
+}
+
+// SetVariants заменяет набор вариантов товара: варианты без id создаются, отсутствующие в запросе удаляются
+func (p *ProductHandler) SetVariants(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling SetVariants product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var body struct {
+		Variants []variantRequest `json:"variants"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		p.logger.Errorf("Failed to decode product variants: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	saved, err := p.productUsecase.SetVariants(r.Context(), productID, variantsFromRequest(body.Variants))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		writeJSONError(w, http.StatusNotFound, "Product not found")
+		return
+	case errors.Is(err, domain.ErrVariantInvalid):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	case errors.Is(err, domain.ErrVariantSKUTaken):
+		writeJSONError(w, http.StatusConflict, err.Error())
+		return
+	case err != nil:
+		p.logger.Errorf("Failed to set product variants: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to set product variants")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		p.logger.Errorf("Failed to encode product variants: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to encode product variants")
+		return
+	}
+}
+
+type imageRequest struct {
+	ID        int32  `json:"id"`
+	Alt       string `json:"alt"`
+	Position  int32  `json:"position"`
+	IsPrimary bool   `json:"is_primary"`
+}
+
+func (p *ProductHandler) GetImages(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling GetImages product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	images, err := p.productUsecase.GetImages(r.Context(), productID)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeJSONError(w, http.StatusNotFound, "Product not found")
+		return
+	}
+	if err != nil {
+		p.logger.Errorf("Failed to get product images: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to get product images")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(images); err != nil {
+		p.logger.Errorf("Failed to encode product images: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to encode product images")
+		return
+	}
+}
+
+// ArrangeImages сохраняет порядок, подписи и основное изображение галереи.
+// Загрузка и удаление файлов идут через сервис изображений
+func (p *ProductHandler) ArrangeImages(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling ArrangeImages product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var body struct {
+		Images []imageRequest `json:"images"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		p.logger.Errorf("Failed to decode product images: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	images := make([]*domain.ProductImage, len(body.Images))
+	for i, req := range body.Images {
+		images[i] = &domain.ProductImage{
+			ID:        req.ID,
+			ProductID: productID,
+			Alt:       req.Alt,
+			Position:  req.Position,
+			IsPrimary: req.IsPrimary,
+		}
+	}
+
+	arranged, err := p.productUsecase.ArrangeImages(r.Context(), productID, images)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		writeJSONError(w, http.StatusNotFound, "Product not found")
+		return
+	case errors.Is(err, domain.ErrImageInvalid), errors.Is(err, domain.ErrImageNotFound):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	case err != nil:
+		p.logger.Errorf("Failed to arrange product images: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to arrange product images")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(arranged); err != nil {
+		p.logger.Errorf("Failed to encode product images: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to encode product images")
+		return
+	}
+}
+
+func parseIDVar(r *http.Request, name string) (int32, error) {
+	value := mux.Vars(r)[name]
+	if value == "" {
+		return 0, fmt.Errorf("%s is empty", name)
+	}
+
+	id, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return int32(id), nil
+}
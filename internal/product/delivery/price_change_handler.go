@@ -0,0 +1,184 @@
+package delivery
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+)
+
+type PriceChangeHandler struct {
+	priceUsecase usecase.PriceChangeUseCase
+	logger       common.Logger
+}
+
+func NewPriceChangeHandler(priceUsecase usecase.PriceChangeUseCase, logger common.Logger) *PriceChangeHandler {
+	return &PriceChangeHandler{
+		priceUsecase: priceUsecase,
+		logger:       logger,
+	}
+}
+
+type priceChangeRequest struct {
+	Price       decimal.Decimal `json:"price"`
+	EffectiveAt time.Time       `json:"effective_at"`
+	Comment     string          `json:"comment"`
+}
+
+func (h *PriceChangeHandler) GetChanges(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetChanges price request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	changes, err := h.priceUsecase.GetChanges(r.Context(), productID)
+	if err != nil {
+		h.writeError(w, err, "Failed to get price changes")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, changes)
+}
+
+func (h *PriceChangeHandler) Schedule(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Schedule price request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var req priceChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf("Failed to decode price change: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	change, err := h.priceUsecase.Schedule(r.Context(), &domain.PriceChange{
+		ProductID:   productID,
+		Price:       req.Price,
+		EffectiveAt: req.EffectiveAt,
+		Comment:     req.Comment,
+	})
+	if err != nil {
+		h.writeError(w, err, "Failed to schedule price change")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, change)
+}
+
+func (h *PriceChangeHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Cancel price request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+	changeID, err := parseIDVar(r, "changeID")
+	if err != nil {
+		h.logger.Errorf("Failed to parse price change ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid price change ID format")
+		return
+	}
+
+	change, err := h.priceUsecase.Cancel(r.Context(), productID, changeID)
+	if err != nil {
+		h.writeError(w, err, "Failed to cancel price change")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, change)
+}
+
+func (h *PriceChangeHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetHistory price request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	history, err := h.priceUsecase.GetHistory(r.Context(), productID)
+	if err != nil {
+		h.writeError(w, err, "Failed to get price history")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, history)
+}
+
+// PriceAt отдаёт цену, действовавшую на момент ?at=: RFC 3339 или дата YYYY-MM-DD (начало суток по времени сервиса)
+func (h *PriceChangeHandler) PriceAt(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling PriceAt price request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	at := time.Now()
+	if value := r.URL.Query().Get("at"); value != "" {
+		if at, err = parsePriceMoment(value); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid at: expected RFC 3339 time or YYYY-MM-DD date")
+			return
+		}
+	}
+
+	entry, err := h.priceUsecase.PriceAt(r.Context(), productID, at)
+	if err != nil {
+		h.writeError(w, err, "Failed to get price")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, entry)
+}
+
+func parsePriceMoment(value string) (time.Time, error) {
+	if at, err := time.Parse(time.RFC3339, value); err == nil {
+		return at, nil
+	}
+	return time.ParseInLocation(time.DateOnly, value, time.Local)
+}
+
+func (h *PriceChangeHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		writeJSONError(w, http.StatusNotFound, "Product or price not found")
+	case errors.Is(err, domain.ErrPriceChangeNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrPriceChangeInvalid):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	default:
+		h.logger.Errorf("%s: %v", message, err)
+		writeJSONError(w, http.StatusInternalServerError, message)
+	}
+}
+
+func (h *PriceChangeHandler) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.logger.Errorf("Failed to encode response: %v", err)
+	}
+}
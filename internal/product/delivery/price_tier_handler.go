@@ -0,0 +1,147 @@
+package delivery
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+type priceTierRequest struct {
+	MinQuantity int32           `json:"min_quantity"`
+	UnitPrice   decimal.Decimal `json:"unit_price"`
+}
+
+func priceTiersFromRequest(reqs []priceTierRequest) []*domain.PriceTier {
+	tiers := make([]*domain.PriceTier, len(reqs))
+	for i, req := range reqs {
+		tiers[i] = &domain.PriceTier{MinQuantity: req.MinQuantity, UnitPrice: req.UnitPrice}
+	}
+	return tiers
+}
+
+func (p *ProductHandler) GetPriceTiers(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling GetPriceTiers product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	tiers, err := p.productUsecase.GetPriceTiers(r.Context(), productID)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeJSONError(w, http.StatusNotFound, "Product not found")
+		return
+	}
+	if err != nil {
+		p.logger.Errorf("Failed to get price tiers: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to get price tiers")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(tiers); err != nil {
+		p.logger.Errorf("Failed to encode price tiers: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to encode price tiers")
+		return
+	}
+}
+
+// SetPriceTiers заменяет все оптовые ступени товара; пустой список отключает оптовые цены
+func (p *ProductHandler) SetPriceTiers(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling SetPriceTiers product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var body struct {
+		PriceTiers []priceTierRequest `json:"price_tiers"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		p.logger.Errorf("Failed to decode price tiers: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	saved, err := p.productUsecase.SetPriceTiers(r.Context(), productID, priceTiersFromRequest(body.PriceTiers))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		writeJSONError(w, http.StatusNotFound, "Product not found")
+		return
+	case errors.Is(err, domain.ErrPriceTierInvalid):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	case err != nil:
+		p.logger.Errorf("Failed to set price tiers: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to set price tiers")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		p.logger.Errorf("Failed to encode price tiers: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to encode price tiers")
+		return
+	}
+}
+
+// Quote считает цену партии: GET /products/{id}/quote?quantity=1500&variant_id=7
+func (p *ProductHandler) Quote(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling Quote product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	quantity, err := strconv.ParseInt(r.URL.Query().Get("quantity"), 10, 32)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "Invalid quantity")
+		return
+	}
+
+	var variantID *int32
+	if value := r.URL.Query().Get("variant_id"); value != "" {
+		id, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid variant ID format")
+			return
+		}
+		variant := int32(id)
+		variantID = &variant
+	}
+
+	quote, err := p.productUsecase.Quote(r.Context(), productID, variantID, int32(quantity))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		writeJSONError(w, http.StatusNotFound, "Product not found")
+		return
+	case errors.Is(err, domain.ErrPriceTierInvalid):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	case err != nil:
+		p.logger.Errorf("Failed to quote product: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to quote product")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(quote); err != nil {
+		p.logger.Errorf("Failed to encode quote: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to encode quote")
+		return
+	}
+}
@@ -0,0 +1,273 @@
+package delivery
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+)
+
+// ProductEvents - события саг товара для запросов, пришедших по HTTP
+type ProductEvents interface {
+	NotifyCreated(ctx context.Context, productID int32)
+	NotifyDeleted(ctx context.Context, productID int32)
+	DeleteWithImages(ctx context.Context, product *domain.Product) error
+}
+
+// productRequest - тело POST и PUT /products. Изображения загружаются через сервис изображений
+type productRequest struct {
+	Name        *string            `json:"name"`
+	Slug        string             `json:"slug"`
+	Description string             `json:"description"`
+	Price       *decimal.Decimal   `json:"price"`
+	TaxClass    string             `json:"tax_class"`
+	Version     *int32             `json:"version"`
+	Variants    []variantRequest   `json:"variants"`
+	PriceTiers  []priceTierRequest `json:"price_tiers"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// toDomain проверяет обязательные поля; варианты и оптовые цены всегда заменяются целиком
+func (req productRequest) toDomain() (*domain.Product, error) {
+	if req.Name == nil {
+		return nil, fmt.Errorf("%w: name is required", domain.ErrProductInvalid)
+	}
+	if req.Price == nil {
+		return nil, fmt.Errorf("%w: price is required", domain.ErrProductInvalid)
+	}
+
+	product := &domain.Product{
+		Name:        *req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+		Price:       *req.Price,
+		TaxClass:    domain.TaxClass(req.TaxClass),
+		Variants:    variantsFromRequest(req.Variants),
+		PriceTiers:  priceTiersFromRequest(req.PriceTiers),
+	}
+	if product.TaxClass == "" {
+		product.TaxClass = domain.TaxClassStandard
+	}
+	return product, nil
+}
+
+func variantsFromRequest(reqs []variantRequest) []*domain.ProductVariant {
+	variants := make([]*domain.ProductVariant, len(reqs))
+	for i, req := range reqs {
+		variants[i] = &domain.ProductVariant{
+			ID:          req.ID,
+			SKU:         req.SKU,
+			Options:     req.Options,
+			Price:       req.Price,
+			WeightGrams: req.WeightGrams,
+			IsActive:    req.IsActive == nil || *req.IsActive,
+		}
+	}
+	return variants
+}
+
+// Create создаёт активный опубликованный товар тем же путём, что и сага создания без изображения
+func (p *ProductHandler) Create(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling Create product request")
+
+	var req productRequest
+	if err := decodeStrict(r, &req); err != nil {
+		p.logger.Errorf("Failed to decode product: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	product, err := req.toDomain()
+	if err != nil {
+		p.writeProductError(w, err, "Failed to create product")
+		return
+	}
+
+	created, err := p.productUsecase.Create(r.Context(), product)
+	if err != nil {
+		p.writeProductError(w, err, "Failed to create product")
+		return
+	}
+	p.events.NotifyCreated(r.Context(), created.ID)
+
+	w.Header().Set("Location", fmt.Sprintf("/products/%d", created.ID))
+	p.writeJSON(w, http.StatusCreated, created)
+}
+
+// Replace заменяет товар целиком: пропущенные описание, варианты и оптовые цены очищаются,
+// ставка НДС становится основной. Адрес без slug в запросе не меняется, чтобы не ломать ссылки
+func (p *ProductHandler) Replace(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling Replace product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var req productRequest
+	if err := decodeStrict(r, &req); err != nil {
+		p.logger.Errorf("Failed to decode product: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.Version == nil {
+		writeJSONError(w, http.StatusUnprocessableEntity, "Product version is required")
+		return
+	}
+
+	product, err := req.toDomain()
+	if err != nil {
+		p.writeProductError(w, err, "Failed to replace product")
+		return
+	}
+
+	product.ID = productID
+	product.Version = *req.Version
+	replaced, err := ReplaceProduct(r.Context(), p.productUsecase, product)
+	if err != nil {
+		p.writeProductError(w, err, "Failed to replace product")
+		return
+	}
+
+	p.writeJSON(w, http.StatusOK, replaced)
+}
+
+// ReplaceProduct заменяет активный товар целиком для HTTP и gRPC; пустой Slug оставляет прежний адрес
+func ReplaceProduct(ctx context.Context, products usecase.ProductUseCase, product *domain.Product) (*domain.Product, error) {
+	current, err := products.GetByID(ctx, product.ID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != domain.ProductStatusActive {
+		return nil, domain.ErrProductBusy
+	}
+	if product.Slug == "" {
+		product.Slug = current.Slug
+	}
+	return products.Update(ctx, product)
+}
+
+// Delete удаляет товар; если сага удаления не завершилась за время запроса, ответ 202
+func (p *ProductHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling Delete product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	pending, err := DeleteProduct(r.Context(), p.productUsecase, p.events, productID)
+	switch {
+	case errors.Is(err, ErrImagesNotDeleted):
+		writeJSONError(w, http.StatusBadGateway, err.Error())
+	case err != nil:
+		p.writeProductError(w, err, "Failed to delete product")
+	case pending != nil:
+		w.Header().Set("Location", fmt.Sprintf("/products/%d", productID))
+		p.writeJSON(w, http.StatusAccepted, pending)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// ErrImagesNotDeleted - сага удаления откатилась: сервис изображений не смог удалить файлы
+var ErrImagesNotDeleted = errors.New("failed to delete product images")
+
+// DeleteProduct удаляет товар для HTTP и gRPC. Товар без изображений удаляется сразу, с изображениями - сагой
+// через сервис изображений; если сага не завершилась за время запроса, возвращается товар в статусе deleting
+func DeleteProduct(ctx context.Context, products usecase.ProductUseCase, events ProductEvents, productID int32) (*domain.Product, error) {
+	product, err := products.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product.Status != domain.ProductStatusActive {
+		return nil, domain.ErrProductBusy
+	}
+
+	if len(product.Images) == 0 && product.ImageURL.String == "" {
+		if err := products.BeginDelete(ctx, productID); err != nil {
+			return nil, err
+		}
+		if err := products.CompleteDelete(ctx, productID); err != nil {
+			return nil, err
+		}
+		events.NotifyDeleted(ctx, productID)
+		return nil, nil
+	}
+
+	err = events.DeleteWithImages(ctx, product)
+	if !errors.Is(err, domain.ErrSagaPending) {
+		return nil, err
+	}
+
+	current, err := products.GetByID(ctx, productID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case current.Status == domain.ProductStatusDeleting:
+		return current, nil
+	default:
+		return nil, ErrImagesNotDeleted
+	}
+}
+
+// writeProductError переводит доменные ошибки товара в HTTP-статусы с телом {"error": "..."}
+func (p *ProductHandler) writeProductError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		writeJSONError(w, http.StatusNotFound, "Product not found")
+	case errors.Is(err, domain.ErrVersionConflict):
+		p.logger.Warnf("Rejected stale product change: %v", err)
+		writeJSONError(w, http.StatusConflict, "Product was modified by another request")
+	case errors.Is(err, domain.ErrProductBusy), errors.Is(err, domain.ErrVariantSKUTaken):
+		writeJSONError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrProductInvalid),
+		errors.Is(err, domain.ErrTaxClassInvalid),
+		errors.Is(err, domain.ErrVariantInvalid),
+		errors.Is(err, domain.ErrPriceTierInvalid),
+		errors.Is(err, domain.ErrVisibilityInvalid),
+		errors.Is(err, domain.ErrRelationInvalid):
+		writeJSONError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		p.logger.Errorf("%s: %v", message, err)
+		writeJSONError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// writeJSONError отвечает ошибкой в виде errorResponse: так отвечают все обработчики API товаров
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(errorResponse{Error: message})
+}
+
+func (p *ProductHandler) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		p.logger.Errorf("Failed to encode response: %v", err)
+	}
+}
+
+// decodeStrict читает JSON-тело и отклоняет неизвестные поля, чтобы опечатка не превращалась в сброс поля при PUT
+func decodeStrict(r *http.Request, v interface{}) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
@@ -0,0 +1,100 @@
+package delivery
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+// relationRequest - ручная связь в теле PUT /products/{id}/relations
+type relationRequest struct {
+	RelatedID int32               `json:"related_id"`
+	Kind      domain.RelationKind `json:"kind"`
+}
+
+func (p *ProductHandler) GetRelations(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling GetRelations product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	relations, err := p.productUsecase.GetRelations(r.Context(), productID)
+	if err != nil {
+		p.writeProductError(w, err, "Failed to get product relations")
+		return
+	}
+
+	p.writeJSON(w, http.StatusOK, relations)
+}
+
+// SetRelations заменяет все ручные связи товара; пустой список оставляет только похожие товары
+func (p *ProductHandler) SetRelations(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling SetRelations product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var body struct {
+		Relations []relationRequest `json:"relations"`
+	}
+	if err := decodeStrict(r, &body); err != nil {
+		p.logger.Errorf("Failed to decode product relations: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	relations := make([]*domain.ProductRelation, len(body.Relations))
+	for i, req := range body.Relations {
+		relations[i] = &domain.ProductRelation{RelatedID: req.RelatedID, Kind: req.Kind}
+	}
+
+	saved, err := p.productUsecase.SetRelations(r.Context(), productID, relations)
+	if err != nil {
+		p.writeProductError(w, err, "Failed to set product relations")
+		return
+	}
+
+	p.writeJSON(w, http.StatusOK, saved)
+}
+
+// GetRecommendations отдаёт товары для блока «С этим товаром покупают» на витрине
+func (p *ProductHandler) GetRecommendations(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling GetRecommendations product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var limit int
+	if value := r.URL.Query().Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+	}
+
+	recommendations, err := p.productUsecase.GetRecommendations(r.Context(), productID, limit)
+	if errors.Is(err, domain.ErrInvalidQuery) {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		p.writeProductError(w, err, "Failed to get product recommendations")
+		return
+	}
+
+	p.writeJSON(w, http.StatusOK, recommendations)
+}
@@ -0,0 +1,167 @@
+package delivery
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+)
+
+type ReviewHandler struct {
+	reviewUsecase usecase.ReviewUseCase
+	logger        common.Logger
+}
+
+func NewReviewHandler(reviewUsecase usecase.ReviewUseCase, logger common.Logger) *ReviewHandler {
+	return &ReviewHandler{
+		reviewUsecase: reviewUsecase,
+		logger:        logger,
+	}
+}
+
+// reviewRequest - отзыв покупателя; author_key передаёт витрина, чтобы отсечь повторы
+type reviewRequest struct {
+	AuthorName string `json:"author_name"`
+	Company    string `json:"company"`
+	Rating     int32  `json:"rating"`
+	Text       string `json:"text"`
+	AuthorKey  string `json:"author_key"`
+}
+
+type reviewModerationRequest struct {
+	Status domain.ReviewStatus `json:"status"`
+	Reply  string              `json:"reply"`
+}
+
+// GetAll отдаёт очередь модерации или одобренные отзывы товара, в зависимости от фильтров
+func (h *ReviewHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetAll reviews request")
+
+	values := r.URL.Query()
+	query := domain.ReviewQuery{Status: domain.ReviewStatus(values.Get("status"))}
+	if value := values.Get("product_id"); value != "" {
+		productID, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid product_id")
+			return
+		}
+		id := int32(productID)
+		query.ProductID = &id
+	}
+	var err error
+	if value := values.Get("limit"); value != "" {
+		if query.Limit, err = strconv.Atoi(value); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+	}
+	if value := values.Get("offset"); value != "" {
+		if query.Offset, err = strconv.Atoi(value); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid offset")
+			return
+		}
+	}
+
+	page, err := h.reviewUsecase.GetAll(r.Context(), query)
+	if err != nil {
+		h.writeError(w, err, "Failed to get reviews")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, page)
+}
+
+func (h *ReviewHandler) Create(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Create review request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var req reviewRequest
+	if err := decodeStrict(r, &req); err != nil {
+		h.logger.Errorf("Failed to decode review: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	review, err := h.reviewUsecase.Create(r.Context(), &domain.Review{
+		ProductID:  productID,
+		AuthorName: req.AuthorName,
+		Company:    req.Company,
+		Rating:     req.Rating,
+		Text:       req.Text,
+		AuthorKey:  req.AuthorKey,
+	})
+	if err != nil {
+		h.writeError(w, err, "Failed to create review")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, review)
+}
+
+func (h *ReviewHandler) Moderate(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling Moderate review request")
+
+	reviewID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse review ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid review ID format")
+		return
+	}
+
+	var req reviewModerationRequest
+	if err := decodeStrict(r, &req); err != nil {
+		h.logger.Errorf("Failed to decode review moderation: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	review, err := h.reviewUsecase.Moderate(r.Context(), &domain.ReviewModeration{
+		ReviewID: reviewID,
+		Status:   req.Status,
+		Reply:    req.Reply,
+	})
+	if err != nil {
+		h.writeError(w, err, "Failed to moderate review")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, review)
+}
+
+// writeError переводит доменные ошибки отзывов в HTTP-статусы
+func (h *ReviewHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		writeJSONError(w, http.StatusNotFound, "Product not found")
+	case errors.Is(err, domain.ErrReviewNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidQuery):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrReviewInvalid):
+		writeJSONError(w, http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, domain.ErrReviewTooFrequent):
+		writeJSONError(w, http.StatusTooManyRequests, err.Error())
+	default:
+		h.logger.Errorf("%s: %v", message, err)
+		writeJSONError(w, http.StatusInternalServerError, message)
+	}
+}
+
+func (h *ReviewHandler) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.logger.Errorf("Failed to encode response: %v", err)
+	}
+}
@@ -0,0 +1,262 @@
+package delivery
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+)
+
+type StockHandler struct {
+	stockUsecase usecase.StockUseCase
+	logger       common.Logger
+}
+
+func NewStockHandler(stockUsecase usecase.StockUseCase, logger common.Logger) *StockHandler {
+	return &StockHandler{
+		stockUsecase: stockUsecase,
+		logger:       logger,
+	}
+}
+
+type stockMovementRequest struct {
+	VariantID *int32                   `json:"variant_id"`
+	Kind      domain.StockMovementKind `json:"kind"`
+	Quantity  int32                    `json:"quantity"`
+	Reference string                   `json:"reference"`
+	Comment   string                   `json:"comment"`
+}
+
+type stockReservationRequest struct {
+	VariantID *int32 `json:"variant_id"`
+	Quantity  int32  `json:"quantity"`
+	Reference string `json:"reference"`
+}
+
+type stockThresholdRequest struct {
+	VariantID *int32 `json:"variant_id"`
+	Threshold int32  `json:"threshold"`
+}
+
+func (h *StockHandler) GetStock(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetStock request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	items, err := h.stockUsecase.GetStock(r.Context(), productID)
+	if err != nil {
+		h.writeError(w, err, "Failed to get stock")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, items)
+}
+
+func (h *StockHandler) GetMovements(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetMovements stock request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+	}
+
+	movements, err := h.stockUsecase.GetMovements(r.Context(), productID, limit)
+	if err != nil {
+		h.writeError(w, err, "Failed to get stock movements")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, movements)
+}
+
+// CreateMovement проводит приход, резерв, снятие резерва, отгрузку или корректировку
+func (h *StockHandler) CreateMovement(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling CreateMovement stock request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var req stockMovementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf("Failed to decode stock movement: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	item, err := h.stockUsecase.Move(r.Context(), &domain.StockMovement{
+		ProductID: productID,
+		VariantID: req.VariantID,
+		Kind:      req.Kind,
+		Quantity:  req.Quantity,
+		Reference: req.Reference,
+		Comment:   req.Comment,
+	})
+	if err != nil {
+		h.writeError(w, err, "Failed to move stock")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, item)
+}
+
+func (h *StockHandler) SetThreshold(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling SetThreshold stock request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var req stockThresholdRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf("Failed to decode stock threshold: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	item, err := h.stockUsecase.SetThreshold(r.Context(), productID, req.VariantID, req.Threshold)
+	if err != nil {
+		h.writeError(w, err, "Failed to set stock threshold")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, item)
+}
+
+func (h *StockHandler) GetReservations(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling GetReservations stock request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	reservations, err := h.stockUsecase.GetReservations(r.Context(), productID)
+	if err != nil {
+		h.writeError(w, err, "Failed to get stock reservations")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, reservations)
+}
+
+// CreateReservation резервирует остаток под заявку; резерв снимается сам, если его не подтвердят до expires_at
+func (h *StockHandler) CreateReservation(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling CreateReservation stock request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var req stockReservationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf("Failed to decode stock reservation: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	reservation, err := h.stockUsecase.Reserve(r.Context(), &domain.StockReservation{
+		ProductID: productID,
+		VariantID: req.VariantID,
+		Quantity:  req.Quantity,
+		Reference: req.Reference,
+	})
+	if err != nil {
+		h.writeError(w, err, "Failed to reserve stock")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, reservation)
+}
+
+func (h *StockHandler) ConfirmReservation(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling ConfirmReservation stock request")
+	h.closeReservation(w, r, h.stockUsecase.ConfirmReservation, "Failed to confirm stock reservation")
+}
+
+func (h *StockHandler) ReleaseReservation(w http.ResponseWriter, r *http.Request) {
+	h.logger.Infof("Handling ReleaseReservation stock request")
+	h.closeReservation(w, r, h.stockUsecase.ReleaseReservation, "Failed to release stock reservation")
+}
+
+func (h *StockHandler) closeReservation(w http.ResponseWriter, r *http.Request, close func(ctx context.Context, productID, reservationID int32) (*domain.StockReservation, error), message string) {
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		h.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+	reservationID, err := parseIDVar(r, "reservationID")
+	if err != nil {
+		h.logger.Errorf("Failed to parse reservation ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid reservation ID format")
+		return
+	}
+
+	reservation, err := close(r.Context(), productID, reservationID)
+	if err != nil {
+		h.writeError(w, err, message)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, reservation)
+}
+
+// writeError переводит доменные ошибки остатков в HTTP-статусы
+func (h *StockHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		writeJSONError(w, http.StatusNotFound, "Product not found")
+	case errors.Is(err, domain.ErrStockReservationNotFound):
+		writeJSONError(w, http.StatusNotFound, "Stock reservation not found")
+	case errors.Is(err, domain.ErrStockReservationClosed):
+		writeJSONError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrStockInvalid):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrStockInsufficient):
+		writeJSONError(w, http.StatusConflict, err.Error())
+	default:
+		h.logger.Errorf("%s: %v", message, err)
+		writeJSONError(w, http.StatusInternalServerError, message)
+	}
+}
+
+func (h *StockHandler) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.logger.Errorf("Failed to encode response: %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+package delivery
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+// visibilityRequest - тело PUT /products/{id}/visibility
+type visibilityRequest struct {
+	Visibility  domain.ProductVisibility `json:"visibility"`
+	PublishAt   *time.Time               `json:"publish_at"`
+	UnpublishAt *time.Time               `json:"unpublish_at"`
+	Version     *int32                   `json:"version"`
+}
+
+// SetVisibility публикует, скрывает или планирует публикацию товара, не трогая остальные поля
+func (p *ProductHandler) SetVisibility(w http.ResponseWriter, r *http.Request) {
+	p.logger.Infof("Handling SetVisibility product request")
+
+	productID, err := parseIDVar(r, "id")
+	if err != nil {
+		p.logger.Errorf("Failed to parse product ID: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid product ID format")
+		return
+	}
+
+	var req visibilityRequest
+	if err := decodeStrict(r, &req); err != nil {
+		p.logger.Errorf("Failed to decode visibility: %v", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.Version == nil {
+		writeJSONError(w, http.StatusUnprocessableEntity, "Product version is required")
+		return
+	}
+
+	product, err := p.productUsecase.SetVisibility(r.Context(), &domain.VisibilityChange{
+		ProductID:   productID,
+		Visibility:  req.Visibility,
+		PublishAt:   req.PublishAt,
+		UnpublishAt: req.UnpublishAt,
+		Version:     *req.Version,
+	})
+	if err != nil {
+		p.writeProductError(w, err, "Failed to set product visibility")
+		return
+	}
+
+	p.writeJSON(w, http.StatusOK, product)
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrAttributeNotFound     = errors.New("attribute not found")
+	ErrAttributeInvalid      = errors.New("invalid attribute")
+	ErrAttributeCodeTaken    = errors.New("attribute code is already taken")
+	ErrAttributeValueInvalid = errors.New("invalid attribute value")
+)
+
+// AttributeType определяет, как хранится и проверяется значение характеристики
+type AttributeType string
+
+const (
+	// AttributeTypeNumber - число с единицей измерения Unit
+	AttributeTypeNumber AttributeType = "number"
+	// AttributeTypeEnum - одно значение из списка Options
+	AttributeTypeEnum AttributeType = "enum"
+	AttributeTypeText AttributeType = "text"
+)
+
+func (t AttributeType) IsValid() bool {
+	switch t {
+	case AttributeTypeNumber, AttributeTypeEnum, AttributeTypeText:
+		return true
+	}
+	return false
+}
+
+// Attribute - характеристика товара: материал, толщина, высота и т.п.
+// CategoryIDs ограничивает её разделами каталога; пустой список - характеристика общая для всех товаров
+type Attribute struct {
+	ID          int32          `json:"id" db:"id"`
+	Code        string         `json:"code" db:"code"`
+	Name        string         `json:"name" db:"name"`
+	Type        AttributeType  `json:"type" db:"type"`
+	Unit        string         `json:"unit" db:"unit"`
+	Options     pq.StringArray `json:"options" db:"options"`
+	Position    int32          `json:"position" db:"position"`
+	CategoryIDs pq.Int32Array  `json:"category_ids" db:"category_ids"`
+	CreatedAt   time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at" db:"updated_at"`
+}
+
+// AppliesTo проверяет, относится ли характеристика к товару из разделов categoryIDs
+func (a *Attribute) AppliesTo(categoryIDs []int32) bool {
+	if len(a.CategoryIDs) == 0 {
+		return true
+	}
+	for _, scope := range a.CategoryIDs {
+		for _, id := range categoryIDs {
+			if scope == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ProductAttributeValue - значение характеристики у товара вместе с её описанием для вывода.
+// У чисел заполнено Number, у списков и текста - Text
+type ProductAttributeValue struct {
+	AttributeID int32               `json:"attribute_id" db:"attribute_id"`
+	ProductID   int32               `json:"-" db:"product_id"`
+	Code        string              `json:"code" db:"code"`
+	Name        string              `json:"name" db:"name"`
+	Type        AttributeType       `json:"type" db:"type"`
+	Unit        string              `json:"unit" db:"unit"`
+	Number      decimal.NullDecimal `json:"number" db:"value_number"`
+	Text        string              `json:"text" db:"value_text"`
+}
+
+// AttributeFilter отбирает товары по характеристике Code: Values - для списков и текста, Min и Max - для чисел
+type AttributeFilter struct {
+	Code   string
+	Values []string
+	Min    *decimal.Decimal
+	Max    *decimal.Decimal
+}
+
+type AttributeRepository interface {
+	GetAll(ctx context.Context) ([]*Attribute, error)
+	GetByID(ctx context.Context, id int32) (*Attribute, error)
+	Create(ctx context.Context, attribute *Attribute) (*Attribute, error)
+	Update(ctx context.Context, attribute *Attribute) (*Attribute, error)
+	Delete(ctx context.Context, id int32) error
+	GetValues(ctx context.Context, productID int32) ([]*ProductAttributeValue, error)
+	GetValuesByProducts(ctx context.Context, productIDs []int32) (map[int32][]*ProductAttributeValue, error)
+	// ReplaceValues заменяет все значения характеристик товара
+	ReplaceValues(ctx context.Context, productID int32, values []*ProductAttributeValue) error
+}
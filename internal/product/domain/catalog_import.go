@@ -0,0 +1,61 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrImportFormat - файл не читается или в нём неизвестные столбцы
+	ErrImportFormat = errors.New("invalid import file")
+	// ErrImportInvalid - в файле есть строки с ошибками, импорт не применяется
+	ErrImportInvalid = errors.New("import has invalid rows")
+)
+
+// ImportAction - что импорт сделает со строкой файла
+type ImportAction string
+
+const (
+	ImportActionCreate ImportAction = "create"
+	ImportActionUpdate ImportAction = "update"
+	ImportActionSkip   ImportAction = "skip"
+	ImportActionError  ImportAction = "error"
+)
+
+// ImportRow - результат проверки или применения строки файла
+type ImportRow struct {
+	Line      int          `json:"line"`
+	Action    ImportAction `json:"action"`
+	ProductID int32        `json:"product_id,omitempty"`
+	Name      string       `json:"name"`
+	Slug      string       `json:"slug,omitempty"`
+	// Changes - изменённые поля товара в виде «поле: было → стало»
+	Changes []string `json:"changes,omitempty"`
+	Errors  []string `json:"errors,omitempty"`
+	// Images - файлы из архива изображений, которые нужно загрузить товару
+	Images []string `json:"images,omitempty"`
+}
+
+// ImportReport - итог импорта; Applied == false - предварительная проверка без изменений
+type ImportReport struct {
+	Rows    []*ImportRow `json:"rows"`
+	Created int          `json:"created"`
+	Updated int          `json:"updated"`
+	Skipped int          `json:"skipped"`
+	Failed  int          `json:"failed"`
+	Applied bool         `json:"applied"`
+}
+
+// Count пересчитывает итоги по действиям строк
+func (r *ImportReport) Count() {
+	r.Created, r.Updated, r.Skipped, r.Failed = 0, 0, 0, 0
+	for _, row := range r.Rows {
+		switch row.Action {
+		case ImportActionCreate:
+			r.Created++
+		case ImportActionUpdate:
+			r.Updated++
+		case ImportActionSkip:
+			r.Skipped++
+		case ImportActionError:
+			r.Failed++
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrCategoryNotFound    = errors.New("category not found")
+	ErrCategoryInvalid     = errors.New("invalid category")
+	ErrCategorySlugTaken   = errors.New("category slug is already taken")
+	ErrCategoryCycle       = errors.New("category cannot be nested into itself")
+	ErrCategoryHasChildren = errors.New("category has subcategories")
+)
+
+// Category - раздел каталога; ParentID == nil у корневых разделов
+type Category struct {
+	ID             int32       `json:"id" db:"id"`
+	ParentID       *int32      `json:"parent_id" db:"parent_id"`
+	Name           string      `json:"name" db:"name"`
+	Slug           string      `json:"slug" db:"slug"`
+	Description    string      `json:"description" db:"description"`
+	SEOTitle       string      `json:"seo_title" db:"seo_title"`
+	SEODescription string      `json:"seo_description" db:"seo_description"`
+	Position       int32       `json:"position" db:"position"`
+	CreatedAt      time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at" db:"updated_at"`
+	Children       []*Category `json:"children,omitempty" db:"-"`
+}
+
+type CategoryRepository interface {
+	GetAll(ctx context.Context) ([]*Category, error)
+	GetByID(ctx context.Context, id int32) (*Category, error)
+	GetBySlug(ctx context.Context, slug string) (*Category, error)
+	Create(ctx context.Context, category *Category) (*Category, error)
+	Update(ctx context.Context, category *Category) (*Category, error)
+	Delete(ctx context.Context, id int32) error
+	CountChildren(ctx context.Context, id int32) (int, error)
+	GetByProduct(ctx context.Context, productID int32) ([]*Category, error)
+	// GetIDsByProducts возвращает разделы каждого товара из списка одним запросом
+	GetIDsByProducts(ctx context.Context, productIDs []int32) (map[int32][]int32, error)
+	SetProductCategories(ctx context.Context, productID int32, categoryIDs []int32) error
+}
+
+// CategoryChangeNotifier сообщает другим сервисам о созданном, изменённом или удалённом разделе
+type CategoryChangeNotifier interface {
+	NotifyCategoryChanged(ctx context.Context, categoryID int32)
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrImageNotFound = errors.New("product image not found")
+	ErrImageInvalid  = errors.New("invalid product images")
+)
+
+// ProductImage - изображение галереи товара; основное дублируется в products.image_url
+type ProductImage struct {
+	ID        int32     `json:"id" db:"id"`
+	ProductID int32     `json:"product_id" db:"product_id"`
+	URL       string    `json:"url" db:"url"`
+	Alt       string    `json:"alt" db:"alt"`
+	Position  int32     `json:"position" db:"position"`
+	IsPrimary bool      `json:"is_primary" db:"is_primary"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+type ProductImageRepository interface {
+	GetByProduct(ctx context.Context, productID int32) ([]*ProductImage, error)
+	GetByProducts(ctx context.Context, productIDs []int32) (map[int32][]*ProductImage, error)
+	// Add добавляет изображение в конец галереи; первое изображение товара становится основным
+	Add(ctx context.Context, image *ProductImage) (*ProductImage, error)
+	// Remove удаляет изображение; если оно было основным, основным становится следующее по порядку
+	Remove(ctx context.Context, productID, imageID int32) error
+	// Arrange сохраняет порядок, подписи и основное изображение для всей галереи
+	Arrange(ctx context.Context, productID int32, images []*ProductImage) ([]*ProductImage, error)
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrPriceChangeInvalid  = errors.New("invalid price change")
+	ErrPriceChangeNotFound = errors.New("price change not found")
+)
+
+type PriceChangeStatus string
+
+const (
+	PriceChangeScheduled PriceChangeStatus = "scheduled"
+	PriceChangeApplied   PriceChangeStatus = "applied"
+	PriceChangeCancelled PriceChangeStatus = "cancelled"
+)
+
+// PriceChange - новая цена товара, которая вступит в силу в EffectiveAt
+type PriceChange struct {
+	ID          int32             `json:"id" db:"id"`
+	ProductID   int32             `json:"product_id" db:"product_id"`
+	Price       decimal.Decimal   `json:"price" db:"price"`
+	EffectiveAt time.Time         `json:"effective_at" db:"effective_at"`
+	Status      PriceChangeStatus `json:"status" db:"status"`
+	Comment     string            `json:"comment" db:"comment"`
+	UserID      *int64            `json:"user_id" db:"user_id"`
+	CreatedAt   time.Time         `json:"created_at" db:"created_at"`
+	AppliedAt   *time.Time        `json:"applied_at" db:"applied_at"`
+}
+
+// AppliedPriceChange - применённое изменение вместе с состоянием товара до и после
+type AppliedPriceChange struct {
+	Change *PriceChange
+	Before *Product
+	After  *Product
+}
+
+// PriceHistoryEntry - цена, действовавшая с EffectiveFrom до следующей записи
+type PriceHistoryEntry struct {
+	ID            int32           `json:"id" db:"id"`
+	ProductID     int32           `json:"product_id" db:"product_id"`
+	Price         decimal.Decimal `json:"price" db:"price"`
+	EffectiveFrom time.Time       `json:"effective_from" db:"effective_from"`
+}
+
+type PriceChangeRepository interface {
+	Create(ctx context.Context, change *PriceChange) (*PriceChange, error)
+	GetByProduct(ctx context.Context, productID int32) ([]*PriceChange, error)
+	Cancel(ctx context.Context, productID, changeID int32) (*PriceChange, error)
+	// ApplyDue в одной транзакции переносит в товары все изменения, срок которых наступил к now
+	ApplyDue(ctx context.Context, now time.Time) ([]*AppliedPriceChange, error)
+	GetHistory(ctx context.Context, productID int32) ([]*PriceHistoryEntry, error)
+	// GetPriceAt возвращает цену, действовавшую в момент at; sql.ErrNoRows - товара тогда ещё не было
+	GetPriceAt(ctx context.Context, productID int32, at time.Time) (*PriceHistoryEntry, error)
+}
+
+// PriceChangeNotifier сообщает другим сервисам о вступившей в силу цене
+type PriceChangeNotifier interface {
+	NotifyPriceChanged(ctx context.Context, applied *AppliedPriceChange)
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var ErrPriceTierInvalid = errors.New("invalid price tier")
+
+// PriceTier - оптовая цена за пару при заказе от MinQuantity пар
+type PriceTier struct {
+	ID          int32           `json:"id" db:"id"`
+	ProductID   int32           `json:"product_id" db:"product_id"`
+	MinQuantity int32           `json:"min_quantity" db:"min_quantity"`
+	UnitPrice   decimal.Decimal `json:"unit_price" db:"unit_price"`
+	CreatedAt   time.Time       `json:"created_at" db:"created_at"`
+	Pricing     *Pricing        `json:"pricing,omitempty" db:"-"`
+}
+
+// PriceQuote - расчёт стоимости партии; MinQuantity == 0 означает, что применена базовая цена
+type PriceQuote struct {
+	ProductID   int32           `json:"product_id"`
+	VariantID   *int32          `json:"variant_id,omitempty"`
+	Quantity    int32           `json:"quantity"`
+	MinQuantity int32           `json:"min_quantity"`
+	UnitPrice   decimal.Decimal `json:"unit_price"`
+	Total       decimal.Decimal `json:"total"`
+	// TotalVAT - НДС в сумме партии; VATRate == 0 - без НДС
+	TotalVAT decimal.Decimal `json:"total_vat"`
+	VATRate  int32           `json:"vat_rate"`
+}
+
+// TierFor возвращает ступень с наибольшим порогом, не превышающим quantity; tiers отсортированы по возрастанию порога
+func TierFor(tiers []*PriceTier, quantity int32) *PriceTier {
+	var found *PriceTier
+	for _, tier := range tiers {
+		if tier.MinQuantity > quantity {
+			break
+		}
+		found = tier
+	}
+	return found
+}
+
+type ProductPriceTierRepository interface {
+	GetByProduct(ctx context.Context, productID int32) ([]*PriceTier, error)
+	GetByProducts(ctx context.Context, productIDs []int32) (map[int32][]*PriceTier, error)
+	// ReplaceForProduct заменяет все ступени товара переданными
+	ReplaceForProduct(ctx context.Context, productID int32, tiers []*PriceTier) ([]*PriceTier, error)
+}
@@ -3,10 +3,23 @@ package domain
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"time"
 
 	"github.com/shopspring/decimal"
 )
 
+var (
+	ErrVersionConflict = errors.New("product version conflict")
+	ErrInvalidStatus = errors.New("invalid product status")
+	ErrInvalidQuery = errors.New("invalid product query")
+	ErrProductInvalid = errors.New("invalid product")
+	// ErrProductBusy - товар ещё создаётся или уже удаляется
+	ErrProductBusy = errors.New("product is being created or deleted")
+	// ErrSagaPending - сага ещё не завершилась за отведённое время
+	ErrSagaPending = errors.New("product operation is still in progress")
+)
+
 type ProductStatus string
 
 const (
@@ -17,21 +30,133 @@ const (
 	ProductStatusCreating ProductStatus = "creating"
 )
 
+func (s ProductStatus) IsValid() bool {
+	switch s {
+	case ProductStatusActive, ProductStatusDeleting, ProductStatusDeleted, ProductStatusPending, ProductStatusCreating:
+		return true
+	}
+	return false
+}
+
+// ProductFilter ограничивает выборку товаров; пустой фильтр возвращает все товары
+type ProductFilter struct {
+	Statuses []ProductStatus
+	MinPrice *decimal.Decimal
+	MaxPrice *decimal.Decimal
+	// CategoryID отбирает товары раздела вместе со всеми его подразделами
+	CategoryID *int32
+	Attributes []AttributeFilter
+	// Published оставляет только товары, которые сейчас видны покупателям
+	Published bool
+}
+
+type ProductSort string
+
+const (
+	ProductSortID        ProductSort = "id"
+	ProductSortName      ProductSort = "name"
+	ProductSortPrice     ProductSort = "price"
+	ProductSortCreatedAt ProductSort = "created_at"
+)
+
+func (s ProductSort) IsValid() bool {
+	switch s {
+	case ProductSortID, ProductSortName, ProductSortPrice, ProductSortCreatedAt:
+		return true
+	}
+	return false
+}
+
+const (
+	DefaultProductLimit = 20
+	MaxProductLimit     = 100
+)
+
+// ProductQuery описывает фильтр, сортировку и страницу списка товаров
+type ProductQuery struct {
+	Filter ProductFilter
+	Sort   ProductSort
+	Desc   bool
+	Limit  int
+	Offset int
+}
+
+// ProductPage - страница списка товаров с общим количеством найденных записей
+type ProductPage struct {
+	Items  []*Product `json:"items"`
+	Total  int        `json:"total"`
+	Limit  int        `json:"limit"`
+	Offset int        `json:"offset"`
+	Next   string     `json:"next,omitempty"`
+	Prev   string     `json:"prev,omitempty"`
+}
+
+// ProductSearchQuery - полнотекстовый поиск с теми же фильтрами, что и у списка
+type ProductSearchQuery struct {
+	Text   string
+	Filter ProductFilter
+	Limit  int
+	Offset int
+}
+
+// Маркеры подсветки совпадений, которые возвращает ts_headline; заменяются на <mark> после экранирования
+const (
+	HighlightStart = "\ue000"
+	HighlightStop  = "\ue001"
+)
+
+type ProductSearchResult struct {
+	Product
+	Rank          float64 `json:"rank" db:"rank"`
+	NameHighlight string  `json:"name_highlight" db:"name_highlight"`
+	Snippet       string  `json:"snippet" db:"snippet"`
+}
+
+type ProductSearchPage struct {
+	Items  []*ProductSearchResult `json:"items"`
+	Total  int                    `json:"total"`
+	Limit  int                    `json:"limit"`
+	Offset int                    `json:"offset"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
 type Product struct {
 	Name        string          `json:"name" db:"name"`
 	Description string          `json:"description" db:"description"`
 	Slug        string          `json:"slug" db:"slug"`
 	Price       decimal.Decimal `json:"price" db:"price"`
+	TaxClass    TaxClass        `json:"tax_class" db:"tax_class"`
+	// Pricing - разбивка цены на НДС по режиму продавца, считается в usecase
+	Pricing     *Pricing        `json:"pricing,omitempty" db:"-"`
 	ImageURL    sql.NullString  `json:"image_url" db:"image_url"`
 	ID          int32           `json:"id" db:"id"`
 	Status ProductStatus `json:"status" db:"status"`
+	Visibility  ProductVisibility `json:"visibility" db:"visibility"`
+	PublishAt   *time.Time      `json:"publish_at" db:"publish_at"`
+	UnpublishAt *time.Time      `json:"unpublish_at" db:"unpublish_at"`
+	Version     int32           `json:"version" db:"version"`
+	CreatedAt   time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at" db:"updated_at"`
+	Variants    []*ProductVariant `json:"variants,omitempty" db:"-"`
+	Images      []*ProductImage   `json:"images,omitempty" db:"-"`
+	Stock       []*StockItem      `json:"stock,omitempty" db:"-"`
+	PriceTiers  []*PriceTier      `json:"price_tiers,omitempty" db:"-"`
+	Attributes  []*ProductAttributeValue `json:"attributes,omitempty" db:"-"`
+	// CategoryIDs - разделы товара; заполняются только в списках товаров
+	CategoryIDs []int32 `json:"category_ids,omitempty" db:"-"`
+	// Rating - средняя оценка по одобренным отзывам; nil, пока отзывов нет
+	Rating      *ProductRating  `json:"rating,omitempty" db:"-"`
 }
 
 type ProductRepository interface {
-	GetAll(ctx context.Context) ([]*Product, error)
+	GetAll(ctx context.Context, query ProductQuery) ([]*Product, error)
+	Count(ctx context.Context, filter ProductFilter) (int, error)
+	Search(ctx context.Context, query ProductSearchQuery) ([]*ProductSearchResult, int, error)
 	GetByID(ctx context.Context, id int32) (*Product, error)
-	UpdateProductImage(ctx context.Context, productID int32, imageURL string) error
 	Update(ctx context.Context, product *Product) (*Product, error)
+	// LockVersion удерживает товар до конца транзакции из ctx; ErrVersionConflict, если версия устарела
+	LockVersion(ctx context.Context, id, version int32) error
 	BeginDelete(ctx context.Context, productID int32) error
 	CompleteDelete(ctx context.Context, productID int32) error
 	RollbackDelete(ctx context.Context, productID int32) error
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type ProductChangeType string
+
+const (
+	ProductChangeCreated ProductChangeType = "created"
+	ProductChangeUpdated ProductChangeType = "updated"
+	ProductChangeDeleted ProductChangeType = "deleted"
+)
+
+// ProductChange - изменение товара для подписчиков WatchProducts. Product - состояние после изменения;
+// у удалённого товара и если товар не удалось перечитать он пустой
+type ProductChange struct {
+	Type      ProductChangeType
+	ProductID int32
+	Product   *Product
+	ChangedAt time.Time
+}
+
+// ProductChangeNotifier сообщает другим сервисам о сохранённом изменении товара, например чтобы пересобрать фиды
+type ProductChangeNotifier interface {
+	NotifyProductChanged(ctx context.Context, changeType ProductChangeType, productID int32)
+}
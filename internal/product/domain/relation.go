@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrRelationInvalid = errors.New("invalid product relation")
+
+// RelationKind - вид связи, которую задаёт администратор
+type RelationKind string
+
+const (
+	// RelationKindRelated - похожая модель
+	RelationKindRelated RelationKind = "related"
+	// RelationKindAccessory - товар, который берут вместе с этим: стельки к заднику
+	RelationKindAccessory RelationKind = "accessory"
+	// RelationKindAlternative - замена, если товара нет или не подошёл
+	RelationKindAlternative RelationKind = "alternative"
+)
+
+func (k RelationKind) IsValid() bool {
+	switch k {
+	case RelationKindRelated, RelationKindAccessory, RelationKindAlternative:
+		return true
+	}
+	return false
+}
+
+// RecommendationSource - откуда взялась рекомендация. Товары, которые заказывают вместе,
+// станут отдельным источником, когда у сервиса появится история заказов
+type RecommendationSource string
+
+const (
+	RecommendationSourceManual RecommendationSource = "manual"
+	// RecommendationSourceSimilar - товар из тех же разделов или с теми же значениями характеристик
+	RecommendationSourceSimilar RecommendationSource = "similar"
+)
+
+// ProductRelation - ручная связь товара с RelatedID; название, адрес и состояние связанного товара нужны админке
+type ProductRelation struct {
+	ProductID  int32             `json:"-" db:"product_id"`
+	RelatedID  int32             `json:"related_id" db:"related_id"`
+	Kind       RelationKind      `json:"kind" db:"kind"`
+	Position   int32             `json:"position" db:"position"`
+	Name       string            `json:"name" db:"name"`
+	Slug       string            `json:"slug" db:"slug"`
+	Status     ProductStatus     `json:"status" db:"status"`
+	Visibility ProductVisibility `json:"visibility" db:"visibility"`
+}
+
+// Recommendation - товар, который предлагается покупателю на странице другого товара
+type Recommendation struct {
+	Kind    RelationKind         `json:"kind"`
+	Source  RecommendationSource `json:"source"`
+	Product *Product             `json:"product"`
+}
+
+type ProductRelationRepository interface {
+	// GetByProduct возвращает ручные связи товара в порядке position, включая неопубликованные товары
+	GetByProduct(ctx context.Context, productID int32) ([]*ProductRelation, error)
+	// Replace заменяет все ручные связи товара
+	Replace(ctx context.Context, productID int32, relations []*ProductRelation) error
+	// GetRelatedProducts возвращает опубликованные товары из ручных связей в порядке position
+	GetRelatedProducts(ctx context.Context, productID int32) ([]*Product, error)
+	// GetSimilar подбирает опубликованные товары: сначала с общими разделами, затем с совпадающими
+	// значениями характеристик. exclude - товары, которые уже предложены
+	GetSimilar(ctx context.Context, productID int32, exclude []int32, limit int) ([]*Product, error)
+}
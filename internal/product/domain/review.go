@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrReviewNotFound = errors.New("review not found")
+	ErrReviewInvalid  = errors.New("invalid review")
+	// ErrReviewTooFrequent - отправитель уже оставлял отзыв на этот товар недавно
+	ErrReviewTooFrequent = errors.New("review was submitted too recently")
+)
+
+// ReviewStatus - состояние модерации отзыва; покупателям показываются только одобренные
+type ReviewStatus string
+
+const (
+	ReviewStatusPending  ReviewStatus = "pending"
+	ReviewStatusApproved ReviewStatus = "approved"
+	ReviewStatusRejected ReviewStatus = "rejected"
+)
+
+func (s ReviewStatus) IsValid() bool {
+	switch s {
+	case ReviewStatusPending, ReviewStatusApproved, ReviewStatusRejected:
+		return true
+	}
+	return false
+}
+
+const (
+	MinReviewRating = 1
+	MaxReviewRating = 5
+)
+
+// Review - отзыв покупателя о товаре. Reply - ответ магазина, который выводится под отзывом
+type Review struct {
+	ID          int32        `json:"id" db:"id"`
+	ProductID   int32        `json:"product_id" db:"product_id"`
+	ProductName string       `json:"product_name" db:"product_name"`
+	AuthorName  string       `json:"author_name" db:"author_name"`
+	Company     string       `json:"company" db:"company"`
+	Rating      int32        `json:"rating" db:"rating"`
+	Text        string       `json:"text" db:"text"`
+	Status      ReviewStatus `json:"status" db:"status"`
+	Reply       string       `json:"reply" db:"reply"`
+	RepliedAt   *time.Time   `json:"replied_at" db:"replied_at"`
+	ModeratedBy *int64       `json:"moderated_by" db:"moderated_by"`
+	ModeratedAt *time.Time   `json:"moderated_at" db:"moderated_at"`
+	AuthorKey   string       `json:"-" db:"author_key"`
+	CreatedAt   time.Time    `json:"created_at" db:"created_at"`
+}
+
+// ReviewModeration - решение модератора по отзыву; пустой Reply снимает ответ магазина
+type ReviewModeration struct {
+	ReviewID int32
+	Status   ReviewStatus
+	Reply    string
+	UserID   *int64
+}
+
+// ReviewQuery - выборка отзывов; пустой Status и nil ProductID не ограничивают её
+type ReviewQuery struct {
+	ProductID *int32
+	Status    ReviewStatus
+	Limit     int
+	Offset    int
+}
+
+type ReviewPage struct {
+	Items  []*Review `json:"items"`
+	Total  int       `json:"total"`
+	Limit  int       `json:"limit"`
+	Offset int       `json:"offset"`
+}
+
+// ProductRating - средняя оценка товара по одобренным отзывам
+type ProductRating struct {
+	ProductID int32   `json:"-" db:"product_id"`
+	Average   float64 `json:"average" db:"average"`
+	Count     int32   `json:"count" db:"count"`
+}
+
+type ProductReviewRepository interface {
+	Create(ctx context.Context, review *Review) (*Review, error)
+	// GetByID возвращает ErrReviewNotFound, если отзыва нет
+	GetByID(ctx context.Context, id int32) (*Review, error)
+	GetAll(ctx context.Context, query ReviewQuery) ([]*Review, int, error)
+	// CountRecent считает отзывы отправителя authorKey на товар, оставленные после since
+	CountRecent(ctx context.Context, productID int32, authorKey string, since time.Time) (int, error)
+	// Moderate меняет статус и ответ; время ответа обновляется только при изменении текста
+	Moderate(ctx context.Context, moderation *ReviewModeration) (*Review, error)
+	// GetRatings возвращает оценки товаров, у которых есть одобренные отзывы
+	GetRatings(ctx context.Context, productIDs []int32) (map[int32]*ProductRating, error)
+}
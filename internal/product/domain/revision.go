@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx/types"
+)
+
+var (
+	ErrRevisionNotFound = errors.New("product revision not found")
+	ErrRevisionNotRestorable = errors.New("product revision has no state to restore")
+)
+
+type RevisionAction string
+
+const (
+	RevisionActionCreate RevisionAction = "create"
+	RevisionActionUpdate RevisionAction = "update"
+	RevisionActionPriceChange RevisionAction = "price_change"
+	RevisionActionImageChange RevisionAction = "image_change"
+	RevisionActionVisibilityChange RevisionAction = "visibility_change"
+	RevisionActionDelete RevisionAction = "delete"
+	RevisionActionRollback RevisionAction = "rollback"
+)
+
+// ProductRevision хранит снимок товара до и после изменения
+type ProductRevision struct {
+	ID        int32          `json:"id" db:"id"`
+	ProductID int32          `json:"product_id" db:"product_id"`
+	UserID    *int64         `json:"user_id" db:"user_id"`
+	Action    RevisionAction `json:"action" db:"action"`
+	Before    types.NullJSONText `json:"before" db:"before"`
+	After     types.NullJSONText `json:"after" db:"after"`
+	CreatedAt time.Time      `json:"created_at" db:"created_at"`
+}
+
+type ProductRevisionRepository interface {
+	Create(ctx context.Context, revision *ProductRevision) error
+	GetByID(ctx context.Context, id int32) (*ProductRevision, error)
+	ListByProduct(ctx context.Context, productID int32) ([]*ProductRevision, error)
+}
+
+type userIDKey struct{}
+
+// ContextWithUserID сохраняет ID администратора, от имени которого выполняется изменение
+func ContextWithUserID(ctx context.Context, userID int64) context.Context {
+	if userID == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserIDFromContext возвращает ID администратора или nil, если изменение системное
+func UserIDFromContext(ctx context.Context) *int64 {
+	userID, ok := ctx.Value(userIDKey{}).(int64)
+	if !ok {
+		return nil
+	}
+	return &userID
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "context"
+
+// ProductSlugRepository хранит прежние адреса товаров, чтобы старые ссылки вели на текущую страницу
+type ProductSlugRepository interface {
+	// IsTaken проверяет, занят ли адрес другим товаром - текущим адресом или записью в истории;
+	// в транзакции адрес остаётся за ней до её завершения
+	IsTaken(ctx context.Context, slug string, productID int32) (bool, error)
+	// Record сохраняет прежний адрес товара; адрес, к которому товар вернулся, из истории удаляется
+	Record(ctx context.Context, productID int32, oldSlug, newSlug string) error
+	// Resolve находит товар по текущему или прежнему адресу; sql.ErrNoRows - адрес неизвестен
+	Resolve(ctx context.Context, slug string) (int32, error)
+}
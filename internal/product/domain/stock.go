@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrStockInvalid      = errors.New("invalid stock movement")
+	ErrStockInsufficient = errors.New("insufficient stock")
+	// ErrStockReservationNotFound - резерва нет или он относится к другому товару
+	ErrStockReservationNotFound = errors.New("stock reservation not found")
+	// ErrStockReservationClosed - резерв уже подтверждён, снят или истёк
+	ErrStockReservationClosed = errors.New("stock reservation is closed")
+)
+
+// StockReservationTTL - сколько держится резерв под заявку с сайта, если менеджер её не обработал
+const StockReservationTTL = 48 * time.Hour
+
+type StockMovementKind string
+
+const (
+	// StockMovementReceipt - приход с производства
+	StockMovementReceipt StockMovementKind = "receipt"
+	// StockMovementReservation - резерв под заявку
+	StockMovementReservation StockMovementKind = "reservation"
+	// StockMovementRelease - снятие резерва
+	StockMovementRelease StockMovementKind = "release"
+	// StockMovementShipment - отгрузка зарезервированного товара
+	StockMovementShipment StockMovementKind = "shipment"
+	// StockMovementAdjustment - ручная корректировка по инвентаризации, количество со знаком
+	StockMovementAdjustment StockMovementKind = "adjustment"
+)
+
+// StockMovementKinds перечисляет виды движений в порядке вывода в админке
+var StockMovementKinds = []StockMovementKind{
+	StockMovementReceipt,
+	StockMovementReservation,
+	StockMovementRelease,
+	StockMovementShipment,
+	StockMovementAdjustment,
+}
+
+type StockAvailability string
+
+const (
+	StockInStock     StockAvailability = "in_stock"
+	StockLow         StockAvailability = "low_stock"
+	StockMadeToOrder StockAvailability = "made_to_order"
+)
+
+// StockItem - остаток товара (VariantID == nil) или его варианта
+type StockItem struct {
+	ID                int32     `json:"id" db:"id"`
+	ProductID         int32     `json:"product_id" db:"product_id"`
+	VariantID         *int32    `json:"variant_id" db:"variant_id"`
+	OnHand            int32     `json:"on_hand" db:"on_hand"`
+	Reserved          int32     `json:"reserved" db:"reserved"`
+	LowStockThreshold int32     `json:"low_stock_threshold" db:"low_stock_threshold"`
+	UpdatedAt         time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Available - количество, которое можно зарезервировать
+func (s StockItem) Available() int32 {
+	return s.OnHand - s.Reserved
+}
+
+func (s StockItem) Availability() StockAvailability {
+	switch available := s.Available(); {
+	case available <= 0:
+		return StockMadeToOrder
+	case available <= s.LowStockThreshold:
+		return StockLow
+	default:
+		return StockInStock
+	}
+}
+
+// Apply возвращает остаток после движения или ошибку, если движение невозможно
+func (s StockItem) Apply(kind StockMovementKind, quantity int32) (StockItem, error) {
+	if quantity == 0 || (kind != StockMovementAdjustment && quantity < 0) {
+		return s, fmt.Errorf("%w: quantity of %s must be positive", ErrStockInvalid, kind)
+	}
+
+	switch kind {
+	case StockMovementReceipt:
+		s.OnHand += quantity
+	case StockMovementReservation:
+		if quantity > s.Available() {
+			return s, fmt.Errorf("%w: %d available, %d requested", ErrStockInsufficient, s.Available(), quantity)
+		}
+		s.Reserved += quantity
+	case StockMovementRelease:
+		if quantity > s.Reserved {
+			return s, fmt.Errorf("%w: only %d reserved", ErrStockInvalid, s.Reserved)
+		}
+		s.Reserved -= quantity
+	case StockMovementShipment:
+		if quantity > s.Reserved {
+			return s, fmt.Errorf("%w: only %d reserved, reserve before shipment", ErrStockInsufficient, s.Reserved)
+		}
+		s.Reserved -= quantity
+		s.OnHand -= quantity
+	case StockMovementAdjustment:
+		if s.OnHand+quantity < s.Reserved {
+			return s, fmt.Errorf("%w: on hand can't drop below reserved %d", ErrStockInsufficient, s.Reserved)
+		}
+		s.OnHand += quantity
+	default:
+		return s, fmt.Errorf("%w: unknown kind %q", ErrStockInvalid, kind)
+	}
+	return s, nil
+}
+
+// StockMovement - запись журнала движения остатков
+type StockMovement struct {
+	ID            int32             `json:"id" db:"id"`
+	StockItemID   int32             `json:"stock_item_id" db:"stock_item_id"`
+	ProductID     int32             `json:"product_id" db:"product_id"`
+	VariantID     *int32            `json:"variant_id" db:"variant_id"`
+	Kind          StockMovementKind `json:"kind" db:"kind"`
+	Quantity      int32             `json:"quantity" db:"quantity"`
+	OnHandAfter   int32             `json:"on_hand_after" db:"on_hand_after"`
+	ReservedAfter int32             `json:"reserved_after" db:"reserved_after"`
+	Reference     string            `json:"reference" db:"reference"`
+	Comment       string            `json:"comment" db:"comment"`
+	UserID        *int64            `json:"user_id" db:"user_id"`
+	CreatedAt     time.Time         `json:"created_at" db:"created_at"`
+}
+
+type StockReservationStatus string
+
+const (
+	// StockReservationActive - резерв держит остаток и ждёт менеджера
+	StockReservationActive StockReservationStatus = "active"
+	// StockReservationConfirmed - заявка принята, остаток остаётся в резерве до отгрузки
+	StockReservationConfirmed StockReservationStatus = "confirmed"
+	// StockReservationReleased - заявка отклонена, резерв снят
+	StockReservationReleased StockReservationStatus = "released"
+	// StockReservationExpired - заявку не обработали до expires_at, резерв снят планировщиком
+	StockReservationExpired StockReservationStatus = "expired"
+)
+
+// StockReservation - резерв остатка под одну заявку
+type StockReservation struct {
+	ID          int32                  `json:"id" db:"id"`
+	StockItemID int32                  `json:"stock_item_id" db:"stock_item_id"`
+	ProductID   int32                  `json:"product_id" db:"product_id"`
+	VariantID   *int32                 `json:"variant_id" db:"variant_id"`
+	Quantity    int32                  `json:"quantity" db:"quantity"`
+	Reference   string                 `json:"reference" db:"reference"`
+	Status      StockReservationStatus `json:"status" db:"status"`
+	ExpiresAt   time.Time              `json:"expires_at" db:"expires_at"`
+	CreatedAt   time.Time              `json:"created_at" db:"created_at"`
+	ClosedAt    *time.Time             `json:"closed_at" db:"closed_at"`
+	UserID      *int64                 `json:"user_id" db:"user_id"`
+}
+
+// ReleaseQuantity - сколько вернуть из резерва при снятии. Отгрузку могли провести вручную до снятия,
+// поэтому больше, чем зарезервировано сейчас, не снимаем
+func (r StockReservation) ReleaseQuantity(item StockItem) int32 {
+	if r.Quantity > item.Reserved {
+		return item.Reserved
+	}
+	return r.Quantity
+}
+
+type StockRepository interface {
+	GetByProduct(ctx context.Context, productID int32) ([]*StockItem, error)
+	GetByProducts(ctx context.Context, productIDs []int32) (map[int32][]*StockItem, error)
+	// Move применяет движение под блокировкой строки остатка и пишет его в журнал; возвращает остаток до и после
+	Move(ctx context.Context, movement *StockMovement) (before, after *StockItem, err error)
+	SetThreshold(ctx context.Context, productID int32, variantID *int32, threshold int32) (*StockItem, error)
+	GetMovements(ctx context.Context, productID int32, limit int) ([]*StockMovement, error)
+	// Reserve резервирует остаток движением reservation и заводит резерв; возвращает остаток до и после
+	Reserve(ctx context.Context, reservation *StockReservation) (before, after *StockItem, err error)
+	// GetReservations возвращает действующие резервы товара
+	GetReservations(ctx context.Context, productID int32) ([]*StockReservation, error)
+	// CloseReservation переводит действующий резерв в status; при released остаток возвращается движением release
+	CloseReservation(ctx context.Context, productID, reservationID int32, status StockReservationStatus, userID *int64) (*StockReservation, error)
+	// ExpireReservations снимает резервы с истёкшим expires_at и возвращает их
+	ExpireReservations(ctx context.Context, now time.Time) ([]*StockReservation, error)
+}
+
+// StockNotifier сообщает администраторам о падении остатка до порога; sku пустой для остатка самого товара
+type StockNotifier interface {
+	NotifyLowStock(ctx context.Context, product *Product, sku string, item *StockItem)
+}
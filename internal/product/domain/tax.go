@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+var ErrTaxClassInvalid = errors.New("invalid tax class")
+
+// TaxClass - ставка НДС товара
+type TaxClass string
+
+const (
+	TaxClassStandard TaxClass = "standard"
+	TaxClassReduced  TaxClass = "reduced"
+	TaxClassExempt   TaxClass = "exempt"
+)
+
+func (c TaxClass) IsValid() bool {
+	switch c {
+	case TaxClassStandard, TaxClassReduced, TaxClassExempt:
+		return true
+	}
+	return false
+}
+
+// TaxMode - режим налогообложения продавца: плательщик НДС или «без НДС» (УСН)
+type TaxMode string
+
+const (
+	TaxModeVAT  TaxMode = "vat"
+	TaxModeNone TaxMode = "none"
+)
+
+func ParseTaxMode(value string) (TaxMode, error) {
+	switch mode := TaxMode(value); mode {
+	case TaxModeVAT, TaxModeNone:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unknown seller tax mode %q", value)
+}
+
+// VATRate возвращает ставку НДС в процентах; 0 - товар продаётся без НДС
+func (m TaxMode) VATRate(class TaxClass) int32 {
+	if m != TaxModeVAT {
+		return 0
+	}
+	switch class {
+	case TaxClassReduced:
+		return 10
+	case TaxClassExempt:
+		return 0
+	default:
+		return 20
+	}
+}
+
+// Pricing - цена с выделенным НДС. Цены каталога хранятся с НДС, поэтому Gross совпадает с ценой товара
+type Pricing struct {
+	Gross   decimal.Decimal `json:"gross"`
+	Net     decimal.Decimal `json:"net"`
+	VAT     decimal.Decimal `json:"vat"`
+	VATRate int32           `json:"vat_rate"`
+}
+
+// Price выделяет НДС из цены: net = gross * 100 / (100 + ставка), округление до копеек
+func (m TaxMode) Price(gross decimal.Decimal, class TaxClass) Pricing {
+	rate := m.VATRate(class)
+	pricing := Pricing{Gross: gross, Net: gross, VAT: decimal.Zero, VATRate: rate}
+	if rate == 0 {
+		return pricing
+	}
+
+	hundred := decimal.NewFromInt(100)
+	pricing.Net = gross.Mul(hundred).Div(hundred.Add(decimal.NewFromInt32(rate))).Round(2)
+	pricing.VAT = gross.Sub(pricing.Net)
+	return pricing
+}
@@ -0,0 +1,9 @@
+package domain
+
+import "context"
+
+// Transactor выполняет fn в одной транзакции БД: репозитории, вызванные с ctx из fn, пишут в неё.
+// Ошибка fn откатывает всё, что успели записать
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrVariantInvalid  = errors.New("invalid product variant")
+	ErrVariantSKUTaken = errors.New("variant sku is already taken")
+)
+
+// VariantOptions - значения опций варианта, например {"толщина": "1.5 мм", "размер": "38"}
+type VariantOptions map[string]string
+
+func (o VariantOptions) Value() (driver.Value, error) {
+	if o == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (o *VariantOptions) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	case nil:
+		*o = VariantOptions{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported variant options type %T", src)
+	}
+	return json.Unmarshal(data, o)
+}
+
+// Label собирает опции в строку вида "размер: 38, толщина: 1.5 мм" с ключами по алфавиту
+func (o VariantOptions) Label() string {
+	keys := make([]string, 0, len(o))
+	for key := range o {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = key + ": " + o[key]
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ProductVariant - вариант товара со своим артикулом; Price == nil означает цену товара
+type ProductVariant struct {
+	ID          int32               `json:"id" db:"id"`
+	ProductID   int32               `json:"product_id" db:"product_id"`
+	SKU         string              `json:"sku" db:"sku"`
+	Options     VariantOptions      `json:"options" db:"options"`
+	Price       decimal.NullDecimal `json:"price" db:"price"`
+	WeightGrams *int32              `json:"weight_grams" db:"weight_grams"`
+	IsActive    bool                `json:"is_active" db:"is_active"`
+	Position    int32               `json:"position" db:"position"`
+	CreatedAt   time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at" db:"updated_at"`
+	Pricing     *Pricing            `json:"pricing,omitempty" db:"-"`
+}
+
+// EffectivePrice возвращает цену варианта или базовую цену товара
+func (v *ProductVariant) EffectivePrice(base decimal.Decimal) decimal.Decimal {
+	if v.Price.Valid {
+		return v.Price.Decimal
+	}
+	return base
+}
+
+type ProductVariantRepository interface {
+	GetByProduct(ctx context.Context, productID int32) ([]*ProductVariant, error)
+	GetByProducts(ctx context.Context, productIDs []int32) (map[int32][]*ProductVariant, error)
+	// ReplaceForProduct приводит набор вариантов товара к переданному: обновляет по ID, добавляет новые, удаляет остальные
+	ReplaceForProduct(ctx context.Context, productID int32, variants []*ProductVariant) ([]*ProductVariant, error)
+}
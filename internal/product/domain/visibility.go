@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrVisibilityInvalid = errors.New("invalid product visibility")
+
+// ProductVisibility - редакционное состояние товара на витрине. В отличие от ProductStatus
+// его меняет администратор или планировщик, а не саги создания и удаления
+type ProductVisibility string
+
+const (
+	ProductVisibilityDraft     ProductVisibility = "draft"
+	ProductVisibilityScheduled ProductVisibility = "scheduled"
+	ProductVisibilityPublished ProductVisibility = "published"
+	ProductVisibilityHidden    ProductVisibility = "hidden"
+)
+
+func (v ProductVisibility) IsValid() bool {
+	switch v {
+	case ProductVisibilityDraft, ProductVisibilityScheduled, ProductVisibilityPublished, ProductVisibilityHidden:
+		return true
+	}
+	return false
+}
+
+// IsPublished сообщает, виден ли товар покупателям в момент now. Условие повторяет
+// фильтр ProductFilter.Published, поэтому не зависит от того, успел ли отработать планировщик
+func (p *Product) IsPublished(now time.Time) bool {
+	if p.Status != ProductStatusActive {
+		return false
+	}
+	if p.UnpublishAt != nil && !p.UnpublishAt.After(now) {
+		return false
+	}
+	switch p.Visibility {
+	case ProductVisibilityPublished:
+		return true
+	case ProductVisibilityScheduled:
+		return p.PublishAt != nil && !p.PublishAt.After(now)
+	}
+	return false
+}
+
+// VisibilityChange - новое состояние витрины товара; Version защищает от параллельной правки
+type VisibilityChange struct {
+	ProductID   int32
+	Visibility  ProductVisibility
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
+	Version     int32
+}
+
+// AppliedVisibilityChange - переход по расписанию вместе с состоянием товара до и после
+type AppliedVisibilityChange struct {
+	Before *Product
+	After  *Product
+}
+
+type ProductVisibilityRepository interface {
+	// Set меняет видимость и увеличивает версию; устаревшая версия - ErrVersionConflict
+	Set(ctx context.Context, change *VisibilityChange) (*Product, error)
+	// ApplyDue в одной транзакции публикует товары, чей publish_at наступил к now,
+	// и скрывает те, у которых наступил unpublish_at
+	ApplyDue(ctx context.Context, now time.Time) ([]*AppliedVisibilityChange, error)
+}
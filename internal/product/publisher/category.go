@@ -0,0 +1,32 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/Nzyazin/zadnik.store/internal/broker"
+	"github.com/Nzyazin/zadnik.store/internal/common"
+)
+
+// CategoryPublisher отправляет события об изменении разделов в брокер
+type CategoryPublisher struct {
+	messageBroker broker.MessageBroker
+	logger        common.Logger
+}
+
+func NewCategoryPublisher(messageBroker broker.MessageBroker, logger common.Logger) *CategoryPublisher {
+	return &CategoryPublisher{
+		messageBroker: messageBroker,
+		logger:        logger,
+	}
+}
+
+// NotifyCategoryChanged не возвращает ошибку: раздел уже сохранён, потерянное событие только логируем
+func (p *CategoryPublisher) NotifyCategoryChanged(ctx context.Context, categoryID int32) {
+	event := &broker.CategoryEvent{
+		EventType:  broker.EventTypeCategoryChanged,
+		CategoryID: categoryID,
+	}
+	if err := p.messageBroker.PublishCategory(ctx, event); err != nil {
+		p.logger.Errorf("Failed to publish changed event for category %d: %v", categoryID, err)
+	}
+}
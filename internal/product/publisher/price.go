@@ -0,0 +1,43 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/Nzyazin/zadnik.store/internal/broker"
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+// PricePublisher отправляет события о вступивших в силу ценах в брокер
+type PricePublisher struct {
+	messageBroker broker.MessageBroker
+	logger        common.Logger
+}
+
+func NewPricePublisher(messageBroker broker.MessageBroker, logger common.Logger) *PricePublisher {
+	return &PricePublisher{
+		messageBroker: messageBroker,
+		logger:        logger,
+	}
+}
+
+// NotifyPriceChanged не возвращает ошибку: цена уже сохранена, потерянное событие только логируем
+func (p *PricePublisher) NotifyPriceChanged(ctx context.Context, applied *domain.AppliedPriceChange) {
+	event := &broker.PriceChangedEvent{
+		EventType:     broker.EventTypeProductPriceChanged,
+		ProductID:     applied.Change.ProductID,
+		PriceChangeID: applied.Change.ID,
+		OldPrice:      applied.Before.Price,
+		NewPrice:      applied.After.Price,
+		EffectiveAt:   applied.Change.EffectiveAt,
+	}
+	if applied.Change.AppliedAt != nil {
+		event.AppliedAt = *applied.Change.AppliedAt
+	}
+
+	if err := p.messageBroker.PublishPriceChanged(ctx, event); err != nil {
+		p.logger.Errorf("Failed to publish price changed event for product %d: %v", applied.Change.ProductID, err)
+		return
+	}
+	p.logger.Infof("Published price changed event for product %d", applied.Change.ProductID)
+}
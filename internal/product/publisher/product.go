@@ -0,0 +1,113 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/broker"
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+// deleteSagaTimeout - сколько HTTP-запрос ждёт удаления изображений сервисом изображений
+const deleteSagaTimeout = 9 * time.Second
+
+// ProductPublisher отправляет события саг создания и удаления товара для запросов, пришедших по HTTP,
+// и события о любом сохранённом изменении товара
+type ProductPublisher struct {
+	messageBroker broker.MessageBroker
+	logger        common.Logger
+}
+
+func NewProductPublisher(messageBroker broker.MessageBroker, logger common.Logger) *ProductPublisher {
+	return &ProductPublisher{
+		messageBroker: messageBroker,
+		logger:        logger,
+	}
+}
+
+// NotifyCreated публикует то же событие, что и сага создания; товар уже сохранён, поэтому ошибка только логируется
+func (p *ProductPublisher) NotifyCreated(ctx context.Context, productID int32) {
+	event := &broker.ProductEvent{
+		EventType: broker.EventTypeProductCreatingCompleted,
+		ProductID: productID,
+	}
+	if err := p.messageBroker.PublishProduct(ctx, broker.ProductImageCreatingCompletedExchange, event); err != nil {
+		p.logger.Errorf("Failed to publish create completed event for product %d: %v", productID, err)
+	}
+}
+
+// NotifyDeleted публикует то же событие, что и сага удаления
+func (p *ProductPublisher) NotifyDeleted(ctx context.Context, productID int32) {
+	event := &broker.ProductEvent{
+		EventType: broker.EventTypeProductDeletingCompleted,
+		ProductID: productID,
+	}
+	if err := p.messageBroker.PublishProduct(ctx, broker.ProductImageDeletingCompletedExchange, event); err != nil {
+		p.logger.Errorf("Failed to publish delete completed event for product %d: %v", productID, err)
+	}
+}
+
+// NotifyProductChanged публикует product.changed уже после записи: по нему витрина пересобирает фиды и карту сайта
+func (p *ProductPublisher) NotifyProductChanged(ctx context.Context, changeType domain.ProductChangeType, productID int32) {
+	event := &broker.ProductEvent{
+		EventType: broker.EventTypeProductChanged,
+		ProductID: productID,
+		Change:    string(changeType),
+	}
+	if err := p.messageBroker.PublishProduct(ctx, broker.ProductExchange, event); err != nil {
+		p.logger.Errorf("Failed to publish changed event for product %d: %v", productID, err)
+	}
+}
+
+// DeleteWithImages запускает сагу удаления, как админка: сервис изображений удаляет файлы,
+// подписчик сервиса товаров - сам товар. Ждёт события о завершении не дольше deleteSagaTimeout;
+// domain.ErrSagaPending - сага не успела завершиться или откатилась
+func (p *ProductPublisher) DeleteWithImages(ctx context.Context, product *domain.Product) error {
+	imageURLs := make([]string, 0, len(product.Images))
+	for _, image := range product.Images {
+		imageURLs = append(imageURLs, image.URL)
+	}
+
+	done := make(chan struct{}, 1)
+	cleanup := make(chan struct{})
+	defer close(cleanup)
+	if err := p.messageBroker.SubscribeToProductDelete(ctx, broker.ProductImageDeletingCompletedExchange, broker.EventTypeProductDeletingCompleted, func(event *broker.ProductEvent) error {
+		select {
+		case <-cleanup:
+		default:
+			if event.ProductID == product.ID {
+				select {
+				case done <- struct{}{}:
+				default:
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to subscribe to delete completed events: %w", err)
+	}
+
+	event := &broker.ProductEvent{
+		EventType: broker.EventTypeProductDeleted,
+		ProductID: product.ID,
+		ImageURL:  product.ImageURL.String,
+		ImageURLs: imageURLs,
+	}
+	if userID := domain.UserIDFromContext(ctx); userID != nil {
+		event.UserID = *userID
+	}
+	if err := p.messageBroker.PublishProduct(ctx, broker.ProductImageDeletingExchange, event); err != nil {
+		return fmt.Errorf("failed to publish delete event: %w", err)
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(deleteSagaTimeout):
+		return domain.ErrSagaPending
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,43 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/Nzyazin/zadnik.store/internal/broker"
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+// StockPublisher отправляет уведомления об остатках в брокер
+type StockPublisher struct {
+	messageBroker broker.MessageBroker
+	logger        common.Logger
+}
+
+func NewStockPublisher(messageBroker broker.MessageBroker, logger common.Logger) *StockPublisher {
+	return &StockPublisher{
+		messageBroker: messageBroker,
+		logger:        logger,
+	}
+}
+
+// NotifyLowStock не возвращает ошибку: движение уже проведено, потерянное уведомление только логируем
+func (p *StockPublisher) NotifyLowStock(ctx context.Context, product *domain.Product, sku string, item *domain.StockItem) {
+	event := &broker.StockEvent{
+		EventType: broker.EventTypeStockLow,
+		ProductID: product.ID,
+		VariantID: item.VariantID,
+		Name:      product.Name,
+		SKU:       sku,
+		OnHand:    item.OnHand,
+		Reserved:  item.Reserved,
+		Available: item.Available(),
+		Threshold: item.LowStockThreshold,
+	}
+
+	if err := p.messageBroker.PublishStock(ctx, event); err != nil {
+		p.logger.Errorf("Failed to publish low stock event for product %d: %v", product.ID, err)
+		return
+	}
+	p.logger.Infof("Published low stock event for product %d", product.ID)
+}
@@ -0,0 +1,226 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const attributeColumns = `a.id, a.code, a.name, a.type, a.unit, a.options, a.position, a.created_at, a.updated_at,
+	ARRAY(SELECT ac.category_id FROM attribute_categories ac WHERE ac.attribute_id = a.id ORDER BY ac.category_id) AS category_ids`
+
+const attributeValueColumns = `v.product_id, v.attribute_id, a.code, a.name, a.type, a.unit, v.value_number,
+	COALESCE(v.value_text, '') AS value_text`
+
+type attributeRepository struct {
+	db *sqlx.DB
+}
+
+func NewAttributeRepository(db *sqlx.DB) domain.AttributeRepository {
+	return &attributeRepository{db: db}
+}
+
+func (r *attributeRepository) GetAll(ctx context.Context) ([]*domain.Attribute, error) {
+	attributes := []*domain.Attribute{}
+	query := `SELECT ` + attributeColumns + ` FROM attributes a ORDER BY a.position, a.name`
+	if err := r.db.SelectContext(ctx, &attributes, query); err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+	return attributes, nil
+}
+
+func (r *attributeRepository) GetByID(ctx context.Context, id int32) (*domain.Attribute, error) {
+	return r.getOne(ctx, r.db, id)
+}
+
+func (r *attributeRepository) getOne(ctx context.Context, q sqlx.QueryerContext, id int32) (*domain.Attribute, error) {
+	attribute := &domain.Attribute{}
+	err := sqlx.GetContext(ctx, q, attribute, `SELECT `+attributeColumns+` FROM attributes a WHERE a.id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrAttributeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attribute: %w", err)
+	}
+	return attribute, nil
+}
+
+func (r *attributeRepository) Create(ctx context.Context, attribute *domain.Attribute) (*domain.Attribute, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id int32
+	err = tx.GetContext(ctx, &id, `
+		INSERT INTO attributes (code, name, type, unit, options, position)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id`,
+		attribute.Code,
+		attribute.Name,
+		attribute.Type,
+		attribute.Unit,
+		attribute.Options,
+		attribute.Position,
+	)
+	if isUniqueViolation(err) {
+		return nil, domain.ErrAttributeCodeTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attribute: %w", err)
+	}
+
+	return r.saveCategories(ctx, tx, id, attribute.CategoryIDs)
+}
+
+func (r *attributeRepository) Update(ctx context.Context, attribute *domain.Attribute) (*domain.Attribute, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE attributes
+		SET code = $1, name = $2, type = $3, unit = $4, options = $5, position = $6, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $7`,
+		attribute.Code,
+		attribute.Name,
+		attribute.Type,
+		attribute.Unit,
+		attribute.Options,
+		attribute.Position,
+		attribute.ID,
+	)
+	if isUniqueViolation(err) {
+		return nil, domain.ErrAttributeCodeTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update attribute: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return nil, domain.ErrAttributeNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM attribute_categories WHERE attribute_id = $1`, attribute.ID); err != nil {
+		return nil, fmt.Errorf("failed to clear attribute categories: %w", err)
+	}
+	return r.saveCategories(ctx, tx, attribute.ID, attribute.CategoryIDs)
+}
+
+// saveCategories записывает разделы характеристики и завершает транзакцию
+func (r *attributeRepository) saveCategories(ctx context.Context, tx *sqlx.Tx, id int32, categoryIDs []int32) (*domain.Attribute, error) {
+	if len(categoryIDs) > 0 {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO attribute_categories (attribute_id, category_id)
+			SELECT $1, unnest($2::int[])
+			ON CONFLICT DO NOTHING`,
+			id, pq.Array(categoryIDs))
+		if err != nil {
+			return nil, fmt.Errorf("failed to set attribute categories: %w", err)
+		}
+	}
+
+	saved, err := r.getOne(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit attribute: %w", err)
+	}
+	return saved, nil
+}
+
+func (r *attributeRepository) Delete(ctx context.Context, id int32) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM attributes WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete attribute: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrAttributeNotFound
+	}
+	return nil
+}
+
+func (r *attributeRepository) GetValues(ctx context.Context, productID int32) ([]*domain.ProductAttributeValue, error) {
+	values := []*domain.ProductAttributeValue{}
+	query := `
+		SELECT ` + attributeValueColumns + `
+		FROM product_attribute_values v
+		JOIN attributes a ON a.id = v.attribute_id
+		WHERE v.product_id = $1
+		ORDER BY a.position, a.name`
+	if err := r.db.SelectContext(ctx, &values, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to get attribute values: %w", err)
+	}
+	return values, nil
+}
+
+func (r *attributeRepository) GetValuesByProducts(ctx context.Context, productIDs []int32) (map[int32][]*domain.ProductAttributeValue, error) {
+	result := make(map[int32][]*domain.ProductAttributeValue, len(productIDs))
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	values := []*domain.ProductAttributeValue{}
+	query := `
+		SELECT ` + attributeValueColumns + `
+		FROM product_attribute_values v
+		JOIN attributes a ON a.id = v.attribute_id
+		WHERE v.product_id = ANY($1)
+		ORDER BY v.product_id, a.position, a.name`
+	if err := r.db.SelectContext(ctx, &values, query, pq.Array(productIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get attribute values: %w", err)
+	}
+	for _, value := range values {
+		result[value.ProductID] = append(result[value.ProductID], value)
+	}
+	return result, nil
+}
+
+func (r *attributeRepository) ReplaceValues(ctx context.Context, productID int32, values []*domain.ProductAttributeValue) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_attribute_values WHERE product_id = $1`, productID); err != nil {
+		return fmt.Errorf("failed to clear attribute values: %w", err)
+	}
+
+	for _, value := range values {
+		var text sql.NullString
+		if !value.Number.Valid {
+			text = sql.NullString{String: value.Text, Valid: true}
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO product_attribute_values (product_id, attribute_id, value_number, value_text)
+			VALUES ($1, $2, $3, $4)`,
+			productID, value.AttributeID, value.Number, text)
+		if err != nil {
+			return fmt.Errorf("failed to save attribute value: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit attribute values: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const categoryColumns = `id, parent_id, name, slug, description, seo_title, seo_description, position, created_at, updated_at`
+
+// uniqueViolation - код ошибки Postgres при нарушении уникального индекса
+const uniqueViolation = "23505"
+
+type categoryRepository struct {
+	db *sqlx.DB
+}
+
+func NewCategoryRepository(db *sqlx.DB) domain.CategoryRepository {
+	return &categoryRepository{db: db}
+}
+
+func (r *categoryRepository) GetAll(ctx context.Context) ([]*domain.Category, error) {
+	categories := []*domain.Category{}
+	query := `SELECT ` + categoryColumns + ` FROM categories ORDER BY position, name`
+	if err := r.db.SelectContext(ctx, &categories, query); err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	return categories, nil
+}
+
+func (r *categoryRepository) GetByID(ctx context.Context, id int32) (*domain.Category, error) {
+	return r.getOne(ctx, `SELECT `+categoryColumns+` FROM categories WHERE id = $1`, id)
+}
+
+func (r *categoryRepository) GetBySlug(ctx context.Context, slug string) (*domain.Category, error) {
+	return r.getOne(ctx, `SELECT `+categoryColumns+` FROM categories WHERE slug = $1`, slug)
+}
+
+func (r *categoryRepository) getOne(ctx context.Context, query string, arg interface{}) (*domain.Category, error) {
+	category := &domain.Category{}
+	err := r.db.GetContext(ctx, category, query, arg)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	return category, nil
+}
+
+func (r *categoryRepository) Create(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	query := `
+		INSERT INTO categories (parent_id, name, slug, description, seo_title, seo_description, position)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + categoryColumns
+
+	created := &domain.Category{}
+	err := r.db.GetContext(ctx, created, query,
+		category.ParentID,
+		category.Name,
+		category.Slug,
+		category.Description,
+		category.SEOTitle,
+		category.SEODescription,
+		category.Position,
+	)
+	if isUniqueViolation(err) {
+		return nil, domain.ErrCategorySlugTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+	return created, nil
+}
+
+func (r *categoryRepository) Update(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	query := `
+		UPDATE categories
+		SET parent_id = $1, name = $2, slug = $3, description = $4, seo_title = $5,
+			seo_description = $6, position = $7, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $8
+		RETURNING ` + categoryColumns
+
+	updated := &domain.Category{}
+	err := r.db.GetContext(ctx, updated, query,
+		category.ParentID,
+		category.Name,
+		category.Slug,
+		category.Description,
+		category.SEOTitle,
+		category.SEODescription,
+		category.Position,
+		category.ID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrCategoryNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, domain.ErrCategorySlugTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+	return updated, nil
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, id int32) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM categories WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (r *categoryRepository) CountChildren(ctx context.Context, id int32) (int, error) {
+	var count int
+	err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM categories WHERE parent_id = $1`, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count subcategories: %w", err)
+	}
+	return count, nil
+}
+
+func (r *categoryRepository) GetByProduct(ctx context.Context, productID int32) ([]*domain.Category, error) {
+	categories := []*domain.Category{}
+	query := `
+		SELECT c.id, c.parent_id, c.name, c.slug, c.description, c.seo_title,
+			c.seo_description, c.position, c.created_at, c.updated_at
+		FROM categories c
+		JOIN product_categories pc ON pc.category_id = c.id
+		WHERE pc.product_id = $1
+		ORDER BY c.position, c.name`
+	if err := r.db.SelectContext(ctx, &categories, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product categories: %w", err)
+	}
+	return categories, nil
+}
+
+func (r *categoryRepository) GetIDsByProducts(ctx context.Context, productIDs []int32) (map[int32][]int32, error) {
+	result := make(map[int32][]int32, len(productIDs))
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		ProductID  int32 `db:"product_id"`
+		CategoryID int32 `db:"category_id"`
+	}
+	query := `SELECT product_id, category_id FROM product_categories WHERE product_id = ANY($1) ORDER BY product_id, category_id`
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, pq.Array(productIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get categories of products: %w", err)
+	}
+
+	for _, row := range rows {
+		result[row.ProductID] = append(result[row.ProductID], row.CategoryID)
+	}
+	return result, nil
+}
+
+func (r *categoryRepository) SetProductCategories(ctx context.Context, productID int32, categoryIDs []int32) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_categories WHERE product_id = $1`, productID); err != nil {
+		return fmt.Errorf("failed to clear product categories: %w", err)
+	}
+
+	if len(categoryIDs) > 0 {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO product_categories (product_id, category_id)
+			SELECT $1, unnest($2::int[])
+			ON CONFLICT DO NOTHING`,
+			productID, pq.Array(categoryIDs))
+		if err != nil {
+			return fmt.Errorf("failed to set product categories: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product categories: %w", err)
+	}
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const imageColumns = `id, product_id, url, alt, position, is_primary, created_at`
+
+type imageRepository struct {
+	db *sqlx.DB
+}
+
+func NewImageRepository(db *sqlx.DB) domain.ProductImageRepository {
+	return &imageRepository{db: db}
+}
+
+func (r *imageRepository) GetByProduct(ctx context.Context, productID int32) ([]*domain.ProductImage, error) {
+	images := []*domain.ProductImage{}
+	query := `SELECT ` + imageColumns + ` FROM product_images WHERE product_id = $1 ORDER BY position, id`
+	if err := conn(ctx, r.db).SelectContext(ctx, &images, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product images: %w", err)
+	}
+	return images, nil
+}
+
+func (r *imageRepository) GetByProducts(ctx context.Context, productIDs []int32) (map[int32][]*domain.ProductImage, error) {
+	result := make(map[int32][]*domain.ProductImage, len(productIDs))
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	images := []*domain.ProductImage{}
+	query := `SELECT ` + imageColumns + ` FROM product_images WHERE product_id = ANY($1) ORDER BY product_id, position, id`
+	if err := conn(ctx, r.db).SelectContext(ctx, &images, query, pq.Array(productIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get product images: %w", err)
+	}
+
+	for _, image := range images {
+		result[image.ProductID] = append(result[image.ProductID], image)
+	}
+	return result, nil
+}
+
+func (r *imageRepository) Add(ctx context.Context, image *domain.ProductImage) (*domain.ProductImage, error) {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// блокируем товар, чтобы параллельные загрузки не получили одинаковую позицию
+	if _, err := tx.ExecContext(ctx, `SELECT id FROM products WHERE id = $1 FOR UPDATE`, image.ProductID); err != nil {
+		return nil, fmt.Errorf("failed to lock product: %w", err)
+	}
+
+	added := &domain.ProductImage{}
+	err = tx.GetContext(ctx, added, `
+		INSERT INTO product_images (product_id, url, alt, position, is_primary)
+		SELECT $1, $2, $3,
+			COALESCE(MAX(position) + 1, 0),
+			NOT EXISTS (SELECT 1 FROM product_images WHERE product_id = $1 AND is_primary)
+		FROM product_images WHERE product_id = $1
+		RETURNING `+imageColumns,
+		image.ProductID, image.URL, image.Alt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add product image: %w", err)
+	}
+
+	if err := syncPrimaryImage(ctx, tx.Tx, image.ProductID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit product image: %w", err)
+	}
+	return added, nil
+}
+
+func (r *imageRepository) Remove(ctx context.Context, productID, imageID int32) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM product_images WHERE id = $1 AND product_id = $2`, imageID, productID)
+	if err != nil {
+		return fmt.Errorf("failed to remove product image: %w", err)
+	}
+	if rows, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	} else if rows == 0 {
+		return domain.ErrImageNotFound
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE product_images SET is_primary = TRUE
+		WHERE id = (SELECT id FROM product_images WHERE product_id = $1 ORDER BY position, id LIMIT 1)
+			AND NOT EXISTS (SELECT 1 FROM product_images WHERE product_id = $1 AND is_primary)`,
+		productID)
+	if err != nil {
+		return fmt.Errorf("failed to promote primary image: %w", err)
+	}
+
+	if err := syncPrimaryImage(ctx, tx.Tx, productID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product image removal: %w", err)
+	}
+	return nil
+}
+
+func (r *imageRepository) Arrange(ctx context.Context, productID int32, images []*domain.ProductImage) ([]*domain.ProductImage, error) {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// сначала снимаем флаг, иначе частичный уникальный индекс не даст переназначить основное изображение
+	if _, err := tx.ExecContext(ctx, `UPDATE product_images SET is_primary = FALSE WHERE product_id = $1`, productID); err != nil {
+		return nil, fmt.Errorf("failed to reset primary image: %w", err)
+	}
+
+	for _, image := range images {
+		result, err := tx.ExecContext(ctx, `
+			UPDATE product_images SET alt = $1, position = $2, is_primary = $3
+			WHERE id = $4 AND product_id = $5`,
+			image.Alt, image.Position, image.IsPrimary, image.ID, productID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update product image %d: %w", image.ID, err)
+		}
+		if rows, err := result.RowsAffected(); err != nil {
+			return nil, fmt.Errorf("failed to get affected rows: %w", err)
+		} else if rows == 0 {
+			return nil, fmt.Errorf("%w: %d", domain.ErrImageNotFound, image.ID)
+		}
+	}
+
+	if err := syncPrimaryImage(ctx, tx.Tx, productID); err != nil {
+		return nil, err
+	}
+
+	arranged := []*domain.ProductImage{}
+	query := `SELECT ` + imageColumns + ` FROM product_images WHERE product_id = $1 ORDER BY position, id`
+	if err := tx.SelectContext(ctx, &arranged, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product images: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit product images: %w", err)
+	}
+	return arranged, nil
+}
+
+// syncPrimaryImage переносит ссылку на основное изображение в products.image_url, если она изменилась
+func syncPrimaryImage(ctx context.Context, tx *sqlx.Tx, productID int32) error {
+	_, err := tx.ExecContext(ctx, `
+		WITH primary_image AS (
+			SELECT url FROM product_images WHERE product_id = $1 AND is_primary
+		)
+		UPDATE products
+		SET image_url = (SELECT url FROM primary_image), version = version + 1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND image_url IS DISTINCT FROM (SELECT url FROM primary_image)`,
+		productID)
+	if err != nil {
+		return fmt.Errorf("failed to sync primary image: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const (
+	priceChangeColumns  = `id, product_id, price, effective_at, status, comment, user_id, created_at, applied_at`
+	priceHistoryColumns = `id, product_id, price, effective_from`
+	// applyDueBatch ограничивает число изменений, применяемых за одну транзакцию
+	applyDueBatch = 100
+)
+
+type priceChangeRepository struct {
+	db *sqlx.DB
+}
+
+func NewPriceChangeRepository(db *sqlx.DB) domain.PriceChangeRepository {
+	return &priceChangeRepository{db: db}
+}
+
+func (r *priceChangeRepository) Create(ctx context.Context, change *domain.PriceChange) (*domain.PriceChange, error) {
+	created := &domain.PriceChange{}
+	err := conn(ctx, r.db).GetContext(ctx, created, `
+		INSERT INTO price_changes (product_id, price, effective_at, comment, user_id)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING `+priceChangeColumns,
+		change.ProductID, change.Price, change.EffectiveAt, change.Comment, change.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create price change: %w", err)
+	}
+	return created, nil
+}
+
+func (r *priceChangeRepository) GetByProduct(ctx context.Context, productID int32) ([]*domain.PriceChange, error) {
+	changes := []*domain.PriceChange{}
+	query := `SELECT ` + priceChangeColumns + ` FROM price_changes WHERE product_id = $1 ORDER BY effective_at DESC, id DESC`
+	if err := conn(ctx, r.db).SelectContext(ctx, &changes, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to get price changes: %w", err)
+	}
+	return changes, nil
+}
+
+func (r *priceChangeRepository) Cancel(ctx context.Context, productID, changeID int32) (*domain.PriceChange, error) {
+	cancelled := &domain.PriceChange{}
+	err := conn(ctx, r.db).GetContext(ctx, cancelled, `
+		UPDATE price_changes SET status = $1
+		WHERE id = $2 AND product_id = $3 AND status = $4
+		RETURNING `+priceChangeColumns,
+		domain.PriceChangeCancelled, changeID, productID, domain.PriceChangeScheduled)
+	if errors.Is(err, sql.ErrNoRows) {
+		var status domain.PriceChangeStatus
+		err := conn(ctx, r.db).GetContext(ctx, &status, `SELECT status FROM price_changes WHERE id = $1 AND product_id = $2`, changeID, productID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrPriceChangeNotFound
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get price change: %w", err)
+		}
+		return nil, fmt.Errorf("%w: price change %d is already %s", domain.ErrPriceChangeInvalid, changeID, status)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel price change: %w", err)
+	}
+	return cancelled, nil
+}
+
+func (r *priceChangeRepository) ApplyDue(ctx context.Context, now time.Time) ([]*domain.AppliedPriceChange, error) {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// SKIP LOCKED позволяет нескольким экземплярам сервиса запускать планировщик одновременно
+	due := []*domain.PriceChange{}
+	err = tx.SelectContext(ctx, &due, `
+		SELECT `+priceChangeColumns+` FROM price_changes
+		WHERE status = $1 AND effective_at <= $2
+		ORDER BY effective_at, id
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED`,
+		domain.PriceChangeScheduled, now, applyDueBatch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due price changes: %w", err)
+	}
+
+	applied := make([]*domain.AppliedPriceChange, 0, len(due))
+	for _, change := range due {
+		before := &domain.Product{}
+		if err := tx.GetContext(ctx, before, `SELECT `+productColumns+` FROM products WHERE id = $1 FOR UPDATE`, change.ProductID); err != nil {
+			return nil, fmt.Errorf("failed to lock product %d: %w", change.ProductID, err)
+		}
+
+		status := domain.PriceChangeApplied
+		after := before
+		if before.Status == domain.ProductStatusDeleted {
+			status = domain.PriceChangeCancelled
+		} else if !before.Price.Equal(change.Price) {
+			after = &domain.Product{}
+			err := tx.GetContext(ctx, after, `
+				UPDATE products SET price = $1, version = version + 1, updated_at = CURRENT_TIMESTAMP
+				WHERE id = $2
+				RETURNING `+productColumns,
+				change.Price, change.ProductID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update price of product %d: %w", change.ProductID, err)
+			}
+			// Триггер записал в историю время транзакции; цена по расписанию действует с effective_at
+			_, err = tx.ExecContext(ctx, `
+				UPDATE product_price_history SET effective_from = $1
+				WHERE id = (SELECT MAX(id) FROM product_price_history WHERE product_id = $2)`,
+				change.EffectiveAt, change.ProductID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to date price history of product %d: %w", change.ProductID, err)
+			}
+		}
+
+		stored := &domain.PriceChange{}
+		err := tx.GetContext(ctx, stored, `
+			UPDATE price_changes SET status = $1, applied_at = $2
+			WHERE id = $3
+			RETURNING `+priceChangeColumns,
+			status, now, change.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark price change %d: %w", change.ID, err)
+		}
+		if status == domain.PriceChangeApplied {
+			applied = append(applied, &domain.AppliedPriceChange{Change: stored, Before: before, After: after})
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit price changes: %w", err)
+	}
+	return applied, nil
+}
+
+func (r *priceChangeRepository) GetHistory(ctx context.Context, productID int32) ([]*domain.PriceHistoryEntry, error) {
+	entries := []*domain.PriceHistoryEntry{}
+	query := `SELECT ` + priceHistoryColumns + ` FROM product_price_history WHERE product_id = $1 ORDER BY effective_from DESC, id DESC`
+	if err := conn(ctx, r.db).SelectContext(ctx, &entries, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to get price history: %w", err)
+	}
+	return entries, nil
+}
+
+func (r *priceChangeRepository) GetPriceAt(ctx context.Context, productID int32, at time.Time) (*domain.PriceHistoryEntry, error) {
+	entry := &domain.PriceHistoryEntry{}
+	err := conn(ctx, r.db).GetContext(ctx, entry, `
+		SELECT `+priceHistoryColumns+` FROM product_price_history
+		WHERE product_id = $1 AND effective_from <= $2
+		ORDER BY effective_from DESC, id DESC
+		LIMIT 1`,
+		productID, at)
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const priceTierColumns = `id, product_id, min_quantity, unit_price, created_at`
+
+type priceTierRepository struct {
+	db *sqlx.DB
+}
+
+func NewPriceTierRepository(db *sqlx.DB) domain.ProductPriceTierRepository {
+	return &priceTierRepository{db: db}
+}
+
+func (r *priceTierRepository) GetByProduct(ctx context.Context, productID int32) ([]*domain.PriceTier, error) {
+	tiers := []*domain.PriceTier{}
+	query := `SELECT ` + priceTierColumns + ` FROM product_price_tiers WHERE product_id = $1 ORDER BY min_quantity`
+	if err := conn(ctx, r.db).SelectContext(ctx, &tiers, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to get price tiers: %w", err)
+	}
+	return tiers, nil
+}
+
+func (r *priceTierRepository) GetByProducts(ctx context.Context, productIDs []int32) (map[int32][]*domain.PriceTier, error) {
+	result := make(map[int32][]*domain.PriceTier, len(productIDs))
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	tiers := []*domain.PriceTier{}
+	query := `SELECT ` + priceTierColumns + ` FROM product_price_tiers WHERE product_id = ANY($1) ORDER BY product_id, min_quantity`
+	if err := conn(ctx, r.db).SelectContext(ctx, &tiers, query, pq.Array(productIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get price tiers: %w", err)
+	}
+
+	for _, tier := range tiers {
+		result[tier.ProductID] = append(result[tier.ProductID], tier)
+	}
+	return result, nil
+}
+
+func (r *priceTierRepository) ReplaceForProduct(ctx context.Context, productID int32, tiers []*domain.PriceTier) ([]*domain.PriceTier, error) {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_price_tiers WHERE product_id = $1`, productID); err != nil {
+		return nil, fmt.Errorf("failed to delete price tiers: %w", err)
+	}
+
+	saved := make([]*domain.PriceTier, 0, len(tiers))
+	for _, tier := range tiers {
+		stored := &domain.PriceTier{}
+		err := tx.GetContext(ctx, stored, `
+			INSERT INTO product_price_tiers (product_id, min_quantity, unit_price)
+			VALUES ($1, $2, $3)
+			RETURNING `+priceTierColumns,
+			productID, tier.MinQuantity, tier.UnitPrice)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save price tier from %d: %w", tier.MinQuantity, err)
+		}
+		saved = append(saved, stored)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit price tiers: %w", err)
+	}
+	return saved, nil
+}
@@ -2,15 +2,22 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"github.com/Nzyazin/zadnik.store/internal/product/config"
 	"github.com/Nzyazin/zadnik.store/internal/product/domain"
 )
 
+// productColumns перечисляет колонки, которые отображаются на domain.Product
+const productColumns = `id, name, slug, description, price, tax_class, image_url, status, visibility, publish_at, unpublish_at, version, created_at, updated_at`
+
 type productRepository struct {
 	db *sqlx.DB
 }
@@ -41,35 +48,173 @@ func NewProductRepository(db *sqlx.DB) domain.ProductRepository {
 	return &productRepository{db: db}
 }
 
-func (r *productRepository) GetAll(ctx context.Context) ([]*domain.Product, error) {
+func (r *productRepository) GetAll(ctx context.Context, query domain.ProductQuery) ([]*domain.Product, error) {
 	products := []*domain.Product{}
-	query := `SELECT * FROM products`
-	err := r.db.SelectContext(ctx, &products, query)
+	conditions, args := productFilterConditions(query.Filter, nil)
+
+	direction := "ASC"
+	if query.Desc {
+		direction = "DESC"
+	}
+	sort := query.Sort
+	if !sort.IsValid() {
+		sort = domain.ProductSortID
+	}
+
+	sqlQuery := fmt.Sprintf(
+		`SELECT %s FROM products%s ORDER BY %s %s, id %s LIMIT $%d OFFSET $%d`,
+		productColumns, whereClause(conditions), sort, direction, direction, len(args)+1, len(args)+2,
+	)
+	args = append(args, query.Limit, query.Offset)
+
+	err := conn(ctx, r.db).SelectContext(ctx, &products, sqlQuery, args...)
 	return products, err
 }
 
+func (r *productRepository) Count(ctx context.Context, filter domain.ProductFilter) (int, error) {
+	var total int
+	conditions, args := productFilterConditions(filter, nil)
+	err := conn(ctx, r.db).GetContext(ctx, &total, `SELECT COUNT(*) FROM products`+whereClause(conditions), args...)
+	return total, err
+}
+
+type searchRow struct {
+	domain.ProductSearchResult
+	Total int `db:"total"`
+}
+
+func (r *productRepository) Search(ctx context.Context, query domain.ProductSearchQuery) ([]*domain.ProductSearchResult, int, error) {
+	args := []interface{}{query.Text, escapeLike(strings.ToLower(query.Text))}
+	conditions, args := productFilterConditions(query.Filter, args)
+	conditions = append(conditions, `(search_vector @@ q.query OR lower(name) % lower($1) OR lower(name) LIKE '%' || $2 || '%')`)
+
+	headlineOptions := fmt.Sprintf("StartSel=%s, StopSel=%s", domain.HighlightStart, domain.HighlightStop)
+	sqlQuery := fmt.Sprintf(`
+		SELECT %s,
+			ts_rank(search_vector, q.query) + similarity(lower(name), lower($1)) AS rank,
+			ts_headline('russian', name, q.query, 'HighlightAll=true, %s') AS name_highlight,
+			ts_headline('russian', coalesce(description, ''), q.query, 'MaxFragments=2, MaxWords=20, MinWords=5, %s') AS snippet,
+			COUNT(*) OVER() AS total
+		FROM products, websearch_to_tsquery('russian', $1) AS q(query)
+		%s
+		ORDER BY rank DESC, id
+		LIMIT $%d OFFSET $%d`,
+		productColumns, headlineOptions, headlineOptions, whereClause(conditions), len(args)+1, len(args)+2,
+	)
+	args = append(args, query.Limit, query.Offset)
+
+	rows := []*searchRow{}
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, sqlQuery, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to search products: %w", err)
+	}
+
+	results := make([]*domain.ProductSearchResult, len(rows))
+	total := 0
+	for i, row := range rows {
+		results[i] = &row.ProductSearchResult
+		total = row.Total
+	}
+	if len(rows) == 0 && query.Offset > 0 {
+		err := conn(ctx, r.db).GetContext(ctx, &total, fmt.Sprintf(
+			`SELECT COUNT(*) FROM products, websearch_to_tsquery('russian', $1) AS q(query) %s`,
+			whereClause(conditions)), args[:len(args)-2]...)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to count search results: %w", err)
+		}
+	}
+
+	return results, total, nil
+}
+
+// productFilterConditions добавляет условия фильтра; плейсхолдеры продолжают нумерацию args
+func productFilterConditions(filter domain.ProductFilter, args []interface{}) ([]string, []interface{}) {
+	var conditions []string
+
+	if filter.Published {
+		conditions = append(conditions, publishedCondition)
+	}
+	if len(filter.Statuses) > 0 {
+		args = append(args, pq.Array(filter.Statuses))
+		conditions = append(conditions, fmt.Sprintf("status = ANY($%d)", len(args)))
+	}
+	if filter.MinPrice != nil {
+		args = append(args, *filter.MinPrice)
+		conditions = append(conditions, fmt.Sprintf("price >= $%d", len(args)))
+	}
+	if filter.MaxPrice != nil {
+		args = append(args, *filter.MaxPrice)
+		conditions = append(conditions, fmt.Sprintf("price <= $%d", len(args)))
+	}
+	if filter.CategoryID != nil {
+		args = append(args, *filter.CategoryID)
+		conditions = append(conditions, fmt.Sprintf(`id IN (
+			WITH RECURSIVE tree AS (
+				SELECT id FROM categories WHERE id = $%d
+				UNION ALL
+				SELECT c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+			)
+			SELECT pc.product_id FROM product_categories pc JOIN tree t ON pc.category_id = t.id
+		)`, len(args)))
+	}
+	for _, attribute := range filter.Attributes {
+		args = append(args, attribute.Code)
+		valueConditions := []string{fmt.Sprintf("a.code = $%d", len(args))}
+		if len(attribute.Values) > 0 {
+			args = append(args, pq.Array(attribute.Values))
+			valueConditions = append(valueConditions, fmt.Sprintf("v.value_text = ANY($%d)", len(args)))
+		}
+		if attribute.Min != nil {
+			args = append(args, *attribute.Min)
+			valueConditions = append(valueConditions, fmt.Sprintf("v.value_number >= $%d", len(args)))
+		}
+		if attribute.Max != nil {
+			args = append(args, *attribute.Max)
+			valueConditions = append(valueConditions, fmt.Sprintf("v.value_number <= $%d", len(args)))
+		}
+		conditions = append(conditions, `id IN (
+			SELECT v.product_id FROM product_attribute_values v JOIN attributes a ON a.id = v.attribute_id
+			WHERE `+strings.Join(valueConditions, " AND ")+`
+		)`)
+	}
+
+	return conditions, args
+}
+
+// publishedCondition повторяет domain.Product.IsPublished: запланированный товар виден с publish_at,
+// даже если планировщик ещё не перевёл его в published
+const publishedCondition = `status = 'active'
+	AND (visibility = 'published' OR (visibility = 'scheduled' AND publish_at <= now()))
+	AND (unpublish_at IS NULL OR unpublish_at > now())`
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
 func (r *productRepository) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
 	product := &domain.Product{}
-	query := `SELECT * FROM products WHERE id = $1`
-	err := r.db.GetContext(ctx, product, query, id)
+	query := `SELECT ` + productColumns + ` FROM products WHERE id = $1`
+	err := conn(ctx, r.db).GetContext(ctx, product, query, id)
 	return product, err
 }
 
-func (r *productRepository) UpdateProductImage(ctx context.Context, productID int32, imageURL string) error {
-	query := `UPDATE products SET image_url = $1 WHERE id = $2`
-	_, err := r.db.ExecContext(ctx, query, imageURL, productID)
-	return err
-}
-
 func (r *productRepository) Update(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	// image_url не трогаем: его ведёт галерея товара (product_images)
 	query := `
 		UPDATE products 
-		SET name = $1, slug = $2, description = $3, price = $4, image_url = $5
-		WHERE id = $6
-		RETURNING *
-	`
+		SET name = $1, slug = $2, description = $3, price = $4, tax_class = $5, version = version + 1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $6 AND version = $7
+		RETURNING ` + productColumns
 	updatedProduct := &domain.Product{}
-	err := r.db.GetContext(
+	err := conn(ctx, r.db).GetContext(
 		ctx,
 		updatedProduct,
 		query,
@@ -77,10 +222,21 @@ func (r *productRepository) Update(ctx context.Context, product *domain.Product)
 		product.Slug,
 		product.Description,
 		product.Price,
-		product.ImageURL,
+		product.TaxClass,
 		product.ID,
+		product.Version,
 	)
 
+	if errors.Is(err, sql.ErrNoRows) {
+		var exists bool
+		if err := conn(ctx, r.db).GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM products WHERE id = $1)`, product.ID); err != nil {
+			return nil, fmt.Errorf("failed to check product existence: %w", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("product %d version %d is stale: %w", product.ID, product.Version, domain.ErrVersionConflict)
+		}
+		return nil, fmt.Errorf("failed to updated product: %w", err)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to updated product: %w", err)
 	}
@@ -88,19 +244,33 @@ func (r *productRepository) Update(ctx context.Context, product *domain.Product)
 	return updatedProduct, nil
 }
 
+// LockVersion берёт разделяемую блокировку строки товара: PATCH и PUT товара ждут конца транзакции,
+// поэтому сверенная версия не устаревает, пока в той же транзакции пишутся связанные данные
+func (r *productRepository) LockVersion(ctx context.Context, id, version int32) error {
+	var current int32
+	err := conn(ctx, r.db).GetContext(ctx, &current, `SELECT version FROM products WHERE id = $1 FOR SHARE`, id)
+	if err != nil {
+		return fmt.Errorf("failed to lock product: %w", err)
+	}
+	if current != version {
+		return fmt.Errorf("product %d version %d is stale: %w", id, version, domain.ErrVersionConflict)
+	}
+	return nil
+}
+
 func (r *productRepository) BeginDelete(ctx context.Context, productID int32) error {
 
 	var product domain.Product
-	err := r.db.GetContext(ctx, &product, `SELECT * FROM products WHERE id = $1 FOR UPDATE`, productID)
+	err := conn(ctx, r.db).GetContext(ctx, &product, `SELECT `+productColumns+` FROM products WHERE id = $1 FOR UPDATE`, productID)
 	if err != nil {
 		return fmt.Errorf("failed to get product: %w", err)
 	}
 
 	if product.Status == domain.ProductStatusDeleting {
-		return fmt.Errorf("product %d is already deleted", productID)
+		return fmt.Errorf("product %d is already deleted: %w", productID, domain.ErrProductBusy)
 	}
 
-	_, err = r.db.ExecContext(ctx, `UPDATE products SET status = $1 WHERE ID = $2`, domain.ProductStatusDeleting, productID)
+	_, err = conn(ctx, r.db).ExecContext(ctx, `UPDATE products SET status = $1 WHERE ID = $2`, domain.ProductStatusDeleting, productID)
 	if err != nil {
 		return fmt.Errorf("failed to begin delete product: %w", err)
 	}
@@ -110,7 +280,7 @@ func (r *productRepository) BeginDelete(ctx context.Context, productID int32) er
 
 func (r *productRepository) CompleteDelete(ctx context.Context, productID int32) error {
 	var product domain.Product
-	err := r.db.GetContext(ctx, &product, `SELECT * FROM products WHERE id = $1`, productID)
+	err := conn(ctx, r.db).GetContext(ctx, &product, `SELECT `+productColumns+` FROM products WHERE id = $1`, productID)
 	if err != nil {
 		return fmt.Errorf("failed to get product: %w", err)
 	}
@@ -119,7 +289,7 @@ func (r *productRepository) CompleteDelete(ctx context.Context, productID int32)
 		return fmt.Errorf("product %d is not in deleting status", productID)
 	}
 
-	result, err := r.db.ExecContext(ctx, "DELETE FROM products WHERE id = $1 AND status = $2", productID, domain.ProductStatusDeleting)
+	result, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM products WHERE id = $1 AND status = $2", productID, domain.ProductStatusDeleting)
 	if err != nil {
 		return fmt.Errorf("failed to delete product: %w", err)
 	}
@@ -136,7 +306,7 @@ func (r *productRepository) CompleteDelete(ctx context.Context, productID int32)
 }
 
 func (r *productRepository) RollbackDelete(ctx context.Context, productID int32) error {
-	result, err := r.db.ExecContext(ctx, 
+	result, err := conn(ctx, r.db).ExecContext(ctx, 
 		"UPDATE products SET status = $1 WHERE id = $2 AND status = $3",
 		domain.ProductStatusActive, productID, domain.ProductStatusDeleting)
 	if err != nil {
@@ -154,7 +324,7 @@ func (r *productRepository) RollbackDelete(ctx context.Context, productID int32)
 }
 
 func (r *productRepository) RollbackCreate(ctx context.Context, productID int32) error {
-	result, err := r.db.ExecContext(ctx, 
+	result, err := conn(ctx, r.db).ExecContext(ctx, 
 		"DELETE FROM products WHERE id = $1",
 		productID)
 	if err != nil {
@@ -173,18 +343,22 @@ func (r *productRepository) RollbackCreate(ctx context.Context, productID int32)
 
 func (r *productRepository) Create(ctx context.Context, product *domain.Product) error {
 	query := `
-		INSERT INTO products (name, description, price, status, slug)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO products (name, description, price, status, slug, tax_class, visibility, publish_at, unpublish_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING id;
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
 		product.Name,
 		product.Description,
 		product.Price,
-		domain.ProductStatusPending,
+		product.Status,
 		product.Slug,
-	)
+		product.TaxClass,
+		product.Visibility,
+		product.PublishAt,
+		product.UnpublishAt,
+	).Scan(&product.ID)
 
 	if err != nil {
 		return fmt.Errorf("failed to create product: %w", err)
@@ -194,7 +368,7 @@ func (r *productRepository) Create(ctx context.Context, product *domain.Product)
 }
 
 func (r *productRepository) CompleteCreate(ctx context.Context, productID int32, imageURL string) error {
-	result, err := r.db.ExecContext(ctx,
+	result, err := conn(ctx, r.db).ExecContext(ctx,
 		"UPDATE products SET status = $1, image_url = $2 WHERE id = $3 AND status = $4",
 		domain.ProductStatusActive,
 		imageURL,
@@ -216,17 +390,21 @@ func (r *productRepository) CompleteCreate(ctx context.Context, productID int32,
 
 func (r *productRepository) BeginCreate(ctx context.Context, product *domain.Product) (*domain.Product, error) {
 	query := `
-		INSERT INTO products (name, description, price, status, slug)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO products (name, description, price, status, slug, tax_class, visibility, publish_at, unpublish_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING id;
 	`
 
-	err := r.db.QueryRowContext(ctx, query,
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
 		product.Name,
 		product.Description,
 		product.Price,
 		domain.ProductStatusPending,
 		product.Slug,
+		product.TaxClass,
+		product.Visibility,
+		product.PublishAt,
+		product.UnpublishAt,
 	).Scan(&product.ID)
 
 	if err != nil {
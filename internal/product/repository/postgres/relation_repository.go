@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+type relationRepository struct {
+	db *sqlx.DB
+}
+
+func NewRelationRepository(db *sqlx.DB) domain.ProductRelationRepository {
+	return &relationRepository{db: db}
+}
+
+func (r *relationRepository) GetByProduct(ctx context.Context, productID int32) ([]*domain.ProductRelation, error) {
+	relations := []*domain.ProductRelation{}
+	query := `
+		SELECT r.product_id, r.related_id, r.kind, r.position, p.name, p.slug, p.status, p.visibility
+		FROM product_relations r
+		JOIN products p ON p.id = r.related_id
+		WHERE r.product_id = $1
+		ORDER BY r.position, r.related_id`
+	if err := r.db.SelectContext(ctx, &relations, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product relations: %w", err)
+	}
+	return relations, nil
+}
+
+func (r *relationRepository) Replace(ctx context.Context, productID int32, relations []*domain.ProductRelation) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_relations WHERE product_id = $1`, productID); err != nil {
+		return fmt.Errorf("failed to clear product relations: %w", err)
+	}
+
+	for _, relation := range relations {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO product_relations (product_id, related_id, kind, position)
+			VALUES ($1, $2, $3, $4)`,
+			productID, relation.RelatedID, relation.Kind, relation.Position)
+		if err != nil {
+			return fmt.Errorf("failed to save product relation: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product relations: %w", err)
+	}
+	return nil
+}
+
+func (r *relationRepository) GetRelatedProducts(ctx context.Context, productID int32) ([]*domain.Product, error) {
+	products := []*domain.Product{}
+	query := `
+		SELECT ` + productColumns + `
+		FROM products
+		JOIN (SELECT related_id, position AS relation_position FROM product_relations WHERE product_id = $1) r ON r.related_id = id
+		WHERE ` + publishedCondition + `
+		ORDER BY r.relation_position, id`
+	if err := r.db.SelectContext(ctx, &products, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to get related products: %w", err)
+	}
+	return products, nil
+}
+
+// GetSimilar считает общие разделы и совпавшие значения характеристик для каждого кандидата.
+// Подзапросы коррелированные, но каталог небольшой, а limit отсекает выдачу
+func (r *relationRepository) GetSimilar(ctx context.Context, productID int32, exclude []int32, limit int) ([]*domain.Product, error) {
+	products := []*domain.Product{}
+	query := `
+		SELECT ` + productColumns + ` FROM (
+			SELECT p.*,
+				(SELECT COUNT(*) FROM product_categories pc
+					WHERE pc.product_id = p.id
+						AND pc.category_id IN (SELECT category_id FROM product_categories WHERE product_id = $1)
+				) AS shared_categories,
+				(SELECT COUNT(*) FROM product_attribute_values v
+					JOIN product_attribute_values s ON s.attribute_id = v.attribute_id AND s.product_id = $1
+					WHERE v.product_id = p.id
+						AND (s.value_number = v.value_number OR s.value_text = v.value_text)
+				) AS shared_attributes
+			FROM products p
+			WHERE p.id <> $1 AND NOT (p.id = ANY($2))
+		) candidates
+		WHERE ` + publishedCondition + ` AND (shared_categories > 0 OR shared_attributes > 0)
+		ORDER BY shared_categories DESC, shared_attributes DESC, id
+		LIMIT $3`
+	if err := r.db.SelectContext(ctx, &products, query, productID, pq.Array(exclude), limit); err != nil {
+		return nil, fmt.Errorf("failed to get similar products: %w", err)
+	}
+	return products, nil
+}
@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const reviewColumns = `r.id, r.product_id, p.name AS product_name, r.author_name, r.company, r.rating, r.text, r.status,
+	r.reply, r.replied_at, r.moderated_by, r.moderated_at, r.author_key, r.created_at`
+
+type reviewRepository struct {
+	db *sqlx.DB
+}
+
+func NewReviewRepository(db *sqlx.DB) domain.ProductReviewRepository {
+	return &reviewRepository{db: db}
+}
+
+func (r *reviewRepository) Create(ctx context.Context, review *domain.Review) (*domain.Review, error) {
+	var id int32
+	err := r.db.QueryRowxContext(ctx, `
+		INSERT INTO product_reviews (product_id, author_name, company, rating, text, status, author_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`,
+		review.ProductID, review.AuthorName, review.Company, review.Rating, review.Text, review.Status, review.AuthorKey).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create review: %w", err)
+	}
+	return r.GetByID(ctx, id)
+}
+
+func (r *reviewRepository) GetByID(ctx context.Context, id int32) (*domain.Review, error) {
+	var review domain.Review
+	query := `SELECT ` + reviewColumns + ` FROM product_reviews r JOIN products p ON p.id = r.product_id WHERE r.id = $1`
+	err := r.db.GetContext(ctx, &review, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrReviewNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review: %w", err)
+	}
+	return &review, nil
+}
+
+func (r *reviewRepository) GetAll(ctx context.Context, query domain.ReviewQuery) ([]*domain.Review, int, error) {
+	var conditions []string
+	var args []interface{}
+	if query.ProductID != nil {
+		args = append(args, *query.ProductID)
+		conditions = append(conditions, fmt.Sprintf("r.product_id = $%d", len(args)))
+	}
+	if query.Status != "" {
+		args = append(args, query.Status)
+		conditions = append(conditions, fmt.Sprintf("r.status = $%d", len(args)))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM product_reviews r`+where, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count reviews: %w", err)
+	}
+
+	reviews := []*domain.Review{}
+	args = append(args, query.Limit, query.Offset)
+	sqlQuery := `SELECT ` + reviewColumns + ` FROM product_reviews r JOIN products p ON p.id = r.product_id` + where +
+		fmt.Sprintf(" ORDER BY r.created_at DESC, r.id DESC LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	if err := r.db.SelectContext(ctx, &reviews, sqlQuery, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to get reviews: %w", err)
+	}
+	return reviews, total, nil
+}
+
+func (r *reviewRepository) CountRecent(ctx context.Context, productID int32, authorKey string, since time.Time) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM product_reviews WHERE product_id = $1 AND author_key = $2 AND created_at > $3`
+	if err := r.db.GetContext(ctx, &count, query, productID, authorKey, since); err != nil {
+		return 0, fmt.Errorf("failed to count recent reviews: %w", err)
+	}
+	return count, nil
+}
+
+func (r *reviewRepository) Moderate(ctx context.Context, moderation *domain.ReviewModeration) (*domain.Review, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE product_reviews
+		SET status = $2,
+			replied_at = CASE WHEN $3 = '' THEN NULL WHEN reply = $3 THEN replied_at ELSE CURRENT_TIMESTAMP END,
+			reply = $3,
+			moderated_by = $4,
+			moderated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`,
+		moderation.ReviewID, moderation.Status, moderation.Reply, moderation.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to moderate review: %w", err)
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return nil, domain.ErrReviewNotFound
+	}
+	return r.GetByID(ctx, moderation.ReviewID)
+}
+
+func (r *reviewRepository) GetRatings(ctx context.Context, productIDs []int32) (map[int32]*domain.ProductRating, error) {
+	result := make(map[int32]*domain.ProductRating, len(productIDs))
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	ratings := []*domain.ProductRating{}
+	query := `
+		SELECT product_id, ROUND(AVG(rating), 1)::float8 AS average, COUNT(*) AS count
+		FROM product_reviews
+		WHERE product_id = ANY($1) AND status = 'approved'
+		GROUP BY product_id`
+	if err := r.db.SelectContext(ctx, &ratings, query, pq.Array(productIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get product ratings: %w", err)
+	}
+
+	for _, rating := range ratings {
+		result[rating.ProductID] = rating
+	}
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+type revisionRepository struct {
+	db *sqlx.DB
+}
+
+func NewRevisionRepository(db *sqlx.DB) domain.ProductRevisionRepository {
+	return &revisionRepository{db: db}
+}
+
+func (r *revisionRepository) Create(ctx context.Context, revision *domain.ProductRevision) error {
+	query := `
+		INSERT INTO product_revisions (product_id, user_id, action, before, after)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		revision.ProductID,
+		revision.UserID,
+		revision.Action,
+		revision.Before,
+		revision.After,
+	).Scan(&revision.ID, &revision.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to create product revision: %w", err)
+	}
+
+	return nil
+}
+
+func (r *revisionRepository) GetByID(ctx context.Context, id int32) (*domain.ProductRevision, error) {
+	revision := &domain.ProductRevision{}
+	err := conn(ctx, r.db).GetContext(ctx, revision, `SELECT * FROM product_revisions WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product revision: %w", err)
+	}
+	return revision, nil
+}
+
+func (r *revisionRepository) ListByProduct(ctx context.Context, productID int32) ([]*domain.ProductRevision, error) {
+	revisions := []*domain.ProductRevision{}
+	query := `SELECT * FROM product_revisions WHERE product_id = $1 ORDER BY created_at DESC, id DESC`
+	if err := conn(ctx, r.db).SelectContext(ctx, &revisions, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to list product revisions: %w", err)
+	}
+	return revisions, nil
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+type slugRepository struct {
+	db *sqlx.DB
+}
+
+func NewSlugRepository(db *sqlx.DB) domain.ProductSlugRepository {
+	return &slugRepository{db: db}
+}
+
+func (r *slugRepository) IsTaken(ctx context.Context, slug string, productID int32) (bool, error) {
+	// блокировка держится до конца транзакции: параллельное сохранение с тем же адресом дождётся её и увидит адрес занятым
+	if _, err := conn(ctx, r.db).ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext('product_slug:' || $1))", slug); err != nil {
+		return false, fmt.Errorf("failed to lock slug %q: %w", slug, err)
+	}
+
+	var taken bool
+	query := `
+		SELECT EXISTS (SELECT 1 FROM products WHERE slug = $1 AND id <> $2)
+			OR EXISTS (SELECT 1 FROM product_slug_history WHERE slug = $1 AND product_id <> $2)
+	`
+	if err := conn(ctx, r.db).GetContext(ctx, &taken, query, slug, productID); err != nil {
+		return false, fmt.Errorf("failed to check slug %q: %w", slug, err)
+	}
+	return taken, nil
+}
+
+func (r *slugRepository) Record(ctx context.Context, productID int32, oldSlug, newSlug string) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		"DELETE FROM product_slug_history WHERE slug = $1 AND product_id = $2",
+		newSlug, productID); err != nil {
+		return fmt.Errorf("failed to delete slug history: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO product_slug_history (slug, product_id)
+		VALUES ($1, $2)
+		ON CONFLICT (slug) DO UPDATE SET product_id = EXCLUDED.product_id, created_at = CURRENT_TIMESTAMP
+	`, oldSlug, productID); err != nil {
+		return fmt.Errorf("failed to record slug history: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *slugRepository) Resolve(ctx context.Context, slug string) (int32, error) {
+	var productID int32
+	query := `
+		SELECT id FROM products WHERE slug = $1
+		UNION ALL
+		SELECT product_id FROM product_slug_history WHERE slug = $1
+		LIMIT 1
+	`
+	if err := conn(ctx, r.db).GetContext(ctx, &productID, query, slug); err != nil {
+		return 0, err
+	}
+	return productID, nil
+}
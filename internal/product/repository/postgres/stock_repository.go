@@ -0,0 +1,338 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const (
+	stockColumns       = `id, product_id, variant_id, on_hand, reserved, low_stock_threshold, updated_at`
+	reservationColumns = `r.id, r.stock_item_id, s.product_id, s.variant_id, r.quantity, r.reference, r.status,
+		r.expires_at, r.created_at, r.closed_at, r.user_id`
+	// expireReservationsBatch ограничивает число резервов, снимаемых за одну транзакцию
+	expireReservationsBatch = 100
+)
+
+type stockRepository struct {
+	db *sqlx.DB
+}
+
+func NewStockRepository(db *sqlx.DB) domain.StockRepository {
+	return &stockRepository{db: db}
+}
+
+func (r *stockRepository) GetByProduct(ctx context.Context, productID int32) ([]*domain.StockItem, error) {
+	items := []*domain.StockItem{}
+	query := `SELECT ` + stockColumns + ` FROM stock_items WHERE product_id = $1 ORDER BY variant_id NULLS FIRST`
+	if err := r.db.SelectContext(ctx, &items, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to get stock: %w", err)
+	}
+	return items, nil
+}
+
+func (r *stockRepository) GetByProducts(ctx context.Context, productIDs []int32) (map[int32][]*domain.StockItem, error) {
+	result := make(map[int32][]*domain.StockItem, len(productIDs))
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	items := []*domain.StockItem{}
+	query := `SELECT ` + stockColumns + ` FROM stock_items WHERE product_id = ANY($1) ORDER BY product_id, variant_id NULLS FIRST`
+	if err := r.db.SelectContext(ctx, &items, query, pq.Array(productIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get stock: %w", err)
+	}
+
+	for _, item := range items {
+		result[item.ProductID] = append(result[item.ProductID], item)
+	}
+	return result, nil
+}
+
+func (r *stockRepository) Move(ctx context.Context, movement *domain.StockMovement) (*domain.StockItem, *domain.StockItem, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	before, err := lockStockItem(ctx, tx, movement.ProductID, movement.VariantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	after, err := applyMovement(ctx, tx, before, movement)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit stock movement: %w", err)
+	}
+	return &before, &after, nil
+}
+
+func (r *stockRepository) SetThreshold(ctx context.Context, productID int32, variantID *int32, threshold int32) (*domain.StockItem, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	item, err := lockStockItem(ctx, tx, productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.GetContext(ctx, &item, `
+		UPDATE stock_items SET low_stock_threshold = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2
+		RETURNING `+stockColumns,
+		threshold, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update stock threshold: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit stock threshold: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *stockRepository) GetMovements(ctx context.Context, productID int32, limit int) ([]*domain.StockMovement, error) {
+	movements := []*domain.StockMovement{}
+	err := r.db.SelectContext(ctx, &movements, `
+		SELECT m.id, m.stock_item_id, s.product_id, s.variant_id, m.kind, m.quantity, m.on_hand_after, m.reserved_after,
+			m.reference, m.comment, m.user_id, m.created_at
+		FROM stock_movements m
+		JOIN stock_items s ON s.id = m.stock_item_id
+		WHERE s.product_id = $1
+		ORDER BY m.created_at DESC, m.id DESC
+		LIMIT $2`,
+		productID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock movements: %w", err)
+	}
+	return movements, nil
+}
+
+func (r *stockRepository) Reserve(ctx context.Context, reservation *domain.StockReservation) (*domain.StockItem, *domain.StockItem, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	before, err := lockStockItem(ctx, tx, reservation.ProductID, reservation.VariantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	after, err := applyMovement(ctx, tx, before, &domain.StockMovement{
+		Kind:      domain.StockMovementReservation,
+		Quantity:  reservation.Quantity,
+		Reference: reservation.Reference,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation.StockItemID = before.ID
+	reservation.Status = domain.StockReservationActive
+	err = tx.GetContext(ctx, reservation, `
+		INSERT INTO stock_reservations (stock_item_id, quantity, reference, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at`,
+		reservation.StockItemID, reservation.Quantity, reservation.Reference, reservation.ExpiresAt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create stock reservation: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit stock reservation: %w", err)
+	}
+	return &before, &after, nil
+}
+
+func (r *stockRepository) GetReservations(ctx context.Context, productID int32) ([]*domain.StockReservation, error) {
+	reservations := []*domain.StockReservation{}
+	err := r.db.SelectContext(ctx, &reservations, `
+		SELECT `+reservationColumns+`
+		FROM stock_reservations r
+		JOIN stock_items s ON s.id = r.stock_item_id
+		WHERE s.product_id = $1 AND r.status = $2
+		ORDER BY r.expires_at, r.id`,
+		productID, domain.StockReservationActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock reservations: %w", err)
+	}
+	return reservations, nil
+}
+
+func (r *stockRepository) CloseReservation(ctx context.Context, productID, reservationID int32, status domain.StockReservationStatus, userID *int64) (*domain.StockReservation, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	reservation := &domain.StockReservation{}
+	err = tx.GetContext(ctx, reservation, `
+		SELECT `+reservationColumns+`
+		FROM stock_reservations r
+		JOIN stock_items s ON s.id = r.stock_item_id
+		WHERE r.id = $1 AND s.product_id = $2
+		FOR UPDATE OF r`,
+		reservationID, productID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrStockReservationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock stock reservation: %w", err)
+	}
+	if reservation.Status != domain.StockReservationActive {
+		return nil, fmt.Errorf("%w: reservation %d is already %s", domain.ErrStockReservationClosed, reservationID, reservation.Status)
+	}
+
+	if err := closeReservation(ctx, tx, reservation, status, time.Now(), userID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit stock reservation: %w", err)
+	}
+	return reservation, nil
+}
+
+func (r *stockRepository) ExpireReservations(ctx context.Context, now time.Time) ([]*domain.StockReservation, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// SKIP LOCKED позволяет нескольким экземплярам сервиса запускать планировщик одновременно
+	due := []*domain.StockReservation{}
+	err = tx.SelectContext(ctx, &due, `
+		SELECT `+reservationColumns+`
+		FROM stock_reservations r
+		JOIN stock_items s ON s.id = r.stock_item_id
+		WHERE r.status = $1 AND r.expires_at <= $2
+		ORDER BY r.expires_at, r.id
+		LIMIT $3
+		FOR UPDATE OF r SKIP LOCKED`,
+		domain.StockReservationActive, now, expireReservationsBatch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired stock reservations: %w", err)
+	}
+
+	for _, reservation := range due {
+		if err := closeReservation(ctx, tx, reservation, domain.StockReservationExpired, now, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit expired stock reservations: %w", err)
+	}
+	return due, nil
+}
+
+// closeReservation закрывает заблокированный резерв; всё, кроме подтверждения, возвращает остаток из резерва
+func closeReservation(ctx context.Context, tx *sqlx.Tx, reservation *domain.StockReservation, status domain.StockReservationStatus, now time.Time, userID *int64) error {
+	if status != domain.StockReservationConfirmed {
+		item, err := lockStockItem(ctx, tx, reservation.ProductID, reservation.VariantID)
+		if err != nil {
+			return err
+		}
+		if quantity := reservation.ReleaseQuantity(item); quantity > 0 {
+			_, err := applyMovement(ctx, tx, item, &domain.StockMovement{
+				Kind:      domain.StockMovementRelease,
+				Quantity:  quantity,
+				Reference: reservation.Reference,
+				Comment:   releaseComment(reservation.ID, status),
+				UserID:    userID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err := tx.GetContext(ctx, reservation, `
+		UPDATE stock_reservations SET status = $1, closed_at = $2, user_id = $3
+		WHERE id = $4
+		RETURNING status, closed_at, user_id`,
+		status, now, userID, reservation.ID)
+	if err != nil {
+		return fmt.Errorf("failed to close stock reservation %d: %w", reservation.ID, err)
+	}
+	return nil
+}
+
+func releaseComment(reservationID int32, status domain.StockReservationStatus) string {
+	if status == domain.StockReservationExpired {
+		return fmt.Sprintf("резерв №%d истёк", reservationID)
+	}
+	return fmt.Sprintf("резерв №%d снят: заявка отклонена", reservationID)
+}
+
+// applyMovement меняет заблокированную строку остатка и пишет движение в журнал
+func applyMovement(ctx context.Context, tx *sqlx.Tx, before domain.StockItem, movement *domain.StockMovement) (domain.StockItem, error) {
+	after, err := before.Apply(movement.Kind, movement.Quantity)
+	if err != nil {
+		return after, err
+	}
+
+	err = tx.GetContext(ctx, &after, `
+		UPDATE stock_items SET on_hand = $1, reserved = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3
+		RETURNING `+stockColumns,
+		after.OnHand, after.Reserved, before.ID)
+	if err != nil {
+		return after, fmt.Errorf("failed to update stock: %w", err)
+	}
+
+	movement.StockItemID = before.ID
+	movement.OnHandAfter = after.OnHand
+	movement.ReservedAfter = after.Reserved
+	err = tx.GetContext(ctx, movement, `
+		INSERT INTO stock_movements (stock_item_id, kind, quantity, on_hand_after, reserved_after, reference, comment, user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at`,
+		movement.StockItemID, movement.Kind, movement.Quantity, movement.OnHandAfter, movement.ReservedAfter,
+		movement.Reference, movement.Comment, movement.UserID)
+	if err != nil {
+		return after, fmt.Errorf("failed to record stock movement: %w", err)
+	}
+	return after, nil
+}
+
+// lockStockItem создаёт строку остатка при первом обращении и блокирует её до конца транзакции
+func lockStockItem(ctx context.Context, tx *sqlx.Tx, productID int32, variantID *int32) (domain.StockItem, error) {
+	var item domain.StockItem
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO stock_items (product_id, variant_id) VALUES ($1, $2)
+		ON CONFLICT (product_id, (COALESCE(variant_id, 0))) DO NOTHING`,
+		productID, variantID)
+	if err != nil {
+		return item, fmt.Errorf("failed to create stock item: %w", err)
+	}
+
+	err = tx.GetContext(ctx, &item, `
+		SELECT `+stockColumns+` FROM stock_items
+		WHERE product_id = $1 AND COALESCE(variant_id, 0) = COALESCE($2, 0)
+		FOR UPDATE`,
+		productID, variantID)
+	if err != nil {
+		return item, fmt.Errorf("failed to lock stock item: %w", err)
+	}
+	return item, nil
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const variantColumns = `id, product_id, sku, options, price, weight_grams, is_active, position, created_at, updated_at`
+
+type variantRepository struct {
+	db *sqlx.DB
+}
+
+func NewVariantRepository(db *sqlx.DB) domain.ProductVariantRepository {
+	return &variantRepository{db: db}
+}
+
+func (r *variantRepository) GetByProduct(ctx context.Context, productID int32) ([]*domain.ProductVariant, error) {
+	variants := []*domain.ProductVariant{}
+	query := `SELECT ` + variantColumns + ` FROM product_variants WHERE product_id = $1 ORDER BY position, id`
+	if err := conn(ctx, r.db).SelectContext(ctx, &variants, query, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product variants: %w", err)
+	}
+	return variants, nil
+}
+
+func (r *variantRepository) GetByProducts(ctx context.Context, productIDs []int32) (map[int32][]*domain.ProductVariant, error) {
+	result := make(map[int32][]*domain.ProductVariant, len(productIDs))
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	variants := []*domain.ProductVariant{}
+	query := `SELECT ` + variantColumns + ` FROM product_variants WHERE product_id = ANY($1) ORDER BY product_id, position, id`
+	if err := conn(ctx, r.db).SelectContext(ctx, &variants, query, pq.Array(productIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get product variants: %w", err)
+	}
+
+	for _, variant := range variants {
+		result[variant.ProductID] = append(result[variant.ProductID], variant)
+	}
+	return result, nil
+}
+
+func (r *variantRepository) ReplaceForProduct(ctx context.Context, productID int32, variants []*domain.ProductVariant) ([]*domain.ProductVariant, error) {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	keep := []int32{}
+	for _, variant := range variants {
+		if variant.ID != 0 {
+			keep = append(keep, variant.ID)
+		}
+	}
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM product_variants WHERE product_id = $1 AND NOT (id = ANY($2))`,
+		productID, pq.Array(keep))
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete product variants: %w", err)
+	}
+
+	saved := make([]*domain.ProductVariant, 0, len(variants))
+	for i, variant := range variants {
+		stored := &domain.ProductVariant{}
+		if variant.ID != 0 {
+			err = tx.GetContext(ctx, stored, `
+				UPDATE product_variants
+				SET sku = $1, options = $2, price = $3, weight_grams = $4, is_active = $5,
+					position = $6, updated_at = CURRENT_TIMESTAMP
+				WHERE id = $7 AND product_id = $8
+				RETURNING `+variantColumns,
+				variant.SKU, variant.Options, variant.Price, variant.WeightGrams, variant.IsActive, i, variant.ID, productID)
+		} else {
+			err = tx.GetContext(ctx, stored, `
+				INSERT INTO product_variants (product_id, sku, options, price, weight_grams, is_active, position)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)
+				RETURNING `+variantColumns,
+				productID, variant.SKU, variant.Options, variant.Price, variant.WeightGrams, variant.IsActive, i)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: variant %d does not belong to product %d", domain.ErrVariantInvalid, variant.ID, productID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to save variant %s: %w", variant.SKU, err)
+		}
+		saved = append(saved, stored)
+	}
+
+	// внутри общей транзакции коммит будет позже, поэтому отложенную проверку артикулов проводим сейчас
+	if _, err := tx.ExecContext(ctx, `SET CONSTRAINTS product_variants_sku_key IMMEDIATE`); isUniqueViolation(err) {
+		return nil, domain.ErrVariantSKUTaken
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to check variant skus: %w", err)
+	}
+
+	if err := tx.Commit(); isUniqueViolation(err) {
+		return nil, domain.ErrVariantSKUTaken
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to commit product variants: %w", err)
+	}
+	return saved, nil
+}
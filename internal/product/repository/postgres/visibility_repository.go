@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+type visibilityRepository struct {
+	db *sqlx.DB
+}
+
+func NewVisibilityRepository(db *sqlx.DB) domain.ProductVisibilityRepository {
+	return &visibilityRepository{db: db}
+}
+
+func (r *visibilityRepository) Set(ctx context.Context, change *domain.VisibilityChange) (*domain.Product, error) {
+	updated := &domain.Product{}
+	err := conn(ctx, r.db).GetContext(ctx, updated, `
+		UPDATE products
+		SET visibility = $1, publish_at = $2, unpublish_at = $3, version = version + 1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4 AND version = $5
+		RETURNING `+productColumns,
+		change.Visibility, change.PublishAt, change.UnpublishAt, change.ProductID, change.Version)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		var exists bool
+		if err := conn(ctx, r.db).GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM products WHERE id = $1)`, change.ProductID); err != nil {
+			return nil, fmt.Errorf("failed to check product existence: %w", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("product %d version %d is stale: %w", change.ProductID, change.Version, domain.ErrVersionConflict)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set product visibility: %w", err)
+	}
+	return updated, nil
+}
+
+func (r *visibilityRepository) ApplyDue(ctx context.Context, now time.Time) ([]*domain.AppliedVisibilityChange, error) {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	due := []*domain.Product{}
+	err = tx.SelectContext(ctx, &due, `
+		SELECT `+productColumns+` FROM products
+		WHERE (visibility = $1 AND publish_at <= $3)
+			OR (visibility IN ($1, $2) AND unpublish_at <= $3)
+		ORDER BY id
+		LIMIT $4
+		FOR UPDATE SKIP LOCKED`,
+		domain.ProductVisibilityScheduled, domain.ProductVisibilityPublished, now, applyDueBatch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products due for visibility change: %w", err)
+	}
+
+	applied := make([]*domain.AppliedVisibilityChange, 0, len(due))
+	for _, before := range due {
+		// товар, у которого наступили обе даты, сразу скрывается
+		visibility := domain.ProductVisibilityPublished
+		if before.UnpublishAt != nil && !before.UnpublishAt.After(now) {
+			visibility = domain.ProductVisibilityHidden
+		}
+
+		after := &domain.Product{}
+		err := tx.GetContext(ctx, after, `
+			UPDATE products SET visibility = $1, version = version + 1, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $2
+			RETURNING `+productColumns,
+			visibility, before.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to change visibility of product %d: %w", before.ID, err)
+		}
+		applied = append(applied, &domain.AppliedVisibilityChange{Before: before, After: after})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit visibility changes: %w", err)
+	}
+	return applied, nil
+}
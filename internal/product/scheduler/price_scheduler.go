@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+)
+
+// PriceScheduler периодически применяет запланированные изменения цен
+type PriceScheduler struct {
+	useCase  usecase.PriceChangeUseCase
+	interval time.Duration
+	logger   common.Logger
+}
+
+func NewPriceScheduler(useCase usecase.PriceChangeUseCase, interval time.Duration, logger common.Logger) *PriceScheduler {
+	return &PriceScheduler{
+		useCase:  useCase,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run блокируется до отмены ctx; первый проход выполняется сразу, чтобы не ждать интервал после рестарта
+func (s *PriceScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.applyDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PriceScheduler) applyDue(ctx context.Context) {
+	applied, err := s.useCase.ApplyDue(ctx, time.Now())
+	if err != nil {
+		s.logger.Errorf("Failed to apply scheduled price changes: %v", err)
+	}
+	if applied > 0 {
+		s.logger.Infof("Applied %d scheduled price changes", applied)
+	}
+}
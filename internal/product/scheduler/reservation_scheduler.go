@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+)
+
+// ReservationScheduler периодически снимает резервы под заявки, которые менеджер не обработал до expires_at
+type ReservationScheduler struct {
+	useCase  usecase.StockUseCase
+	interval time.Duration
+	logger   common.Logger
+}
+
+func NewReservationScheduler(useCase usecase.StockUseCase, interval time.Duration, logger common.Logger) *ReservationScheduler {
+	return &ReservationScheduler{
+		useCase:  useCase,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run блокируется до отмены ctx; первый проход выполняется сразу
+func (s *ReservationScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.expire(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ReservationScheduler) expire(ctx context.Context) {
+	reservations, err := s.useCase.ExpireReservations(ctx, time.Now())
+	if err != nil {
+		s.logger.Errorf("Failed to expire stock reservations: %v", err)
+	}
+	for _, reservation := range reservations {
+		s.logger.Infof("Stock reservation %d of product %d expired", reservation.ID, reservation.ProductID)
+	}
+}
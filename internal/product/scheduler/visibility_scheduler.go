@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+)
+
+// VisibilityScheduler периодически публикует запланированные товары и скрывает товары с истёкшим unpublish_at.
+// Витрина проверяет даты сама, планировщик нужен, чтобы состояние в админке и история совпадали с витриной
+type VisibilityScheduler struct {
+	useCase  usecase.ProductUseCase
+	interval time.Duration
+	logger   common.Logger
+}
+
+func NewVisibilityScheduler(useCase usecase.ProductUseCase, interval time.Duration, logger common.Logger) *VisibilityScheduler {
+	return &VisibilityScheduler{
+		useCase:  useCase,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run блокируется до отмены ctx; первый проход выполняется сразу
+func (s *VisibilityScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.applyDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *VisibilityScheduler) applyDue(ctx context.Context) {
+	products, err := s.useCase.ApplyVisibilitySchedule(ctx, time.Now())
+	if err != nil {
+		s.logger.Errorf("Failed to apply scheduled visibility changes: %v", err)
+	}
+	for _, product := range products {
+		s.logger.Infof("Product %d is %s by schedule", product.ID, product.Visibility)
+	}
+}
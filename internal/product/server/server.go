@@ -3,7 +3,10 @@ package server
 import (
 	"net/http"
 	"context"
+	"encoding/json"
 
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/Nzyazin/zadnik.store/api/openapi"
 	"github.com/Nzyazin/zadnik.store/internal/common"
 	"github.com/gorilla/mux"
 	"github.com/Nzyazin/zadnik.store/internal/product/delivery"
@@ -14,20 +17,89 @@ type Server struct {
 	logger common.Logger
 }
 
-func NewServer(addr string, handler *delivery.ProductHandler, logger common.Logger) *Server {
-	router := mux.NewRouter()
-	router.Use(handler.AuthMiddleware)
-	router.HandleFunc("/products", handler.GetAll).Methods("GET")
-	router.HandleFunc("/products/{id}", handler.GetByID).Methods("GET")
-	router.HandleFunc("/products/{id}", handler.Update).Methods("PATCH")
+func NewServer(addr string, handler *delivery.ProductHandler, categoryHandler *delivery.CategoryHandler, stockHandler *delivery.StockHandler, priceHandler *delivery.PriceChangeHandler, attributeHandler *delivery.AttributeHandler, catalogHandler *delivery.CatalogHandler, reviewHandler *delivery.ReviewHandler, logger common.Logger) (*Server, error) {
+	spec, err := openapi.LoadProduct(context.Background())
+	if err != nil {
+		return nil, err
+	}
 
 	return &Server{
 		srv: &http.Server{
 			Addr: addr,
-			Handler: router,
+			Handler: NewRouter(spec, handler, categoryHandler, stockHandler, priceHandler, attributeHandler, catalogHandler, reviewHandler, logger),
 		},
 		logger: logger,
-	}
+	}, nil
+}
+
+// NewRouter собирает маршруты HTTP API. Каждый маршрут описан в api/openapi/product.yaml:
+// запросы проверяются по спецификации, а тест сверяет её с маршрутами
+func NewRouter(spec *openapi3.T, handler *delivery.ProductHandler, categoryHandler *delivery.CategoryHandler, stockHandler *delivery.StockHandler, priceHandler *delivery.PriceChangeHandler, attributeHandler *delivery.AttributeHandler, catalogHandler *delivery.CatalogHandler, reviewHandler *delivery.ReviewHandler, logger common.Logger) *mux.Router {
+	specJSON, _ := json.Marshal(spec)
+	root := mux.NewRouter()
+	root.HandleFunc("/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(specJSON)
+	}).Methods("GET")
+
+	router := root.NewRoute().Subrouter()
+	router.Use(handler.AuthMiddleware, validateRequests(spec, logger))
+	router.HandleFunc("/products", handler.GetAll).Methods("GET")
+	router.HandleFunc("/products", handler.Create).Methods("POST")
+	router.HandleFunc("/products/search", handler.Search).Methods("GET")
+	router.HandleFunc("/products/slug/{slug}", handler.GetBySlug).Methods("GET")
+	router.HandleFunc("/products/export", catalogHandler.Export).Methods("GET")
+	router.HandleFunc("/products/import", catalogHandler.Import).Methods("POST")
+	router.HandleFunc("/products/{id}", handler.GetByID).Methods("GET")
+	router.HandleFunc("/products/{id}", handler.Replace).Methods("PUT")
+	router.HandleFunc("/products/{id}", handler.Update).Methods("PATCH")
+	router.HandleFunc("/products/{id}", handler.Delete).Methods("DELETE")
+	router.HandleFunc("/products/{id}/visibility", handler.SetVisibility).Methods("PUT")
+	router.HandleFunc("/products/{id}/relations", handler.GetRelations).Methods("GET")
+	router.HandleFunc("/products/{id}/relations", handler.SetRelations).Methods("PUT")
+	router.HandleFunc("/products/{id}/recommendations", handler.GetRecommendations).Methods("GET")
+	router.HandleFunc("/products/{id}/revisions", handler.GetRevisions).Methods("GET")
+	router.HandleFunc("/products/{id}/revisions/{revisionID}/restore", handler.RestoreRevision).Methods("POST")
+	router.HandleFunc("/products/{id}/variants", handler.GetVariants).Methods("GET")
+	router.HandleFunc("/products/{id}/variants", handler.SetVariants).Methods("PUT")
+	router.HandleFunc("/products/{id}/price-tiers", handler.GetPriceTiers).Methods("GET")
+	router.HandleFunc("/products/{id}/price-tiers", handler.SetPriceTiers).Methods("PUT")
+	router.HandleFunc("/products/{id}/quote", handler.Quote).Methods("GET")
+	router.HandleFunc("/products/{id}/price", priceHandler.PriceAt).Methods("GET")
+	router.HandleFunc("/products/{id}/price-history", priceHandler.GetHistory).Methods("GET")
+	router.HandleFunc("/products/{id}/price-changes", priceHandler.GetChanges).Methods("GET")
+	router.HandleFunc("/products/{id}/price-changes", priceHandler.Schedule).Methods("POST")
+	router.HandleFunc("/products/{id}/price-changes/{changeID}/cancel", priceHandler.Cancel).Methods("POST")
+	router.HandleFunc("/products/{id}/images", handler.GetImages).Methods("GET")
+	router.HandleFunc("/products/{id}/images", handler.ArrangeImages).Methods("PUT")
+	router.HandleFunc("/products/{id}/stock", stockHandler.GetStock).Methods("GET")
+	router.HandleFunc("/products/{id}/stock/movements", stockHandler.GetMovements).Methods("GET")
+	router.HandleFunc("/products/{id}/stock/movements", stockHandler.CreateMovement).Methods("POST")
+	router.HandleFunc("/products/{id}/stock/threshold", stockHandler.SetThreshold).Methods("PUT")
+	router.HandleFunc("/products/{id}/stock/reservations", stockHandler.GetReservations).Methods("GET")
+	router.HandleFunc("/products/{id}/stock/reservations", stockHandler.CreateReservation).Methods("POST")
+	router.HandleFunc("/products/{id}/stock/reservations/{reservationID}/confirm", stockHandler.ConfirmReservation).Methods("POST")
+	router.HandleFunc("/products/{id}/stock/reservations/{reservationID}/release", stockHandler.ReleaseReservation).Methods("POST")
+	router.HandleFunc("/products/{id}/categories", categoryHandler.GetProductCategories).Methods("GET")
+	router.HandleFunc("/products/{id}/categories", categoryHandler.SetProductCategories).Methods("PUT")
+	router.HandleFunc("/products/{id}/attributes", attributeHandler.GetProductValues).Methods("GET")
+	router.HandleFunc("/products/{id}/attributes", attributeHandler.SetProductValues).Methods("PUT")
+	router.HandleFunc("/products/{id}/reviews", reviewHandler.Create).Methods("POST")
+	router.HandleFunc("/reviews", reviewHandler.GetAll).Methods("GET")
+	router.HandleFunc("/reviews/{id}/moderation", reviewHandler.Moderate).Methods("PUT")
+	router.HandleFunc("/categories", categoryHandler.GetAll).Methods("GET")
+	router.HandleFunc("/categories", categoryHandler.Create).Methods("POST")
+	router.HandleFunc("/categories/slug/{slug}", categoryHandler.GetBySlug).Methods("GET")
+	router.HandleFunc("/categories/{id}", categoryHandler.GetByID).Methods("GET")
+	router.HandleFunc("/categories/{id}", categoryHandler.Update).Methods("PUT")
+	router.HandleFunc("/categories/{id}", categoryHandler.Delete).Methods("DELETE")
+	router.HandleFunc("/attributes", attributeHandler.GetAll).Methods("GET")
+	router.HandleFunc("/attributes", attributeHandler.Create).Methods("POST")
+	router.HandleFunc("/attributes/{id}", attributeHandler.GetByID).Methods("GET")
+	router.HandleFunc("/attributes/{id}", attributeHandler.Update).Methods("PUT")
+	router.HandleFunc("/attributes/{id}", attributeHandler.Delete).Methods("DELETE")
+
+	return root
 }
 
 func (s *Server) Run() error {
@@ -0,0 +1,510 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nzyazin/zadnik.store/api/openapi"
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/delivery"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+)
+
+const testAPIKey = "test-key"
+
+// newTestRouter собирает маршруты; без products запросы, отклонённые по спецификации, до обработчиков не доходят
+func newTestRouter(t *testing.T, products usecase.ProductUseCase) (*openapi3.T, *mux.Router) {
+	spec, err := openapi.LoadProduct(context.Background())
+	require.NoError(t, err)
+
+	logger := common.NewSimpleLogger()
+	router := NewRouter(spec,
+		delivery.NewProductHandler(products, silentEvents{}, logger, testAPIKey),
+		delivery.NewCategoryHandler(nil, logger),
+		delivery.NewStockHandler(nil, logger),
+		delivery.NewPriceChangeHandler(nil, logger),
+		delivery.NewAttributeHandler(nil, logger),
+		delivery.NewCatalogHandler(nil, logger),
+		delivery.NewReviewHandler(nil, logger),
+		logger)
+	return spec, router
+}
+
+func TestRouterMatchesSpec(t *testing.T) {
+	spec, router := newTestRouter(t, nil)
+
+	var routes []string
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil || template == "/openapi.json" {
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+		for _, method := range methods {
+			routes = append(routes, method+" "+template)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	var operations []string
+	for path, item := range spec.Paths.Map() {
+		for method := range item.Operations() {
+			operations = append(operations, method+" "+path)
+		}
+	}
+	sort.Strings(routes)
+	sort.Strings(operations)
+	assert.Equal(t, operations, routes, "routes in server.go and api/openapi/product.yaml differ")
+}
+
+func TestValidateRequests(t *testing.T) {
+	_, router := newTestRouter(t, nil)
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		want   []string
+	}{
+		{
+			name:   "price as number",
+			method: http.MethodPatch,
+			target: "/products/1",
+			body:   `{"price": 1500, "version": 3}`,
+			want:   []string{"body price"},
+		},
+		{
+			name:   "unknown field and missing version",
+			method: http.MethodPatch,
+			target: "/products/1",
+			body:   `{"titel": "Задник"}`,
+			want:   []string{"body titel", "body version"},
+		},
+		{
+			name:   "nested variant price",
+			method: http.MethodPut,
+			target: "/products/1",
+			body:   `{"name": "Задник", "price": "10", "version": 1, "variants": [{"sku": "A-1", "price": "1.005"}]}`,
+			want:   []string{"body variants.0.price"},
+		},
+		{
+			name:   "replace without version",
+			method: http.MethodPut,
+			target: "/products/1",
+			body:   `{"name": "Задник", "price": "10"}`,
+			want:   []string{"body version"},
+		},
+		{
+			name:   "path and query parameters",
+			method: http.MethodGet,
+			target: "/products/abc/quote?quantity=0",
+			want:   []string{"path id", "query quantity"},
+		},
+		{
+			name:   "unknown movement kind",
+			method: http.MethodPost,
+			target: "/products/1/stock/movements",
+			body:   `{"kind": "theft", "quantity": 1}`,
+			want:   []string{"body kind"},
+		},
+		{
+			name:   "unknown visibility",
+			method: http.MethodPut,
+			target: "/products/1/visibility",
+			body:   `{"visibility": "archived", "version": 1}`,
+			want:   []string{"body visibility"},
+		},
+		{
+			name:   "unknown relation kind",
+			method: http.MethodPut,
+			target: "/products/1/relations",
+			body:   `{"relations": [{"related_id": 2, "kind": "upsell"}]}`,
+			want:   []string{"body relations.0.kind"},
+		},
+		{
+			name:   "review rating out of range",
+			method: http.MethodPost,
+			target: "/products/1/reviews",
+			body:   `{"author_name": "Ирина", "rating": 6, "text": "Задники держат форму"}`,
+			want:   []string{"body rating"},
+		},
+		{
+			name:   "moderation back to pending",
+			method: http.MethodPut,
+			target: "/reviews/1/moderation",
+			body:   `{"status": "pending"}`,
+			want:   []string{"body status"},
+		},
+		{
+			name:   "malformed json",
+			method: http.MethodPost,
+			target: "/categories",
+			body:   `{"name":`,
+			want:   []string{"body "},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-API-KEY", testAPIKey)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+			var resp validationErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			var got []string
+			for _, detail := range resp.Details {
+				assert.NotEmpty(t, detail.Message)
+				got = append(got, detail.In+" "+detail.Field)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+
+	t.Run("spec is served without API key", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+		assert.Equal(t, "3.0.3", doc["openapi"])
+	})
+
+	t.Run("API key is checked before validation", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/products/abc", nil))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+// silentEvents - события саг товара, которые в тестах обработчиков никуда не уходят
+type silentEvents struct{}
+
+func (silentEvents) NotifyCreated(ctx context.Context, productID int32) {}
+func (silentEvents) NotifyDeleted(ctx context.Context, productID int32) {}
+func (silentEvents) DeleteWithImages(ctx context.Context, product *domain.Product) error {
+	return nil
+}
+
+// stubProducts отвечает товаром product или ошибкой err на все вызовы, которые нужны обработчикам ниже
+type stubProducts struct {
+	usecase.ProductUseCase
+	product *domain.Product
+	err     error
+}
+
+func (s *stubProducts) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	return &domain.Product{ID: id, Name: "Задник", Slug: "zadnik", Price: decimal.NewFromInt(120), Status: domain.ProductStatusActive, Version: 3}, nil
+}
+
+func (s *stubProducts) GetBySlug(ctx context.Context, slug string) (*domain.Product, error) {
+	return s.product, s.err
+}
+
+func (s *stubProducts) Create(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	return s.product, s.err
+}
+
+func (s *stubProducts) Update(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	return s.product, s.err
+}
+
+func (s *stubProducts) GetVariants(ctx context.Context, productID int32) ([]*domain.ProductVariant, error) {
+	return nil, s.err
+}
+
+func (s *stubProducts) RestoreRevision(ctx context.Context, productID, revisionID, version int32) (*domain.Product, error) {
+	return s.product, s.err
+}
+
+// TestProductErrors проверяет, что доменные ошибки доходят до клиента JSON-ответом с нужным статусом
+func TestProductErrors(t *testing.T) {
+	created := &domain.Product{ID: 5, Name: "Задник", Slug: "zadnik", Price: decimal.NewFromInt(120), Version: 1}
+
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		body         string
+		products     *stubProducts
+		wantStatus   int
+		wantError    string
+		wantLocation string
+	}{
+		{
+			name:       "unknown slug",
+			method:     http.MethodGet,
+			target:     "/products/slug/net-takogo",
+			products:   &stubProducts{err: sql.ErrNoRows},
+			wantStatus: http.StatusNotFound,
+			wantError:  "Product not found",
+		},
+		{
+			name:       "variants of missing product",
+			method:     http.MethodGet,
+			target:     "/products/9/variants",
+			products:   &stubProducts{err: sql.ErrNoRows},
+			wantStatus: http.StatusNotFound,
+			wantError:  "Product not found",
+		},
+		{
+			name:       "stale version",
+			method:     http.MethodPatch,
+			target:     "/products/1",
+			body:       `{"name": "Задник 7780", "version": 2}`,
+			products:   &stubProducts{err: fmt.Errorf("product 1 version 2 is stale: %w", domain.ErrVersionConflict)},
+			wantStatus: http.StatusConflict,
+			wantError:  "Product was modified by another request",
+		},
+		{
+			name:       "stale version on restore",
+			method:     http.MethodPost,
+			target:     "/products/1/revisions/4/restore",
+			body:       `{"version": 2}`,
+			products:   &stubProducts{err: domain.ErrVersionConflict},
+			wantStatus: http.StatusConflict,
+			wantError:  "Product was modified by another request",
+		},
+		{
+			name:       "catalog rules",
+			method:     http.MethodPost,
+			target:     "/products",
+			body:       `{"name": "Задник", "price": "120", "tax_class": "reduced"}`,
+			products:   &stubProducts{err: fmt.Errorf("%w: \"reduced\"", domain.ErrTaxClassInvalid)},
+			wantStatus: http.StatusUnprocessableEntity,
+			wantError:  domain.ErrTaxClassInvalid.Error() + `: "reduced"`,
+		},
+		{
+			name:         "created",
+			method:       http.MethodPost,
+			target:       "/products",
+			body:         `{"name": "Задник", "price": "120"}`,
+			products:     &stubProducts{product: created},
+			wantStatus:   http.StatusCreated,
+			wantLocation: "/products/5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, router := newTestRouter(t, tt.products)
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-API-KEY", testAPIKey)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			assert.Equal(t, tt.wantLocation, rec.Header().Get("Location"))
+			if tt.wantError != "" {
+				var resp struct {
+					Error string `json:"error"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.Equal(t, tt.wantError, resp.Error)
+			}
+		})
+	}
+}
+
+// listedProducts запоминает запрос списка товаров и отвечает пустой страницей или ошибкой err
+type listedProducts struct {
+	usecase.ProductUseCase
+	queries []domain.ProductQuery
+	err     error
+}
+
+func (l *listedProducts) GetAll(ctx context.Context, query domain.ProductQuery) (*domain.ProductPage, error) {
+	l.queries = append(l.queries, query)
+	if l.err != nil {
+		return nil, l.err
+	}
+	return &domain.ProductPage{Items: []*domain.Product{}, Limit: 20}, nil
+}
+
+func TestProductStatusFilter(t *testing.T) {
+	tests := []struct {
+		name         string
+		target       string
+		err          error
+		wantStatus   int
+		wantStatuses []domain.ProductStatus
+	}{
+		{
+			name:       "all statuses by default",
+			target:     "/products",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "comma separated",
+			target:       "/products?status=active,pending",
+			wantStatus:   http.StatusOK,
+			wantStatuses: []domain.ProductStatus{domain.ProductStatusActive, domain.ProductStatusPending},
+		},
+		{
+			name:         "repeated parameter",
+			target:       "/products?status=active&status=+deleting+",
+			wantStatus:   http.StatusOK,
+			wantStatuses: []domain.ProductStatus{domain.ProductStatusActive, domain.ProductStatusDeleting},
+		},
+		{
+			name:         "unknown status",
+			target:       "/products?status=archived",
+			err:          fmt.Errorf("%w: %q", domain.ErrInvalidStatus, "archived"),
+			wantStatus:   http.StatusBadRequest,
+			wantStatuses: []domain.ProductStatus{"archived"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			products := &listedProducts{err: tt.err}
+			_, router := newTestRouter(t, products)
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			req.Header.Set("X-API-KEY", testAPIKey)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			require.Len(t, products.queries, 1)
+			assert.Equal(t, tt.wantStatuses, products.queries[0].Filter.Statuses)
+		})
+	}
+}
+
+// TestResponsesMatchSpec сверяет JSON доменных типов со схемами ответов: лишнее или пропавшее поле ломает тест
+func TestResponsesMatchSpec(t *testing.T) {
+	spec, _ := newTestRouter(t, nil)
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	variantID := int32(2)
+	userID := int64(7)
+	weight := int32(350)
+	pricing := &domain.Pricing{Gross: decimal.RequireFromString("120"), Net: decimal.RequireFromString("100"), VAT: decimal.RequireFromString("20"), VATRate: 20}
+	product := domain.Product{
+		ID: 1, Name: "Задник", Slug: "zadnik", Description: "Кожкартон", Price: decimal.RequireFromString("120"),
+		TaxClass: domain.TaxClassStandard, Pricing: pricing, ImageURL: sql.NullString{String: "/images/1.jpg", Valid: true},
+		Status: domain.ProductStatusActive, Visibility: domain.ProductVisibilityScheduled, PublishAt: &now, Version: 3, CreatedAt: now, UpdatedAt: now,
+		Variants: []*domain.ProductVariant{{
+			ID: variantID, ProductID: 1, SKU: "Z-38", Options: domain.VariantOptions{"size": "38"},
+			Price: decimal.NewNullDecimal(decimal.RequireFromString("130.50")), WeightGrams: &weight, IsActive: true,
+			CreatedAt: now, UpdatedAt: now, Pricing: pricing,
+		}},
+		Images:     []*domain.ProductImage{{ID: 1, ProductID: 1, URL: "/images/1.jpg", Alt: "Задник", IsPrimary: true, CreatedAt: now}},
+		Stock:      []*domain.StockItem{{ID: 1, ProductID: 1, VariantID: &variantID, OnHand: 10, Reserved: 2, LowStockThreshold: 3, UpdatedAt: now}},
+		PriceTiers: []*domain.PriceTier{{ID: 1, ProductID: 1, MinQuantity: 100, UnitPrice: decimal.RequireFromString("99.90"), CreatedAt: now, Pricing: pricing}},
+		Attributes: []*domain.ProductAttributeValue{{AttributeID: 1, Code: "thickness", Name: "Толщина", Type: domain.AttributeTypeNumber, Unit: "мм", Number: decimal.NewNullDecimal(decimal.RequireFromString("1.5"))}},
+		Rating:     &domain.ProductRating{ProductID: 1, Average: 4.5, Count: 2},
+	}
+	parentID := int32(1)
+	appliedAt := now.Add(time.Hour)
+	review := domain.Review{ID: 1, ProductID: 1, ProductName: "Задник", AuthorName: "Ирина", Company: "ООО Обувь", Rating: 5,
+		Text: "Задники держат форму", Status: domain.ReviewStatusApproved, Reply: "Спасибо!", RepliedAt: &now,
+		ModeratedBy: &userID, ModeratedAt: &now, AuthorKey: "f00d", CreatedAt: now}
+
+	samples := map[string]interface{}{
+		"Product":           product,
+		"ProductPage":       domain.ProductPage{Items: []*domain.Product{&product}, Total: 1, Limit: 20, Next: "/products?offset=20"},
+		"ProductSearchPage": domain.ProductSearchPage{Items: []*domain.ProductSearchResult{{Product: product, Rank: 0.5, NameHighlight: "Задник", Snippet: "кожкартон"}}, Total: 1},
+		"ProductRevision": domain.ProductRevision{ID: 1, ProductID: 1, UserID: &userID, Action: domain.RevisionActionUpdate,
+			Before: types.NullJSONText{JSONText: types.JSONText(`{"name":"Задник"}`), Valid: true}, CreatedAt: now},
+		"StockMovement": domain.StockMovement{ID: 1, StockItemID: 1, ProductID: 1, VariantID: &variantID, Kind: domain.StockMovementReservation,
+			Quantity: 2, OnHandAfter: 10, ReservedAfter: 2, Reference: "order-1", UserID: &userID, CreatedAt: now},
+		"PriceQuote": domain.PriceQuote{ProductID: 1, VariantID: &variantID, Quantity: 100, MinQuantity: 100,
+			UnitPrice: decimal.RequireFromString("99.90"), Total: decimal.RequireFromString("9990"), TotalVAT: decimal.RequireFromString("1665"), VATRate: 20},
+		"PriceChange": domain.PriceChange{ID: 1, ProductID: 1, Price: decimal.RequireFromString("150"), EffectiveAt: now,
+			Status: domain.PriceChangeApplied, UserID: &userID, CreatedAt: now, AppliedAt: &appliedAt},
+		"PriceHistoryEntry": domain.PriceHistoryEntry{ID: 1, ProductID: 1, Price: decimal.RequireFromString("150"), EffectiveFrom: now},
+		"Category": domain.Category{ID: 1, Name: "Задники", Slug: "zadniki", CreatedAt: now, UpdatedAt: now,
+			Children: []*domain.Category{{ID: 2, ParentID: &parentID, Name: "Детские", Slug: "detskie", CreatedAt: now, UpdatedAt: now}}},
+		"Attribute": domain.Attribute{ID: 1, Code: "color", Name: "Цвет", Type: domain.AttributeTypeEnum, Options: []string{"белый"},
+			CategoryIDs: []int32{1}, CreatedAt: now, UpdatedAt: now},
+		"ProductRelation": domain.ProductRelation{ProductID: 1, RelatedID: 2, Kind: domain.RelationKindAccessory, Position: 0, Name: "Стельки",
+			Slug: "stelki", Status: domain.ProductStatusActive, Visibility: domain.ProductVisibilityPublished},
+		"Recommendation": domain.Recommendation{Kind: domain.RelationKindRelated, Source: domain.RecommendationSourceSimilar, Product: &product},
+		"Review":         review,
+		"ReviewPage":     domain.ReviewPage{Items: []*domain.Review{&review}, Total: 1, Limit: 20},
+		"ImportReport": domain.ImportReport{Rows: []*domain.ImportRow{{Line: 2, Action: domain.ImportActionUpdate, ProductID: 1, Name: "Задник",
+			Slug: "zadnik", Changes: []string{"price: 100 → 120"}, Errors: []string{}, Images: []string{"1.jpg"}}}, Updated: 1},
+	}
+
+	for name, sample := range samples {
+		t.Run(name, func(t *testing.T) {
+			ref := spec.Components.Schemas[name]
+			require.NotNil(t, ref, "schema %s is missing", name)
+
+			data, err := json.Marshal(sample)
+			require.NoError(t, err)
+			var value interface{}
+			require.NoError(t, json.Unmarshal(data, &value))
+
+			assert.NoError(t, ref.Value.VisitJSON(value, openapi3.MultiErrors()))
+			assert.Empty(t, undeclaredFields(ref.Value, value, name))
+		})
+	}
+}
+
+// undeclaredFields возвращает поля JSON, которых нет в схеме; VisitJSON их пропускает, если не запрещены явно
+func undeclaredFields(schema *openapi3.Schema, value interface{}, path string) []string {
+	var fields []string
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if schema.Type == nil && len(schema.AllOf) == 0 {
+			// произвольный JSON, например снимки товара в истории
+			return nil
+		}
+		properties := map[string]*openapi3.Schema{}
+		collectProperties(schema, properties)
+		for key, item := range value {
+			property, ok := properties[key]
+			switch {
+			case ok:
+				fields = append(fields, undeclaredFields(property, item, path+"."+key)...)
+			case schema.AdditionalProperties.Schema == nil:
+				fields = append(fields, path+"."+key)
+			}
+		}
+	case []interface{}:
+		if schema.Items != nil {
+			for _, item := range value {
+				fields = append(fields, undeclaredFields(schema.Items.Value, item, path+"[]")...)
+			}
+		}
+	}
+	return fields
+}
+
+func collectProperties(schema *openapi3.Schema, properties map[string]*openapi3.Schema) {
+	for name, property := range schema.Properties {
+		properties[name] = property.Value
+	}
+	for _, ref := range schema.AllOf {
+		collectProperties(ref.Value, properties)
+	}
+}
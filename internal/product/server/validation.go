@@ -0,0 +1,128 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gorilla/mux"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+)
+
+// unsupportedProperty - лишнее поле тела: kin-openapi указывает на объект, а имя поля есть только в тексте причины
+var unsupportedProperty = regexp.MustCompile(`^property (".*") is unsupported$`)
+
+type validationDetail struct {
+	In      string `json:"in"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+type validationErrorResponse struct {
+	Error   string             `json:"error"`
+	Details []validationDetail `json:"details"`
+}
+
+// validateRequests проверяет параметры и тело запроса по спецификации до обработчика. Маршрут уже выбран mux,
+// поэтому операция ищется по шаблону пути. Форму импорта проверяет обработчик: файл читается с ограничением размера
+func validateRequests(doc *openapi3.T, logger common.Logger) mux.MiddlewareFunc {
+	options := &openapi3filter.Options{
+		MultiError: true,
+		// ключ уже проверил AuthMiddleware
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+	withoutBody := *options
+	withoutBody.ExcludeRequestBody = true
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			template, _ := mux.CurrentRoute(r).GetPathTemplate()
+			pathItem := doc.Paths.Value(template)
+			var operation *openapi3.Operation
+			if pathItem != nil {
+				operation = pathItem.GetOperation(r.Method)
+			}
+			if operation == nil {
+				logger.Warnf("Route %s %s is missing in OpenAPI spec", r.Method, template)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: mux.Vars(r),
+				Route: &routers.Route{
+					Spec:      doc,
+					Path:      template,
+					PathItem:  pathItem,
+					Method:    r.Method,
+					Operation: operation,
+				},
+				Options: options,
+			}
+			if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+				input.Options = &withoutBody
+			}
+
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				// текст ошибки kin-openapi содержит всю схему, поэтому в журнал идут только поля
+				details := validationDetails(err, "request", nil, nil)
+				logger.Warnf("Rejected invalid request %s %s: %+v", r.Method, r.URL.Path, details)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(validationErrorResponse{
+					Error:   "Request does not match API specification",
+					Details: details,
+				})
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// validationDetails раскладывает ошибки kin-openapi по полям: параметр по имени, поле тела - путём через точку
+func validationDetails(err error, in string, path []string, details []validationDetail) []validationDetail {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, item := range e {
+			details = validationDetails(item, in, path, details)
+		}
+		return details
+	case *openapi3filter.RequestError:
+		switch {
+		case e.Parameter != nil:
+			in, path = e.Parameter.In, []string{e.Parameter.Name}
+		case e.RequestBody != nil:
+			in = "body"
+		}
+		if e.Err == nil {
+			return append(details, validationDetail{In: in, Field: strings.Join(path, "."), Message: e.Reason})
+		}
+		return validationDetails(e.Err, in, path, details)
+	case *openapi3.SchemaError:
+		if in == "body" {
+			path = append(append([]string{}, path...), e.JSONPointer()...)
+		}
+		// allOf и nullable-ссылки прячут настоящую причину во вложенной ошибке
+		if e.Origin != nil {
+			return validationDetails(e.Origin, in, path, details)
+		}
+		if match := unsupportedProperty.FindStringSubmatch(e.Reason); match != nil {
+			if name, err := strconv.Unquote(match[1]); err == nil {
+				path = append(path, name)
+			}
+		}
+		return append(details, validationDetail{In: in, Field: strings.Join(path, "."), Message: e.Reason})
+	case *openapi3filter.ParseError:
+		return append(details, validationDetail{In: in, Field: strings.Join(path, "."), Message: e.Error()})
+	default:
+		return append(details, validationDetail{In: in, Field: strings.Join(path, "."), Message: err.Error()})
+	}
+}
@@ -0,0 +1,65 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// utf8BOM нужен Excel, чтобы открыть CSV в UTF-8, а не в cp1251
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// csvSeparator - разделитель, который русский Excel использует по умолчанию
+const csvSeparator = ';'
+
+func readCSV(data []byte) ([]Row, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectSeparator(data)
+	reader.FieldsPerRecord = -1
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+		if isEmptyRow(record) {
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, Row{Line: line, Cells: record})
+	}
+	return rows, nil
+}
+
+// detectSeparator выбирает между точкой с запятой и запятой по первой строке файла
+func detectSeparator(data []byte) rune {
+	header := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		header = data[:i]
+	}
+	if bytes.Count(header, []byte{','}) > bytes.Count(header, []byte{';'}) {
+		return ','
+	}
+	return ';'
+}
+
+func writeCSV(w io.Writer, rows [][]string) error {
+	if _, err := w.Write(utf8BOM); err != nil {
+		return fmt.Errorf("failed to write csv: %w", err)
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Comma = csvSeparator
+	writer.UseCRLF = true
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write csv: %w", err)
+	}
+	return nil
+}
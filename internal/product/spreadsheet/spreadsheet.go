@@ -0,0 +1,77 @@
+// Package spreadsheet читает и пишет таблицы в CSV и XLSX без сторонних библиотек:
+// XLSX - это zip-архив с XML листов, из которого нужен только первый лист
+package spreadsheet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported spreadsheet format")
+
+// ParseFormat принимает формат или имя файла с расширением
+func ParseFormat(s string) (Format, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if i := strings.LastIndex(s, "."); i >= 0 {
+		s = s[i+1:]
+	}
+	switch Format(s) {
+	case FormatCSV, FormatXLSX:
+		return Format(s), nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnsupportedFormat, s)
+}
+
+// ContentType - MIME-тип файла для ответа
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Row - строка таблицы; Line - её номер в файле, начиная с 1, для сообщений об ошибках
+type Row struct {
+	Line  int
+	Cells []string
+}
+
+// Read читает первый лист таблицы; пустые строки пропускаются
+func Read(data []byte, format Format) ([]Row, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(data)
+	case FormatXLSX:
+		return readXLSX(data)
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+}
+
+// Write записывает строки в таблицу
+func Write(w io.Writer, format Format, rows [][]string) error {
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, rows)
+	case FormatXLSX:
+		return writeXLSX(w, rows)
+	}
+	return fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+}
+
+func isEmptyRow(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
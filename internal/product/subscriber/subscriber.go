@@ -7,6 +7,7 @@ import (
 
 	"github.com/Nzyazin/zadnik.store/internal/broker"
 	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
 	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
 )
 
@@ -37,6 +38,9 @@ func (s *Subscriber) Subscribe(ctx context.Context) error {
 	if err := s.subscribeToImageProcessed(ctx); err != nil {
 		return err
 	}
+	if err := s.subscribeToImageRemoved(ctx); err != nil {
+		return err
+	}
 	if err := s.subscribeToProductUpdate(ctx); err != nil {
 		return err
 	}
@@ -60,12 +64,39 @@ func (s *Subscriber) subscribeToImageProcessed(ctx context.Context) error {
 	return s.messageBroker.SubscribeToImageProcessed(ctx, func(event *broker.ProductImageEvent) error {
 		s.logger.Infof("Received image processed event for product %d with URL %s", event.ProductID, event.ImageURL)
 
-		if err := s.useCase.UpdateProductImage(ctx, event.ProductID, event.ImageURL); err != nil {
-			s.logger.Errorf("Failed to update product image: %v", err)
+		actorCtx := domain.ContextWithUserID(ctx, event.UserID)
+		image, err := s.useCase.AddImage(actorCtx, event.ProductID, event.ImageURL, event.Alt)
+		if err != nil {
+			s.logger.Errorf("Failed to add product image: %v", err)
 			return err
 		}
 
-		s.logger.Infof("Successfully updated image URL for product %d", event.ProductID)
+		s.logger.Infof("Successfully added image %d to product %d", image.ID, event.ProductID)
+		return nil
+	})
+}
+
+func (s *Subscriber) subscribeToImageRemoved(ctx context.Context) error {
+	return s.messageBroker.SubscribeToImageRemoved(ctx, broker.ImageExchange, broker.EventTypeImageRemoved, func(event *broker.ProductImageEvent) error {
+		s.logger.Infof("Received image removed event for image %d of product %d", event.ImageID, event.ProductID)
+
+		if event.Error != "" {
+			s.logger.Errorf("Image service failed to remove image %d: %s", event.ImageID, event.Error)
+			return nil
+		}
+
+		actorCtx := domain.ContextWithUserID(ctx, event.UserID)
+		err := s.useCase.RemoveImage(actorCtx, event.ProductID, event.ImageID)
+		if errors.Is(err, domain.ErrImageNotFound) {
+			s.logger.Warnf("Image %d of product %d is already removed", event.ImageID, event.ProductID)
+			return nil
+		}
+		if err != nil {
+			s.logger.Errorf("Failed to remove product image: %v", err)
+			return err
+		}
+
+		s.logger.Infof("Successfully removed image %d from product %d", event.ImageID, event.ProductID)
 		return nil
 	})
 }
@@ -103,20 +134,23 @@ func (s *Subscriber) subscribeToProductCreated(ctx context.Context, chImageProdu
 	return s.messageBroker.SubscribeToProductCreated(ctx, broker.ProductImageCreatingExchange, broker.EventTypeProductCreating, func(event *broker.ProductEvent) error {
 
 		s.logger.Infof("Received data product event")
+		actorCtx := domain.ContextWithUserID(ctx, event.UserID)
 
 		if event.ImageData == nil {
-			if err := s.useCase.CreateFromEvent(ctx, event); err != nil {
-				return fmt.Errorf("failed to begin create product: %d: %w", event.ProductID, err)
+			product, err := s.useCase.CreateFromEvent(actorCtx, event)
+			if err != nil {
+				return fmt.Errorf("failed to create product: %w", err)
 			}
 
 			completedEvent := &broker.ProductEvent{
 				EventType: broker.EventTypeProductCreatingCompleted,
-				ProductID: event.ProductID,
+				ProductID: product.ID,
+				RequestID: event.RequestID,
 			}
 			if err := s.messageBroker.PublishProduct(ctx, broker.ProductImageCreatingCompletedExchange, completedEvent); err != nil {
 				s.logger.Errorf("Failed to publish create completed event: %v", err)
 			}
-			s.logger.Infof("Successfully created product %d without image", event.ProductID)
+			s.logger.Infof("Successfully created product %d without image", product.ID)
 			return nil
 		} else {
 			product, err := s.useCase.BeginCreate(ctx, event)
@@ -132,13 +166,14 @@ func (s *Subscriber) subscribeToProductCreated(ctx context.Context, chImageProdu
 				return fmt.Errorf("failed to create image for product %d: %w", product.ID, result.err)
 			}
 
-			if err := s.useCase.CompleteCreate(ctx, product.ID, result.imageURL); err != nil {
+			if err := s.useCase.CompleteCreate(actorCtx, product.ID, result.imageURL, event.Name); err != nil {
 				return fmt.Errorf("failed to complete create product: %d: %w", product.ID, err)
 			}
 
 			completedEvent := &broker.ProductEvent{
 				EventType: broker.EventTypeProductCreatingCompleted,
 				ProductID: product.ID,
+				RequestID: event.RequestID,
 			}
 			if err := s.messageBroker.PublishProduct(ctx, broker.ProductImageCreatingCompletedExchange, completedEvent); err != nil {
 				s.logger.Errorf("Failed to publish create completed event: %v", err)
@@ -168,8 +203,23 @@ func (s *Subscriber) subscribeToProductUpdate(ctx context.Context) error {
 		if event.Description != "" {
 			product.Description = event.Description
 		}
+		if event.TaxClass != "" {
+			product.TaxClass = domain.TaxClass(event.TaxClass)
+		}
+		if event.RegenerateSlug {
+			product.Slug = ""
+		} else if event.Slug != "" {
+			product.Slug = event.Slug
+		}
+		product.Version = event.Version
+		product.Variants = usecase.VariantsFromEvent(event.Variants)
+		product.PriceTiers = usecase.PriceTiersFromEvent(event.PriceTiers)
 
-		_, err = s.useCase.Update(ctx, product)
+		_, err = s.useCase.Update(domain.ContextWithUserID(ctx, event.UserID), product)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			s.logger.Warnf("Rejected stale update for product %d: %v", event.ProductID, err)
+			return err
+		}
 		if err != nil {
 			s.logger.Errorf("Failed to update product: %v", err)
 			return err
@@ -186,12 +236,13 @@ func (s *Subscriber) subscribeToProductDelete(ctx context.Context, chImageProduc
 			return nil
 		}
 		s.logger.Infof("Started product deletion for product %d", event.ProductID)
+		actorCtx := domain.ContextWithUserID(ctx, event.UserID)
 
-		if event.ImageURL == "" {
+		if event.ImageURL == "" && len(event.ImageURLs) == 0 {
 			if err := s.useCase.BeginDelete(ctx, event.ProductID); err != nil {
 				return fmt.Errorf("failed to begin delete product: %d: %w", event.ProductID, err)
 			}
-			if err := s.useCase.CompleteDelete(ctx, event.ProductID); err != nil {
+			if err := s.useCase.CompleteDelete(actorCtx, event.ProductID); err != nil {
 				return fmt.Errorf("failed to complete delete product: %d: %w", event.ProductID, err)
 			}
 			s.logger.Infof("Successfully deleted product %d without image", event.ProductID)
@@ -210,7 +261,7 @@ func (s *Subscriber) subscribeToProductDelete(ctx context.Context, chImageProduc
 			return fmt.Errorf("failed to delete image for product %d: %w", event.ProductID, result.err)
 		}
 
-		if err := s.useCase.CompleteDelete(ctx, event.ProductID); err != nil {
+		if err := s.useCase.CompleteDelete(actorCtx, event.ProductID); err != nil {
 			return fmt.Errorf("failed to complete delete product: %d: %w", event.ProductID, err)
 		}
 
@@ -0,0 +1,116 @@
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Nzyazin/zadnik.store/internal/broker"
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/usecase"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// updateBroker запоминает обработчик события изменения товара
+type updateBroker struct {
+	broker.MessageBroker
+	handler func(*broker.ProductEvent) error
+}
+
+func (b *updateBroker) SubscribeToProductUpdate(ctx context.Context, handler func(*broker.ProductEvent) error) error {
+	b.handler = handler
+	return nil
+}
+
+// versionedProducts хранит один товар и отклоняет изменения с устаревшей версией
+type versionedProducts struct {
+	usecase.ProductUseCase
+	product *domain.Product
+	updated []*domain.Product
+}
+
+func (u *versionedProducts) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	product := *u.product
+	return &product, nil
+}
+
+func (u *versionedProducts) Update(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	if product.Version != u.product.Version {
+		return nil, fmt.Errorf("product %d version %d is stale: %w", product.ID, product.Version, domain.ErrVersionConflict)
+	}
+	u.updated = append(u.updated, product)
+	return product, nil
+}
+
+func TestSubscribeToProductUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int32
+		wantErr error
+		updated int
+	}{
+		{name: "current version", version: 3, updated: 1},
+		{name: "stale version", version: 2, wantErr: domain.ErrVersionConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			products := &versionedProducts{product: &domain.Product{ID: 1, Name: "Задник 7780", Price: decimal.NewFromInt(150), Version: 3}}
+			messageBroker := &updateBroker{}
+			s := NewSubscriber(products, messageBroker, common.NewSimpleLogger())
+			require.NoError(t, s.subscribeToProductUpdate(context.Background()))
+
+			err := messageBroker.handler(&broker.ProductEvent{ProductID: 1, Name: "Задник 7780 Люкс", Version: tt.version})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, products.updated, tt.updated)
+		})
+	}
+}
+
+// createBroker запоминает обработчик события создания товара и опубликованные события
+type createBroker struct {
+	broker.MessageBroker
+	handler   func(*broker.ProductEvent) error
+	published []*broker.ProductEvent
+}
+
+func (b *createBroker) SubscribeToProductCreated(ctx context.Context, exchange string, eventType broker.EventType, handler func(*broker.ProductEvent) error) error {
+	b.handler = handler
+	return nil
+}
+
+func (b *createBroker) PublishProduct(ctx context.Context, exchange string, event *broker.ProductEvent) error {
+	b.published = append(b.published, event)
+	return nil
+}
+
+type createdProducts struct {
+	usecase.ProductUseCase
+	id int32
+}
+
+func (u *createdProducts) CreateFromEvent(ctx context.Context, event *broker.ProductEvent) (*domain.Product, error) {
+	return &domain.Product{ID: u.id, Name: event.Name}, nil
+}
+
+func TestSubscribeToProductCreated(t *testing.T) {
+	messageBroker := &createBroker{}
+	s := NewSubscriber(&createdProducts{id: 42}, messageBroker, common.NewSimpleLogger())
+	require.NoError(t, s.subscribeToProductCreated(context.Background(), make(chan result)))
+
+	err := messageBroker.handler(&broker.ProductEvent{EventType: broker.EventTypeProductCreating, Name: "Задник 7780", RequestID: "request-1"})
+
+	require.NoError(t, err)
+	require.Len(t, messageBroker.published, 1)
+	assert.Equal(t, broker.EventTypeProductCreatingCompleted, messageBroker.published[0].EventType)
+	assert.Equal(t, int32(42), messageBroker.published[0].ProductID)
+	assert.Equal(t, "request-1", messageBroker.published[0].RequestID)
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+type AttributeUseCase interface {
+	GetAll(ctx context.Context) ([]*domain.Attribute, error)
+	GetByID(ctx context.Context, id int32) (*domain.Attribute, error)
+	Create(ctx context.Context, attribute *domain.Attribute) (*domain.Attribute, error)
+	Update(ctx context.Context, attribute *domain.Attribute) (*domain.Attribute, error)
+	Delete(ctx context.Context, id int32) error
+	GetProductValues(ctx context.Context, productID int32) ([]*domain.ProductAttributeValue, error)
+	SetProductValues(ctx context.Context, productID int32, version *int32, values []*domain.ProductAttributeValue) ([]*domain.ProductAttributeValue, error)
+}
+
+type attributeUseCase struct {
+	repo       domain.AttributeRepository
+	categories domain.CategoryRepository
+	products   domain.ProductRepository
+	tx         domain.Transactor
+}
+
+func NewAttributeUseCase(repo domain.AttributeRepository, categories domain.CategoryRepository, products domain.ProductRepository, tx domain.Transactor) AttributeUseCase {
+	return &attributeUseCase{repo: repo, categories: categories, products: products, tx: tx}
+}
+
+// attributeCodePattern - код характеристики в параметрах фильтра: attr.<code>=...
+var attributeCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// maxAttributeTextLength ограничивает текстовое значение характеристики
+const maxAttributeTextLength = 500
+
+func (auc *attributeUseCase) GetAll(ctx context.Context) ([]*domain.Attribute, error) {
+	return auc.repo.GetAll(ctx)
+}
+
+func (auc *attributeUseCase) GetByID(ctx context.Context, id int32) (*domain.Attribute, error) {
+	return auc.repo.GetByID(ctx, id)
+}
+
+func (auc *attributeUseCase) Create(ctx context.Context, attribute *domain.Attribute) (*domain.Attribute, error) {
+	if err := auc.normalizeAttribute(ctx, attribute); err != nil {
+		return nil, err
+	}
+	return auc.repo.Create(ctx, attribute)
+}
+
+func (auc *attributeUseCase) Update(ctx context.Context, attribute *domain.Attribute) (*domain.Attribute, error) {
+	if err := auc.normalizeAttribute(ctx, attribute); err != nil {
+		return nil, err
+	}
+	return auc.repo.Update(ctx, attribute)
+}
+
+func (auc *attributeUseCase) Delete(ctx context.Context, id int32) error {
+	return auc.repo.Delete(ctx, id)
+}
+
+func (auc *attributeUseCase) GetProductValues(ctx context.Context, productID int32) ([]*domain.ProductAttributeValue, error) {
+	return auc.repo.GetValues(ctx, productID)
+}
+
+// SetProductValues заменяет значения характеристик товара; значения без текста пропускаются,
+// то есть очищенное в форме поле удаляет значение. С version запись проходит только по актуальной версии товара
+func (auc *attributeUseCase) SetProductValues(ctx context.Context, productID int32, version *int32, values []*domain.ProductAttributeValue) ([]*domain.ProductAttributeValue, error) {
+	if _, err := auc.products.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	attributes, err := auc.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int32]*domain.Attribute, len(attributes))
+	for _, attribute := range attributes {
+		byID[attribute.ID] = attribute
+	}
+
+	categories, err := auc.categories.GetByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	categoryIDs := make([]int32, len(categories))
+	for i, category := range categories {
+		categoryIDs[i] = category.ID
+	}
+
+	normalized := make([]*domain.ProductAttributeValue, 0, len(values))
+	seen := make(map[int32]bool, len(values))
+	for _, value := range values {
+		attribute, ok := byID[value.AttributeID]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown attribute %d", domain.ErrAttributeValueInvalid, value.AttributeID)
+		}
+		if seen[attribute.ID] {
+			return nil, fmt.Errorf("%w: duplicate attribute %q", domain.ErrAttributeValueInvalid, attribute.Code)
+		}
+		seen[attribute.ID] = true
+
+		if strings.TrimSpace(value.Text) == "" && !value.Number.Valid {
+			continue
+		}
+		if !attribute.AppliesTo(categoryIDs) {
+			return nil, fmt.Errorf("%w: attribute %q does not apply to product categories", domain.ErrAttributeValueInvalid, attribute.Code)
+		}
+		if err := normalizeAttributeValue(attribute, value); err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, value)
+	}
+
+	err = withProductVersion(ctx, auc.tx, auc.products, productID, version, func(ctx context.Context) error {
+		return auc.repo.ReplaceValues(ctx, productID, normalized)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return auc.repo.GetValues(ctx, productID)
+}
+
+// normalizeAttribute проверяет описание характеристики: единица измерения бывает только у чисел,
+// список значений - только у перечислений
+func (auc *attributeUseCase) normalizeAttribute(ctx context.Context, attribute *domain.Attribute) error {
+	attribute.Code = strings.ToLower(strings.TrimSpace(attribute.Code))
+	attribute.Name = strings.TrimSpace(attribute.Name)
+	attribute.Unit = strings.TrimSpace(attribute.Unit)
+
+	if !attributeCodePattern.MatchString(attribute.Code) {
+		return fmt.Errorf("%w: code must contain latin letters, digits and underscores", domain.ErrAttributeInvalid)
+	}
+	if attribute.Name == "" {
+		return fmt.Errorf("%w: name is required", domain.ErrAttributeInvalid)
+	}
+	if !attribute.Type.IsValid() {
+		return fmt.Errorf("%w: unknown type %q", domain.ErrAttributeInvalid, attribute.Type)
+	}
+
+	if attribute.Type != domain.AttributeTypeNumber {
+		attribute.Unit = ""
+	}
+
+	options := attribute.Options
+	attribute.Options = []string{}
+	if attribute.Type == domain.AttributeTypeEnum {
+		seen := make(map[string]bool, len(options))
+		for _, option := range options {
+			if option = strings.TrimSpace(option); option != "" && !seen[option] {
+				seen[option] = true
+				attribute.Options = append(attribute.Options, option)
+			}
+		}
+		if len(attribute.Options) == 0 {
+			return fmt.Errorf("%w: enum needs at least one option", domain.ErrAttributeInvalid)
+		}
+	}
+
+	for _, id := range attribute.CategoryIDs {
+		if _, err := auc.categories.GetByID(ctx, id); err != nil {
+			return fmt.Errorf("category %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// normalizeAttributeValue проверяет значение по типу характеристики и заполняет её описание для ответа.
+// Числа приходят текстом из формы: допускается десятичная запятая
+func normalizeAttributeValue(attribute *domain.Attribute, value *domain.ProductAttributeValue) error {
+	value.Code = attribute.Code
+	value.Name = attribute.Name
+	value.Type = attribute.Type
+	value.Unit = attribute.Unit
+	text := strings.TrimSpace(value.Text)
+
+	switch attribute.Type {
+	case domain.AttributeTypeNumber:
+		if !value.Number.Valid {
+			number, err := decimal.NewFromString(strings.Replace(text, ",", ".", 1))
+			if err != nil {
+				return fmt.Errorf("%w: %s must be a number", domain.ErrAttributeValueInvalid, attribute.Name)
+			}
+			value.Number = decimal.NewNullDecimal(number)
+		}
+		if value.Number.Decimal.IsNegative() {
+			return fmt.Errorf("%w: %s must not be negative", domain.ErrAttributeValueInvalid, attribute.Name)
+		}
+		value.Number.Decimal = value.Number.Decimal.Round(3)
+		value.Text = ""
+	case domain.AttributeTypeEnum:
+		for _, option := range attribute.Options {
+			if option == text {
+				value.Text = text
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: %q is not an option of %s", domain.ErrAttributeValueInvalid, text, attribute.Name)
+	default:
+		if len([]rune(text)) > maxAttributeTextLength {
+			return fmt.Errorf("%w: %s is longer than %d characters", domain.ErrAttributeValueInvalid, attribute.Name, maxAttributeTextLength)
+		}
+		value.Number = decimal.NullDecimal{}
+		value.Text = text
+	}
+	return nil
+}
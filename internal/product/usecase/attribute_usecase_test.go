@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+func TestNormalizeAttributeValue(t *testing.T) {
+	thickness := &domain.Attribute{Code: "thickness", Name: "Толщина", Type: domain.AttributeTypeNumber, Unit: "мм"}
+	material := &domain.Attribute{Code: "material", Name: "Материал", Type: domain.AttributeTypeEnum, Options: []string{"кожкартон", "термопласт"}}
+
+	t.Run("number accepts decimal comma", func(t *testing.T) {
+		value := &domain.ProductAttributeValue{Text: " 1,5 "}
+
+		assert.NoError(t, normalizeAttributeValue(thickness, value))
+		assert.True(t, value.Number.Valid)
+		assert.Equal(t, "1.5", value.Number.Decimal.String())
+		assert.Equal(t, "", value.Text)
+		assert.Equal(t, "мм", value.Unit)
+	})
+
+	t.Run("number rejects text and negative values", func(t *testing.T) {
+		assert.ErrorIs(t, normalizeAttributeValue(thickness, &domain.ProductAttributeValue{Text: "толстый"}), domain.ErrAttributeValueInvalid)
+		assert.ErrorIs(t, normalizeAttributeValue(thickness, &domain.ProductAttributeValue{Number: decimal.NewNullDecimal(decimal.NewFromInt(-1))}), domain.ErrAttributeValueInvalid)
+	})
+
+	t.Run("enum value must be an option", func(t *testing.T) {
+		value := &domain.ProductAttributeValue{Text: "кожкартон"}
+
+		assert.NoError(t, normalizeAttributeValue(material, value))
+		assert.ErrorIs(t, normalizeAttributeValue(material, &domain.ProductAttributeValue{Text: "картон"}), domain.ErrAttributeValueInvalid)
+	})
+}
+
+func TestNormalizeAttribute(t *testing.T) {
+	auc := &attributeUseCase{}
+
+	t.Run("unit and options are kept only for their types", func(t *testing.T) {
+		attribute := &domain.Attribute{Code: " Shoe_Type ", Name: "Тип обуви", Type: domain.AttributeTypeEnum, Unit: "мм", Options: []string{"туфли", " ", "туфли", "сапоги"}}
+
+		assert.NoError(t, auc.normalizeAttribute(context.Background(), attribute))
+		assert.Equal(t, "shoe_type", attribute.Code)
+		assert.Equal(t, "", attribute.Unit)
+		assert.Equal(t, []string{"туфли", "сапоги"}, []string(attribute.Options))
+	})
+
+	t.Run("invalid definitions are rejected", func(t *testing.T) {
+		cases := map[string]*domain.Attribute{
+			"code":         {Code: "тип", Name: "Тип", Type: domain.AttributeTypeText},
+			"type":         {Code: "height", Name: "Высота", Type: "date"},
+			"enum options": {Code: "material", Name: "Материал", Type: domain.AttributeTypeEnum},
+		}
+		for name, attribute := range cases {
+			assert.ErrorIs(t, auc.normalizeAttribute(context.Background(), attribute), domain.ErrAttributeInvalid, name)
+		}
+	})
+}
+
+func TestValidateAttributeFilter(t *testing.T) {
+	min, max := decimal.NewFromInt(3), decimal.NewFromInt(2)
+
+	assert.NoError(t, validateFilter(domain.ProductFilter{Attributes: []domain.AttributeFilter{{Code: "material", Values: []string{"кожкартон"}}}}))
+	assert.ErrorIs(t, validateFilter(domain.ProductFilter{Attributes: []domain.AttributeFilter{{Code: "material"}}}), domain.ErrInvalidQuery)
+	assert.ErrorIs(t, validateFilter(domain.ProductFilter{Attributes: []domain.AttributeFilter{{Code: "thickness", Min: &min, Max: &max}}}), domain.ErrInvalidQuery)
+}
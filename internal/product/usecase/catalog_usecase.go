@@ -0,0 +1,717 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/spreadsheet"
+)
+
+// Столбцы файла каталога; характеристики идут отдельными столбцами attr.<code>
+const (
+	catalogColumnID          = "id"
+	catalogColumnSlug        = "slug"
+	catalogColumnName        = "name"
+	catalogColumnDescription = "description"
+	catalogColumnPrice       = "price"
+	catalogColumnTaxClass    = "tax_class"
+	catalogColumnCategories  = "categories"
+	catalogColumnImages      = "images"
+	catalogAttributePrefix   = "attr."
+)
+
+var catalogColumns = []string{
+	catalogColumnID,
+	catalogColumnSlug,
+	catalogColumnName,
+	catalogColumnDescription,
+	catalogColumnPrice,
+	catalogColumnTaxClass,
+	catalogColumnCategories,
+	catalogColumnImages,
+}
+
+// catalogListSeparator разделяет адреса разделов и изображения в одной ячейке
+const catalogListSeparator = ","
+
+type CatalogUseCase interface {
+	// Import проверяет файл каталога и, если apply и ошибок нет, применяет его.
+	// imageNames - файлы в архиве изображений; nil - архив не загружен
+	Import(ctx context.Context, file []byte, format spreadsheet.Format, imageNames []string, apply bool) (*domain.ImportReport, error)
+	// Export пишет активные товары в том же формате, в каком их принимает Import
+	Export(ctx context.Context, w io.Writer, format spreadsheet.Format) error
+}
+
+type catalogUseCase struct {
+	products   ProductUseCase
+	categories CategoryUseCase
+	attributes AttributeUseCase
+}
+
+func NewCatalogUseCase(products ProductUseCase, categories CategoryUseCase, attributes AttributeUseCase) CatalogUseCase {
+	return &catalogUseCase{products: products, categories: categories, attributes: attributes}
+}
+
+// catalogHeader - номера столбцов файла по полям товара и характеристикам
+type catalogHeader struct {
+	columns    map[string]int
+	attributes map[int]*domain.Attribute
+}
+
+// cell возвращает значение поля строки; ok == false - такого столбца в файле нет
+func (h *catalogHeader) cell(row spreadsheet.Row, column string) (string, bool) {
+	index, ok := h.columns[column]
+	if !ok {
+		return "", false
+	}
+	if index >= len(row.Cells) {
+		return "", true
+	}
+	return strings.TrimSpace(row.Cells[index]), true
+}
+
+// importItem - проверенная строка файла и изменения, которые она вносит
+type importItem struct {
+	row     *domain.ImportRow
+	product *domain.Product
+	// fieldsChanged - изменились поля самого товара, а не только разделы или характеристики
+	fieldsChanged bool
+	// categoryIDs == nil - разделы не меняются
+	categoryIDs []int32
+	// attributes == nil - характеристики не меняются, иначе это полный новый набор значений
+	attributes []*domain.ProductAttributeValue
+}
+
+// catalogReference - справочники, нужные для проверки строк
+type catalogReference struct {
+	attributes     []*domain.Attribute
+	categoryBySlug map[string]*domain.Category
+	categoryByID   map[int32]*domain.Category
+	images         map[string]bool
+	hasArchive     bool
+}
+
+func (cuc *catalogUseCase) Import(ctx context.Context, file []byte, format spreadsheet.Format, imageNames []string, apply bool) (*domain.ImportReport, error) {
+	rows, err := spreadsheet.Read(file, format)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrImportFormat, err)
+	}
+	if len(rows) < 2 {
+		return nil, fmt.Errorf("%w: file has no products", domain.ErrImportFormat)
+	}
+
+	ref, err := cuc.loadReference(ctx, imageNames)
+	if err != nil {
+		return nil, err
+	}
+	header, err := parseCatalogHeader(rows[0].Cells, ref.attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.ImportReport{}
+	items := make([]*importItem, 0, len(rows)-1)
+	seen := make(map[int32]int, len(rows))
+	for _, row := range rows[1:] {
+		item, err := cuc.planRow(ctx, header, ref, row)
+		if err != nil {
+			return nil, err
+		}
+		if id := item.product.ID; id != 0 {
+			if line, ok := seen[id]; ok {
+				item.fail(fmt.Sprintf("product %d is already changed by line %d", id, line))
+			}
+			seen[id] = row.Line
+		}
+		items = append(items, item)
+		report.Rows = append(report.Rows, item.row)
+	}
+	report.Count()
+
+	if !apply {
+		return report, nil
+	}
+	if report.Failed > 0 {
+		return report, domain.ErrImportInvalid
+	}
+
+	for _, item := range items {
+		cuc.applyItem(ctx, item)
+	}
+	report.Applied = true
+	report.Count()
+	return report, nil
+}
+
+func (cuc *catalogUseCase) loadReference(ctx context.Context, imageNames []string) (*catalogReference, error) {
+	attributes, err := cuc.attributes.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := cuc.categories.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ref := &catalogReference{
+		attributes:     attributes,
+		categoryBySlug: make(map[string]*domain.Category, len(categories)),
+		categoryByID:   make(map[int32]*domain.Category, len(categories)),
+		images:         make(map[string]bool, len(imageNames)),
+		hasArchive:     imageNames != nil,
+	}
+	for _, category := range categories {
+		ref.categoryBySlug[category.Slug] = category
+		ref.categoryByID[category.ID] = category
+	}
+	for _, name := range imageNames {
+		ref.images[name] = true
+	}
+	return ref, nil
+}
+
+// parseCatalogHeader разбирает первую строку файла; неизвестный столбец - ошибка формата,
+// чтобы опечатка в заголовке не превратилась в молча пропущенные данные
+func parseCatalogHeader(cells []string, attributes []*domain.Attribute) (*catalogHeader, error) {
+	byCode := make(map[string]*domain.Attribute, len(attributes))
+	for _, attribute := range attributes {
+		byCode[attribute.Code] = attribute
+	}
+	known := make(map[string]bool, len(catalogColumns))
+	for _, column := range catalogColumns {
+		known[column] = true
+	}
+
+	header := &catalogHeader{columns: map[string]int{}, attributes: map[int]*domain.Attribute{}}
+	for i, cell := range cells {
+		column := strings.ToLower(strings.TrimSpace(cell))
+		if column == "" {
+			continue
+		}
+		if _, ok := header.columns[column]; ok {
+			return nil, fmt.Errorf("%w: duplicate column %q", domain.ErrImportFormat, column)
+		}
+
+		if code, ok := strings.CutPrefix(column, catalogAttributePrefix); ok {
+			attribute, ok := byCode[code]
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown attribute column %q", domain.ErrImportFormat, column)
+			}
+			header.attributes[i] = attribute
+		} else if !known[column] {
+			return nil, fmt.Errorf("%w: unknown column %q", domain.ErrImportFormat, column)
+		}
+		header.columns[column] = i
+	}
+
+	_, hasID := header.columns[catalogColumnID]
+	_, hasSlug := header.columns[catalogColumnSlug]
+	_, hasName := header.columns[catalogColumnName]
+	if !hasID && !hasSlug && !hasName {
+		return nil, fmt.Errorf("%w: file needs an id, slug or name column", domain.ErrImportFormat)
+	}
+	return header, nil
+}
+
+func (item *importItem) fail(message string) {
+	item.row.Errors = append(item.row.Errors, message)
+	item.row.Action = domain.ImportActionError
+}
+
+func (item *importItem) change(field string, before, after interface{}) {
+	item.row.Changes = append(item.row.Changes, fmt.Sprintf("%s: %v → %v", field, before, after))
+}
+
+// planRow сопоставляет строку с товаром по id или адресу и собирает изменения.
+// Ошибки данных попадают в строку отчёта, возвращается только ошибка хранилища
+func (cuc *catalogUseCase) planRow(ctx context.Context, header *catalogHeader, ref *catalogReference, row spreadsheet.Row) (*importItem, error) {
+	item := &importItem{row: &domain.ImportRow{Line: row.Line}}
+	item.row.Name, _ = header.cell(row, catalogColumnName)
+
+	current, matchedBySlug, err := cuc.findProduct(ctx, header, row, item)
+	if err != nil {
+		return nil, err
+	}
+	if item.row.Action == domain.ImportActionError {
+		item.product = &domain.Product{}
+		return item, nil
+	}
+
+	product := &domain.Product{TaxClass: domain.TaxClassStandard}
+	if current != nil {
+		product = &domain.Product{
+			ID:          current.ID,
+			Name:        current.Name,
+			Slug:        current.Slug,
+			Description: current.Description,
+			Price:       current.Price,
+			TaxClass:    current.TaxClass,
+			Version:     current.Version,
+		}
+	}
+	item.product = product
+
+	if name, ok := header.cell(row, catalogColumnName); ok || current == nil {
+		if name == "" {
+			item.fail("name is required")
+		}
+		product.Name = name
+	}
+	item.row.Name = product.Name
+
+	if slug, ok := header.cell(row, catalogColumnSlug); ok && slug != "" && !matchedBySlug {
+		if common.GenerateSlug(slug) == "" {
+			item.fail(fmt.Sprintf("slug %q has no latin letters or digits", slug))
+		} else if current == nil || common.GenerateSlug(slug) != current.Slug {
+			product.Slug = common.GenerateSlug(slug)
+		}
+	}
+	item.row.Slug = product.Slug
+
+	if description, ok := header.cell(row, catalogColumnDescription); ok {
+		product.Description = normalizeNewlines(description)
+	}
+
+	if value, ok := header.cell(row, catalogColumnPrice); ok || current == nil {
+		price, err := parseCatalogPrice(value)
+		if err != nil {
+			item.fail(err.Error())
+		} else {
+			product.Price = price
+		}
+	}
+
+	if value, ok := header.cell(row, catalogColumnTaxClass); ok && value != "" {
+		if class := domain.TaxClass(strings.ToLower(value)); class.IsValid() {
+			product.TaxClass = class
+		} else {
+			item.fail(fmt.Sprintf("unknown tax class %q", value))
+		}
+	}
+
+	currentCategories, err := cuc.currentCategoryIDs(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+	scope := currentCategories
+	if value, ok := header.cell(row, catalogColumnCategories); ok {
+		item.categoryIDs = []int32{}
+		for _, slug := range splitCatalogList(value) {
+			category, ok := ref.categoryBySlug[slug]
+			if !ok {
+				item.fail(fmt.Sprintf("unknown category %q", slug))
+				continue
+			}
+			if !slices.Contains(item.categoryIDs, category.ID) {
+				item.categoryIDs = append(item.categoryIDs, category.ID)
+			}
+		}
+		scope = item.categoryIDs
+	}
+
+	cuc.planAttributes(header, ref, row, item, current, scope)
+	cuc.planImages(header, ref, row, item)
+
+	if item.row.Action == domain.ImportActionError {
+		return item, nil
+	}
+	if current == nil {
+		item.row.Action = domain.ImportActionCreate
+		return item, nil
+	}
+
+	item.row.ProductID = current.ID
+	item.diff(current, currentCategories, ref)
+	if len(item.row.Changes) == 0 {
+		item.row.Action = domain.ImportActionSkip
+		item.categoryIDs, item.attributes = nil, nil
+	} else {
+		item.row.Action = domain.ImportActionUpdate
+	}
+	return item, nil
+}
+
+// findProduct ищет товар по id, а без него - по текущему или прежнему адресу;
+// строка без id и найденного адреса создаёт новый товар
+func (cuc *catalogUseCase) findProduct(ctx context.Context, header *catalogHeader, row spreadsheet.Row, item *importItem) (*domain.Product, bool, error) {
+	var (
+		product       *domain.Product
+		matchedBySlug bool
+		err           error
+	)
+	if value, _ := header.cell(row, catalogColumnID); value != "" {
+		id, parseErr := strconv.ParseInt(value, 10, 32)
+		if parseErr != nil || id <= 0 {
+			item.fail(fmt.Sprintf("invalid id %q", value))
+			return nil, false, nil
+		}
+		product, err = cuc.products.GetByID(ctx, int32(id))
+		if errors.Is(err, sql.ErrNoRows) {
+			item.fail(fmt.Sprintf("product %d not found", id))
+			return nil, false, nil
+		}
+	} else if value, _ := header.cell(row, catalogColumnSlug); value != "" {
+		product, err = cuc.products.GetBySlug(ctx, value)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		matchedBySlug = true
+	} else {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if product.Status != domain.ProductStatusActive {
+		item.fail(fmt.Sprintf("product %d is %s", product.ID, product.Status))
+		return nil, false, nil
+	}
+	return product, matchedBySlug, nil
+}
+
+func (cuc *catalogUseCase) currentCategoryIDs(ctx context.Context, product *domain.Product) ([]int32, error) {
+	if product == nil {
+		return nil, nil
+	}
+	categories, err := cuc.categories.GetByProduct(ctx, product.ID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int32, len(categories))
+	for i, category := range categories {
+		ids[i] = category.ID
+	}
+	return ids, nil
+}
+
+// planAttributes накладывает значения из столбцов характеристик на текущие значения товара.
+// Пустая ячейка удаляет значение. Если сменились разделы, проверяются и оставшиеся значения
+func (cuc *catalogUseCase) planAttributes(header *catalogHeader, ref *catalogReference, row spreadsheet.Row, item *importItem, current *domain.Product, scope []int32) {
+	values := map[int32]*domain.ProductAttributeValue{}
+	if current != nil {
+		for _, value := range current.Attributes {
+			values[value.AttributeID] = value
+		}
+	}
+
+	columns := make([]int, 0, len(header.attributes))
+	for index := range header.attributes {
+		columns = append(columns, index)
+	}
+	sort.Ints(columns)
+
+	changed := len(columns) > 0
+	for _, index := range columns {
+		attribute := header.attributes[index]
+		text := ""
+		if index < len(row.Cells) {
+			text = strings.TrimSpace(row.Cells[index])
+		}
+		if text == "" {
+			delete(values, attribute.ID)
+			continue
+		}
+
+		value := &domain.ProductAttributeValue{AttributeID: attribute.ID, Text: text}
+		if err := normalizeAttributeValue(attribute, value); err != nil {
+			item.fail(err.Error())
+			continue
+		}
+		values[attribute.ID] = value
+	}
+
+	if !changed && item.categoryIDs == nil {
+		return
+	}
+	for _, attribute := range ref.attributes {
+		if _, ok := values[attribute.ID]; ok && !attribute.AppliesTo(scope) {
+			item.fail(fmt.Sprintf("attribute %q does not apply to the product categories", attribute.Code))
+		}
+	}
+	if !changed {
+		return
+	}
+
+	item.attributes = make([]*domain.ProductAttributeValue, 0, len(values))
+	for _, attribute := range ref.attributes {
+		if value, ok := values[attribute.ID]; ok {
+			item.attributes = append(item.attributes, value)
+		}
+	}
+}
+
+// planImages отбирает файлы из архива; адреса уже загруженных изображений, как в экспорте, пропускаются
+func (cuc *catalogUseCase) planImages(header *catalogHeader, ref *catalogReference, row spreadsheet.Row, item *importItem) {
+	value, _ := header.cell(row, catalogColumnImages)
+	for _, name := range splitCatalogList(value) {
+		if strings.Contains(name, "/") {
+			continue
+		}
+		if !ref.hasArchive {
+			item.fail(fmt.Sprintf("image %q needs an image archive", name))
+			continue
+		}
+		if !ref.images[name] {
+			item.fail(fmt.Sprintf("image %q is not in the archive", name))
+			continue
+		}
+		if !slices.Contains(item.row.Images, name) {
+			item.row.Images = append(item.row.Images, name)
+		}
+	}
+}
+
+// diff описывает изменения существующего товара для предварительного просмотра
+func (item *importItem) diff(current *domain.Product, currentCategories []int32, ref *catalogReference) {
+	product := item.product
+	if product.Name != current.Name {
+		item.change(catalogColumnName, current.Name, product.Name)
+	}
+	if product.Slug != current.Slug {
+		item.change(catalogColumnSlug, current.Slug, product.Slug)
+	}
+	if normalizeNewlines(product.Description) != normalizeNewlines(current.Description) {
+		item.row.Changes = append(item.row.Changes, catalogColumnDescription+": changed")
+	}
+	if !product.Price.Equal(current.Price) {
+		item.change(catalogColumnPrice, current.Price.StringFixed(2), product.Price.StringFixed(2))
+	}
+	if product.TaxClass != current.TaxClass {
+		item.change(catalogColumnTaxClass, current.TaxClass, product.TaxClass)
+	}
+	item.fieldsChanged = len(item.row.Changes) > 0
+
+	if item.categoryIDs != nil && !sameInt32Set(item.categoryIDs, currentCategories) {
+		item.change(catalogColumnCategories, categorySlugs(currentCategories, ref), categorySlugs(item.categoryIDs, ref))
+	} else {
+		item.categoryIDs = nil
+	}
+
+	if item.attributes != nil {
+		before := make(map[int32]string, len(current.Attributes))
+		for _, value := range current.Attributes {
+			before[value.AttributeID] = attributeValueText(value)
+		}
+		after := make(map[int32]string, len(item.attributes))
+		for _, value := range item.attributes {
+			after[value.AttributeID] = attributeValueText(value)
+		}
+
+		changed := false
+		for _, attribute := range ref.attributes {
+			if before[attribute.ID] != after[attribute.ID] {
+				changed = true
+				item.change(catalogAttributePrefix+attribute.Code, emptyDash(before[attribute.ID]), emptyDash(after[attribute.ID]))
+			}
+		}
+		if !changed {
+			item.attributes = nil
+		}
+	}
+
+	if len(item.row.Images) > 0 {
+		item.row.Changes = append(item.row.Changes, fmt.Sprintf("%s: +%d", catalogColumnImages, len(item.row.Images)))
+	}
+}
+
+// applyItem применяет одну строку; ошибка не останавливает импорт остальных строк
+func (cuc *catalogUseCase) applyItem(ctx context.Context, item *importItem) {
+	var err error
+	switch item.row.Action {
+	case domain.ImportActionCreate:
+		err = cuc.applyCreate(ctx, item)
+	case domain.ImportActionUpdate:
+		err = cuc.applyUpdate(ctx, item)
+	default:
+		return
+	}
+	if err != nil {
+		item.fail(err.Error())
+	}
+}
+
+func (cuc *catalogUseCase) applyCreate(ctx context.Context, item *importItem) error {
+	created, err := cuc.products.Create(ctx, item.product)
+	if err != nil {
+		return err
+	}
+	item.row.ProductID = created.ID
+	item.row.Slug = created.Slug
+	return cuc.applyRelations(ctx, item, created.ID)
+}
+
+func (cuc *catalogUseCase) applyUpdate(ctx context.Context, item *importItem) error {
+	if item.fieldsChanged {
+		updated, err := cuc.products.Update(ctx, item.product)
+		if err != nil {
+			return err
+		}
+		item.row.Slug = updated.Slug
+	}
+	return cuc.applyRelations(ctx, item, item.product.ID)
+}
+
+func (cuc *catalogUseCase) applyRelations(ctx context.Context, item *importItem, productID int32) error {
+	if item.categoryIDs != nil {
+		if err := cuc.categories.SetProductCategories(ctx, productID, nil, item.categoryIDs); err != nil {
+			return err
+		}
+	}
+	if item.attributes != nil {
+		if _, err := cuc.attributes.SetProductValues(ctx, productID, nil, item.attributes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cuc *catalogUseCase) Export(ctx context.Context, w io.Writer, format spreadsheet.Format) error {
+	ref, err := cuc.loadReference(ctx, nil)
+	if err != nil {
+		return err
+	}
+	attributes := ref.attributes
+
+	header := append([]string{}, catalogColumns...)
+	for _, attribute := range attributes {
+		header = append(header, catalogAttributePrefix+attribute.Code)
+	}
+	rows := [][]string{header}
+
+	query := domain.ProductQuery{
+		Filter: domain.ProductFilter{Statuses: []domain.ProductStatus{domain.ProductStatusActive}},
+		Sort:   domain.ProductSortID,
+		Limit:  domain.MaxProductLimit,
+	}
+	for {
+		page, err := cuc.products.GetAll(ctx, query)
+		if err != nil {
+			return err
+		}
+		for _, product := range page.Items {
+			categoryIDs, err := cuc.currentCategoryIDs(ctx, product)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, exportRow(product, categorySlugs(categoryIDs, ref), attributes))
+		}
+
+		query.Offset += len(page.Items)
+		if len(page.Items) == 0 || query.Offset >= page.Total {
+			break
+		}
+	}
+
+	return spreadsheet.Write(w, format, rows)
+}
+
+func exportRow(product *domain.Product, categories string, attributes []*domain.Attribute) []string {
+	images := make([]string, 0, len(product.Images))
+	for _, image := range product.Images {
+		images = append(images, image.URL)
+	}
+	if len(images) == 0 && product.ImageURL.Valid {
+		images = append(images, product.ImageURL.String)
+	}
+
+	row := []string{
+		strconv.Itoa(int(product.ID)),
+		product.Slug,
+		product.Name,
+		product.Description,
+		product.Price.StringFixed(2),
+		string(product.TaxClass),
+		categories,
+		strings.Join(images, catalogListSeparator+" "),
+	}
+
+	values := make(map[int32]string, len(product.Attributes))
+	for _, value := range product.Attributes {
+		values[value.AttributeID] = attributeValueText(value)
+	}
+	for _, attribute := range attributes {
+		row = append(row, values[attribute.ID])
+	}
+	return row
+}
+
+// parseCatalogPrice принимает цену с точкой или запятой и не более чем двумя знаками после неё
+func parseCatalogPrice(value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, fmt.Errorf("price is required")
+	}
+	price, err := decimal.NewFromString(strings.ReplaceAll(strings.Replace(value, ",", ".", 1), " ", ""))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("price %q is not a number", value)
+	}
+	if !price.IsPositive() {
+		return decimal.Zero, fmt.Errorf("price must be positive")
+	}
+	if !price.Equal(price.Round(2)) {
+		return decimal.Zero, fmt.Errorf("price %q has more than 2 decimal places", value)
+	}
+	return price, nil
+}
+
+func attributeValueText(value *domain.ProductAttributeValue) string {
+	if value.Number.Valid {
+		return value.Number.Decimal.String()
+	}
+	return value.Text
+}
+
+func categorySlugs(ids []int32, ref *catalogReference) string {
+	slugs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if category, ok := ref.categoryByID[id]; ok {
+			slugs = append(slugs, category.Slug)
+		}
+	}
+	sort.Strings(slugs)
+	return strings.Join(slugs, catalogListSeparator+" ")
+}
+
+func splitCatalogList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, catalogListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func normalizeNewlines(s string) string {
+	return strings.ReplaceAll(s, "\r\n", "\n")
+}
+
+func emptyDash(s string) string {
+	if s == "" {
+		return "—"
+	}
+	return s
+}
+
+func sameInt32Set(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			return false
+		}
+	}
+	return true
+}
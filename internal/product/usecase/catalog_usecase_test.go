@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/Nzyazin/zadnik.store/internal/product/spreadsheet"
+)
+
+type catalogProducts struct {
+	ProductUseCase
+	items   map[int32]*domain.Product
+	created []*domain.Product
+	updated []*domain.Product
+}
+
+func (p *catalogProducts) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	if product, ok := p.items[id]; ok {
+		return product, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (p *catalogProducts) GetBySlug(ctx context.Context, slug string) (*domain.Product, error) {
+	for _, product := range p.items {
+		if product.Slug == slug {
+			return product, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (p *catalogProducts) GetAll(ctx context.Context, query domain.ProductQuery) (*domain.ProductPage, error) {
+	page := &domain.ProductPage{Total: len(p.items)}
+	for id := int32(1); int(id) <= len(p.items); id++ {
+		page.Items = append(page.Items, p.items[id])
+	}
+	return page, nil
+}
+
+func (p *catalogProducts) Create(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	p.created = append(p.created, product)
+	return &domain.Product{ID: 100, Slug: product.Slug}, nil
+}
+
+func (p *catalogProducts) Update(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	p.updated = append(p.updated, product)
+	return product, nil
+}
+
+type catalogCategories struct {
+	CategoryUseCase
+	byProduct map[int32][]*domain.Category
+	set       map[int32][]int32
+}
+
+func (c *catalogCategories) GetAll(ctx context.Context) ([]*domain.Category, error) {
+	return []*domain.Category{{ID: 1, Slug: "zadniki"}, {ID: 2, Slug: "stelki"}}, nil
+}
+
+func (c *catalogCategories) GetByProduct(ctx context.Context, productID int32) ([]*domain.Category, error) {
+	return c.byProduct[productID], nil
+}
+
+func (c *catalogCategories) SetProductCategories(ctx context.Context, productID int32, version *int32, categoryIDs []int32) error {
+	c.set[productID] = categoryIDs
+	return nil
+}
+
+type catalogAttributes struct {
+	AttributeUseCase
+	set map[int32][]*domain.ProductAttributeValue
+}
+
+func (a *catalogAttributes) GetAll(ctx context.Context) ([]*domain.Attribute, error) {
+	return []*domain.Attribute{
+		{ID: 1, Code: "thickness", Type: domain.AttributeTypeNumber, Unit: "мм", CategoryIDs: []int32{1}},
+	}, nil
+}
+
+func (a *catalogAttributes) SetProductValues(ctx context.Context, productID int32, version *int32, values []*domain.ProductAttributeValue) ([]*domain.ProductAttributeValue, error) {
+	a.set[productID] = values
+	return values, nil
+}
+
+func newCatalogFixture() (*catalogUseCase, *catalogProducts, *catalogCategories, *catalogAttributes) {
+	products := &catalogProducts{items: map[int32]*domain.Product{
+		1: {
+			ID: 1, Slug: "zadnik", Name: "Задник", Price: decimal.NewFromInt(150), TaxClass: domain.TaxClassStandard,
+			Status: domain.ProductStatusActive,
+			Attributes: []*domain.ProductAttributeValue{
+				{AttributeID: 1, Number: decimal.NewNullDecimal(decimal.RequireFromString("1.5"))},
+			},
+		},
+		2: {ID: 2, Slug: "stelka", Name: "Стелька", Price: decimal.NewFromInt(200), TaxClass: domain.TaxClassStandard, Status: domain.ProductStatusActive},
+	}}
+	categories := &catalogCategories{
+		byProduct: map[int32][]*domain.Category{1: {{ID: 1, Slug: "zadniki"}}},
+		set:       map[int32][]int32{},
+	}
+	attributes := &catalogAttributes{set: map[int32][]*domain.ProductAttributeValue{}}
+	return &catalogUseCase{products: products, categories: categories, attributes: attributes}, products, categories, attributes
+}
+
+func TestParseCatalogHeader(t *testing.T) {
+	attributes := []*domain.Attribute{{ID: 1, Code: "thickness"}}
+
+	t.Run("known columns and attributes", func(t *testing.T) {
+		header, err := parseCatalogHeader([]string{"ID", " name ", "", "attr.thickness"}, attributes)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"id": 0, "name": 1, "attr.thickness": 3}, header.columns)
+		assert.Equal(t, "thickness", header.attributes[3].Code)
+	})
+
+	t.Run("rejects unknown and duplicate columns", func(t *testing.T) {
+		_, err := parseCatalogHeader([]string{"name", "colour"}, attributes)
+		assert.ErrorIs(t, err, domain.ErrImportFormat)
+
+		_, err = parseCatalogHeader([]string{"name", "attr.colour"}, attributes)
+		assert.ErrorIs(t, err, domain.ErrImportFormat)
+
+		_, err = parseCatalogHeader([]string{"name", "Name"}, attributes)
+		assert.ErrorIs(t, err, domain.ErrImportFormat)
+	})
+
+	t.Run("needs a column to match products", func(t *testing.T) {
+		_, err := parseCatalogHeader([]string{"price"}, attributes)
+
+		assert.ErrorIs(t, err, domain.ErrImportFormat)
+	})
+}
+
+func TestParseCatalogPrice(t *testing.T) {
+	price, err := parseCatalogPrice("1 250,50")
+	assert.NoError(t, err)
+	assert.Equal(t, "1250.5", price.String())
+
+	for _, value := range []string{"", "abc", "0", "-10", "10.555"} {
+		_, err := parseCatalogPrice(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestCatalogImport(t *testing.T) {
+	file := []byte("id;slug;name;price;categories;attr.thickness;images\n" +
+		"1;;Задник;150;zadniki;1,5;\n" +
+		"2;;Стелька мягкая;200;;;\n" +
+		";novyy-zadnik;Новый задник;99,90;zadniki;2;photo.jpg\n" +
+		";;Без цены;;;;\n" +
+		"99;;Нет такого;10;;;\n")
+
+	t.Run("preview plans rows without changes", func(t *testing.T) {
+		cuc, products, _, _ := newCatalogFixture()
+
+		report, err := cuc.Import(context.Background(), file, spreadsheet.FormatCSV, []string{"photo.jpg"}, false)
+
+		require.NoError(t, err)
+		require.Len(t, report.Rows, 5)
+		assert.Equal(t, domain.ImportActionSkip, report.Rows[0].Action)
+		assert.Equal(t, domain.ImportActionUpdate, report.Rows[1].Action)
+		assert.Equal(t, []string{"name: Стелька → Стелька мягкая"}, report.Rows[1].Changes)
+		assert.Equal(t, domain.ImportActionCreate, report.Rows[2].Action)
+		assert.Equal(t, "novyy-zadnik", report.Rows[2].Slug)
+		assert.Equal(t, []string{"photo.jpg"}, report.Rows[2].Images)
+		assert.Equal(t, []string{"price is required"}, report.Rows[3].Errors)
+		assert.Equal(t, []string{"product 99 not found"}, report.Rows[4].Errors)
+		assert.Equal(t, 2, report.Failed)
+		assert.False(t, report.Applied)
+		assert.Empty(t, products.created)
+	})
+
+	t.Run("apply refuses a file with errors", func(t *testing.T) {
+		cuc, products, _, _ := newCatalogFixture()
+
+		report, err := cuc.Import(context.Background(), file, spreadsheet.FormatCSV, []string{"photo.jpg"}, true)
+
+		assert.ErrorIs(t, err, domain.ErrImportInvalid)
+		assert.False(t, report.Applied)
+		assert.Empty(t, products.created)
+		assert.Empty(t, products.updated)
+	})
+
+	t.Run("apply creates and updates products", func(t *testing.T) {
+		cuc, products, categories, attributes := newCatalogFixture()
+		valid := bytes.Join(bytes.Split(file, []byte("\n"))[:4], []byte("\n"))
+
+		report, err := cuc.Import(context.Background(), valid, spreadsheet.FormatCSV, []string{"photo.jpg"}, true)
+
+		require.NoError(t, err)
+		assert.True(t, report.Applied)
+		assert.Equal(t, 1, report.Created)
+		assert.Equal(t, 1, report.Updated)
+		assert.Equal(t, 1, report.Skipped)
+		require.Len(t, products.updated, 1)
+		assert.Equal(t, "Стелька мягкая", products.updated[0].Name)
+		require.Len(t, products.created, 1)
+		assert.Equal(t, "99.9", products.created[0].Price.String())
+		assert.Equal(t, int32(100), report.Rows[2].ProductID)
+		assert.Equal(t, []int32{1}, categories.set[100])
+		assert.Len(t, attributes.set[100], 1)
+		assert.NotContains(t, categories.set, int32(2))
+	})
+
+	t.Run("attribute outside category scope", func(t *testing.T) {
+		cuc, _, _, _ := newCatalogFixture()
+
+		report, err := cuc.Import(context.Background(), []byte("id;attr.thickness\n2;3\n"), spreadsheet.FormatCSV, nil, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ImportActionError, report.Rows[0].Action)
+	})
+}
+
+func TestCatalogExportRoundTrip(t *testing.T) {
+	cuc, _, _, _ := newCatalogFixture()
+
+	var buf bytes.Buffer
+	require.NoError(t, cuc.Export(context.Background(), &buf, spreadsheet.FormatXLSX))
+
+	report, err := cuc.Import(context.Background(), buf.Bytes(), spreadsheet.FormatXLSX, nil, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Skipped)
+	assert.Equal(t, 0, report.Created+report.Updated+report.Failed)
+}
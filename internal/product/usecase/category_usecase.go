@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+type CategoryUseCase interface {
+	GetAll(ctx context.Context) ([]*domain.Category, error)
+	GetTree(ctx context.Context) ([]*domain.Category, error)
+	GetByID(ctx context.Context, id int32) (*domain.Category, error)
+	GetBySlug(ctx context.Context, slug string) (*domain.Category, error)
+	Create(ctx context.Context, category *domain.Category) (*domain.Category, error)
+	Update(ctx context.Context, category *domain.Category) (*domain.Category, error)
+	Delete(ctx context.Context, id int32) error
+	GetByProduct(ctx context.Context, productID int32) ([]*domain.Category, error)
+	// SetProductCategories заменяет разделы товара; с version запись проходит только по актуальной версии товара
+	SetProductCategories(ctx context.Context, productID int32, version *int32, categoryIDs []int32) error
+}
+
+type categoryUseCase struct {
+	repo     domain.CategoryRepository
+	products domain.ProductRepository
+	tx       domain.Transactor
+}
+
+func NewCategoryUseCase(repo domain.CategoryRepository, products domain.ProductRepository, tx domain.Transactor) CategoryUseCase {
+	return &categoryUseCase{repo: repo, products: products, tx: tx}
+}
+
+var categorySlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+func (cuc *categoryUseCase) GetAll(ctx context.Context) ([]*domain.Category, error) {
+	return cuc.repo.GetAll(ctx)
+}
+
+func (cuc *categoryUseCase) GetTree(ctx context.Context) ([]*domain.Category, error) {
+	categories, err := cuc.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return buildCategoryTree(categories), nil
+}
+
+func (cuc *categoryUseCase) GetByID(ctx context.Context, id int32) (*domain.Category, error) {
+	return cuc.repo.GetByID(ctx, id)
+}
+
+func (cuc *categoryUseCase) GetBySlug(ctx context.Context, slug string) (*domain.Category, error) {
+	category, err := cuc.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := cuc.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, root := range buildCategoryTree(categories) {
+		if found := findCategory(root, category.ID); found != nil {
+			category.Children = found.Children
+			break
+		}
+	}
+	return category, nil
+}
+
+func (cuc *categoryUseCase) Create(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	if err := normalizeCategory(category); err != nil {
+		return nil, err
+	}
+	if category.ParentID != nil {
+		if _, err := cuc.repo.GetByID(ctx, *category.ParentID); err != nil {
+			return nil, fmt.Errorf("failed to get parent category: %w", err)
+		}
+	}
+	return cuc.repo.Create(ctx, category)
+}
+
+func (cuc *categoryUseCase) Update(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	if err := normalizeCategory(category); err != nil {
+		return nil, err
+	}
+	if category.ParentID != nil {
+		categories, err := cuc.repo.GetAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkCategoryParent(categories, category.ID, *category.ParentID); err != nil {
+			return nil, err
+		}
+	}
+	return cuc.repo.Update(ctx, category)
+}
+
+func (cuc *categoryUseCase) Delete(ctx context.Context, id int32) error {
+	children, err := cuc.repo.CountChildren(ctx, id)
+	if err != nil {
+		return err
+	}
+	if children > 0 {
+		return domain.ErrCategoryHasChildren
+	}
+	return cuc.repo.Delete(ctx, id)
+}
+
+func (cuc *categoryUseCase) GetByProduct(ctx context.Context, productID int32) ([]*domain.Category, error) {
+	return cuc.repo.GetByProduct(ctx, productID)
+}
+
+func (cuc *categoryUseCase) SetProductCategories(ctx context.Context, productID int32, version *int32, categoryIDs []int32) error {
+	if _, err := cuc.products.GetByID(ctx, productID); err != nil {
+		return fmt.Errorf("failed to get product: %w", err)
+	}
+	for _, id := range categoryIDs {
+		if _, err := cuc.repo.GetByID(ctx, id); err != nil {
+			return fmt.Errorf("category %d: %w", id, err)
+		}
+	}
+	return withProductVersion(ctx, cuc.tx, cuc.products, productID, version, func(ctx context.Context) error {
+		return cuc.repo.SetProductCategories(ctx, productID, categoryIDs)
+	})
+}
+
+// normalizeCategory убирает лишние пробелы и проверяет обязательные поля
+func normalizeCategory(category *domain.Category) error {
+	category.Name = strings.TrimSpace(category.Name)
+	category.Slug = strings.ToLower(strings.TrimSpace(category.Slug))
+	category.SEOTitle = strings.TrimSpace(category.SEOTitle)
+	category.SEODescription = strings.TrimSpace(category.SEODescription)
+
+	if category.Name == "" {
+		return fmt.Errorf("%w: name is required", domain.ErrCategoryInvalid)
+	}
+	if !categorySlugPattern.MatchString(category.Slug) {
+		return fmt.Errorf("%w: slug must contain only latin letters, digits and dashes", domain.ErrCategoryInvalid)
+	}
+	if category.ParentID != nil && *category.ParentID == category.ID && category.ID != 0 {
+		return domain.ErrCategoryCycle
+	}
+	return nil
+}
+
+// checkCategoryParent не даёт перенести раздел внутрь самого себя или своего потомка
+func checkCategoryParent(categories []*domain.Category, id, parentID int32) error {
+	parents := make(map[int32]*int32, len(categories))
+	for _, category := range categories {
+		parents[category.ID] = category.ParentID
+	}
+	if _, ok := parents[parentID]; !ok {
+		return fmt.Errorf("failed to get parent category: %w", domain.ErrCategoryNotFound)
+	}
+
+	current := &parentID
+	for steps := 0; current != nil && steps <= len(categories); steps++ {
+		if *current == id {
+			return domain.ErrCategoryCycle
+		}
+		current = parents[*current]
+	}
+	return nil
+}
+
+// buildCategoryTree раскладывает плоский список разделов по родителям, сохраняя порядок
+func buildCategoryTree(categories []*domain.Category) []*domain.Category {
+	byID := make(map[int32]*domain.Category, len(categories))
+	for _, category := range categories {
+		category.Children = nil
+		byID[category.ID] = category
+	}
+
+	roots := []*domain.Category{}
+	for _, category := range categories {
+		var parent *domain.Category
+		if category.ParentID != nil {
+			parent = byID[*category.ParentID]
+		}
+		if parent != nil {
+			parent.Children = append(parent.Children, category)
+		} else {
+			roots = append(roots, category)
+		}
+	}
+	return roots
+}
+
+func findCategory(root *domain.Category, id int32) *domain.Category {
+	if root.ID == id {
+		return root
+	}
+	for _, child := range root.Children {
+		if found := findCategory(child, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func TestBuildCategoryTree(t *testing.T) {
+	categories := []*domain.Category{
+		{ID: 1, Slug: "zadniki"},
+		{ID: 2, Slug: "stelki"},
+		{ID: 3, ParentID: int32Ptr(2), Slug: "polustelki"},
+		{ID: 4, ParentID: int32Ptr(99), Slug: "orphan"},
+	}
+
+	roots := buildCategoryTree(categories)
+
+	assert.Len(t, roots, 3)
+	assert.Equal(t, "stelki", roots[1].Slug)
+	assert.Len(t, roots[1].Children, 1)
+	assert.Equal(t, "polustelki", roots[1].Children[0].Slug)
+}
+
+func TestCheckCategoryParent(t *testing.T) {
+	categories := []*domain.Category{
+		{ID: 1},
+		{ID: 2, ParentID: int32Ptr(1)},
+		{ID: 3, ParentID: int32Ptr(2)},
+	}
+
+	t.Run("valid parent", func(t *testing.T) {
+		assert.NoError(t, checkCategoryParent(categories, 3, 1))
+	})
+
+	t.Run("descendant as parent", func(t *testing.T) {
+		assert.ErrorIs(t, checkCategoryParent(categories, 1, 3), domain.ErrCategoryCycle)
+	})
+
+	t.Run("unknown parent", func(t *testing.T) {
+		assert.ErrorIs(t, checkCategoryParent(categories, 1, 42), domain.ErrCategoryNotFound)
+	})
+}
+
+func TestNormalizeCategory(t *testing.T) {
+	t.Run("invalid slug", func(t *testing.T) {
+		err := normalizeCategory(&domain.Category{Name: "Задники", Slug: "задники"})
+
+		assert.ErrorIs(t, err, domain.ErrCategoryInvalid)
+	})
+
+	t.Run("trims fields", func(t *testing.T) {
+		category := &domain.Category{Name: " Стельки ", Slug: " Stelki "}
+
+		err := normalizeCategory(category)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Стельки", category.Name)
+		assert.Equal(t, "stelki", category.Slug)
+	})
+}
+
+// productCategories - разделы товаров в памяти
+type productCategories struct {
+	domain.CategoryRepository
+	byProduct map[int32][]int32
+}
+
+func (r *productCategories) GetByID(ctx context.Context, id int32) (*domain.Category, error) {
+	return &domain.Category{ID: id}, nil
+}
+
+func (r *productCategories) SetProductCategories(ctx context.Context, productID int32, categoryIDs []int32) error {
+	r.byProduct[productID] = categoryIDs
+	return nil
+}
+
+func TestSetProductCategories(t *testing.T) {
+	newUseCase := func() (*categoryUseCase, *productCategories) {
+		categories := &productCategories{byProduct: map[int32][]int32{1: {5}}}
+		products := &storedProducts{products: map[int32]*domain.Product{1: {ID: 1, Version: 3}}}
+		return &categoryUseCase{repo: categories, products: products, tx: inlineTransactor{}}, categories
+	}
+
+	t.Run("current version", func(t *testing.T) {
+		cuc, categories := newUseCase()
+
+		err := cuc.SetProductCategories(context.Background(), 1, int32Ptr(3), []int32{7})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int32{7}, categories.byProduct[1])
+	})
+
+	t.Run("stale version keeps categories", func(t *testing.T) {
+		cuc, categories := newUseCase()
+
+		err := cuc.SetProductCategories(context.Background(), 1, int32Ptr(2), []int32{7})
+
+		assert.ErrorIs(t, err, domain.ErrVersionConflict)
+		assert.Equal(t, []int32{5}, categories.byProduct[1])
+	})
+
+	t.Run("without version", func(t *testing.T) {
+		cuc, categories := newUseCase()
+
+		err := cuc.SetProductCategories(context.Background(), 1, nil, []int32{7})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int32{7}, categories.byProduct[1])
+	})
+}
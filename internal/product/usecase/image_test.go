@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeImages(t *testing.T) {
+	current := []*domain.ProductImage{{ID: 1}, {ID: 2}}
+
+	t.Run("trims alt", func(t *testing.T) {
+		images := []*domain.ProductImage{
+			{ID: 2, Alt: " задник 90-И ", Position: 0, IsPrimary: true},
+			{ID: 1, Position: 1},
+		}
+
+		assert.NoError(t, normalizeImages(current, images))
+		assert.Equal(t, "задник 90-И", images[0].Alt)
+	})
+
+	t.Run("unknown image", func(t *testing.T) {
+		images := []*domain.ProductImage{{ID: 1, IsPrimary: true}, {ID: 3}}
+
+		assert.ErrorIs(t, normalizeImages(current, images), domain.ErrImageNotFound)
+	})
+
+	t.Run("missing image", func(t *testing.T) {
+		images := []*domain.ProductImage{{ID: 1, IsPrimary: true}}
+
+		assert.ErrorIs(t, normalizeImages(current, images), domain.ErrImageInvalid)
+	})
+
+	t.Run("two primary images", func(t *testing.T) {
+		images := []*domain.ProductImage{{ID: 1, IsPrimary: true}, {ID: 2, IsPrimary: true}}
+
+		assert.ErrorIs(t, normalizeImages(current, images), domain.ErrImageInvalid)
+	})
+
+	t.Run("no primary image", func(t *testing.T) {
+		images := []*domain.ProductImage{{ID: 1}, {ID: 2}}
+
+		assert.ErrorIs(t, normalizeImages(current, images), domain.ErrImageInvalid)
+	})
+}
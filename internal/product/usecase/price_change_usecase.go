@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const maxPriceChangeCommentLength = 500
+
+type PriceChangeUseCase interface {
+	GetChanges(ctx context.Context, productID int32) ([]*domain.PriceChange, error)
+	Schedule(ctx context.Context, change *domain.PriceChange) (*domain.PriceChange, error)
+	Cancel(ctx context.Context, productID, changeID int32) (*domain.PriceChange, error)
+	GetHistory(ctx context.Context, productID int32) ([]*domain.PriceHistoryEntry, error)
+	PriceAt(ctx context.Context, productID int32, at time.Time) (*domain.PriceHistoryEntry, error)
+	// ApplyDue применяет наступившие изменения и возвращает их число
+	ApplyDue(ctx context.Context, now time.Time) (int, error)
+}
+
+type priceChangeUseCase struct {
+	repo      domain.PriceChangeRepository
+	products  domain.ProductRepository
+	revisions domain.ProductRevisionRepository
+	notifier  domain.PriceChangeNotifier
+	tx        domain.Transactor
+}
+
+func NewPriceChangeUseCase(repo domain.PriceChangeRepository, products domain.ProductRepository, revisions domain.ProductRevisionRepository, notifier domain.PriceChangeNotifier, tx domain.Transactor) PriceChangeUseCase {
+	return &priceChangeUseCase{repo: repo, products: products, revisions: revisions, notifier: notifier, tx: tx}
+}
+
+func (pcu *priceChangeUseCase) GetChanges(ctx context.Context, productID int32) ([]*domain.PriceChange, error) {
+	if _, err := pcu.products.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return pcu.repo.GetByProduct(ctx, productID)
+}
+
+func (pcu *priceChangeUseCase) Schedule(ctx context.Context, change *domain.PriceChange) (*domain.PriceChange, error) {
+	if err := normalizePriceChange(change, time.Now()); err != nil {
+		return nil, err
+	}
+
+	product, err := pcu.products.GetByID(ctx, change.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", change.ProductID, err)
+	}
+	if product.Status == domain.ProductStatusDeleted {
+		return nil, fmt.Errorf("%w: product %d is deleted", domain.ErrPriceChangeInvalid, change.ProductID)
+	}
+
+	change.UserID = domain.UserIDFromContext(ctx)
+	return pcu.repo.Create(ctx, change)
+}
+
+func (pcu *priceChangeUseCase) Cancel(ctx context.Context, productID, changeID int32) (*domain.PriceChange, error) {
+	return pcu.repo.Cancel(ctx, productID, changeID)
+}
+
+func (pcu *priceChangeUseCase) GetHistory(ctx context.Context, productID int32) ([]*domain.PriceHistoryEntry, error) {
+	if _, err := pcu.products.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return pcu.repo.GetHistory(ctx, productID)
+}
+
+func (pcu *priceChangeUseCase) PriceAt(ctx context.Context, productID int32, at time.Time) (*domain.PriceHistoryEntry, error) {
+	if _, err := pcu.products.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return pcu.repo.GetPriceAt(ctx, productID, at)
+}
+
+// ApplyDue вызывается планировщиком. Цены и ревизии пишутся одной транзакцией,
+// уведомления уходят только после её фиксации
+func (pcu *priceChangeUseCase) ApplyDue(ctx context.Context, now time.Time) (int, error) {
+	var applied []*domain.AppliedPriceChange
+	err := pcu.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if applied, err = pcu.repo.ApplyDue(ctx, now); err != nil {
+			return err
+		}
+
+		for _, change := range applied {
+			if change.Before.Price.Equal(change.After.Price) {
+				continue
+			}
+			if err := pcu.recordPriceChange(ctx, change); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, change := range applied {
+		if !change.Before.Price.Equal(change.After.Price) {
+			pcu.notifier.NotifyPriceChanged(ctx, change)
+		}
+	}
+	return len(applied), nil
+}
+
+func (pcu *priceChangeUseCase) recordPriceChange(ctx context.Context, applied *domain.AppliedPriceChange) error {
+	revision := &domain.ProductRevision{
+		ProductID: applied.Change.ProductID,
+		UserID:    applied.Change.UserID,
+		Action:    domain.RevisionActionPriceChange,
+	}
+
+	var err error
+	if revision.Before, err = snapshotOf(applied.Before); err != nil {
+		return err
+	}
+	if revision.After, err = snapshotOf(applied.After); err != nil {
+		return err
+	}
+
+	if err := pcu.revisions.Create(ctx, revision); err != nil {
+		return fmt.Errorf("failed to record price change revision for product %d: %w", applied.Change.ProductID, err)
+	}
+	return nil
+}
+
+// normalizePriceChange проверяет цену, дату вступления в силу и комментарий
+func normalizePriceChange(change *domain.PriceChange, now time.Time) error {
+	if !change.Price.IsPositive() {
+		return fmt.Errorf("%w: price must be positive", domain.ErrPriceChangeInvalid)
+	}
+	if !change.EffectiveAt.After(now) {
+		return fmt.Errorf("%w: effective date must be in the future", domain.ErrPriceChangeInvalid)
+	}
+
+	change.Price = change.Price.Round(2)
+	change.Comment = strings.TrimSpace(change.Comment)
+	if len([]rune(change.Comment)) > maxPriceChangeCommentLength {
+		return fmt.Errorf("%w: comment is longer than %d characters", domain.ErrPriceChangeInvalid, maxPriceChangeCommentLength)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+type dueRepository struct {
+	domain.PriceChangeRepository
+	applied []*domain.AppliedPriceChange
+}
+
+func (r *dueRepository) ApplyDue(ctx context.Context, now time.Time) ([]*domain.AppliedPriceChange, error) {
+	return r.applied, nil
+}
+
+type revisionRecorder struct {
+	domain.ProductRevisionRepository
+	revisions []*domain.ProductRevision
+}
+
+func (r *revisionRecorder) Create(ctx context.Context, revision *domain.ProductRevision) error {
+	r.revisions = append(r.revisions, revision)
+	return nil
+}
+
+// inlineTransactor выполняет fn без транзакции: репозитории в тестах живут в памяти
+type inlineTransactor struct{}
+
+func (inlineTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type priceNotifier struct {
+	notified []int32
+}
+
+func (n *priceNotifier) NotifyPriceChanged(ctx context.Context, applied *domain.AppliedPriceChange) {
+	n.notified = append(n.notified, applied.Change.ProductID)
+}
+
+func TestNormalizePriceChange(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	t.Run("rounds price and trims comment", func(t *testing.T) {
+		change := &domain.PriceChange{Price: decimal.RequireFromString("12.345"), EffectiveAt: now.Add(time.Hour), Comment: " сырьё "}
+
+		assert.NoError(t, normalizePriceChange(change, now))
+		assert.Equal(t, "12.35", change.Price.StringFixed(2))
+		assert.Equal(t, "сырьё", change.Comment)
+	})
+
+	t.Run("effective date in the past", func(t *testing.T) {
+		change := &domain.PriceChange{Price: decimal.NewFromInt(12), EffectiveAt: now}
+
+		assert.ErrorIs(t, normalizePriceChange(change, now), domain.ErrPriceChangeInvalid)
+	})
+
+	t.Run("zero price", func(t *testing.T) {
+		change := &domain.PriceChange{Price: decimal.Zero, EffectiveAt: now.Add(time.Hour)}
+
+		assert.ErrorIs(t, normalizePriceChange(change, now), domain.ErrPriceChangeInvalid)
+	})
+}
+
+func TestApplyDue(t *testing.T) {
+	userID := int64(7)
+	changed := &domain.AppliedPriceChange{
+		Change: &domain.PriceChange{ProductID: 1, UserID: &userID},
+		Before: &domain.Product{ID: 1, Price: decimal.NewFromInt(10)},
+		After:  &domain.Product{ID: 1, Price: decimal.NewFromInt(12)},
+	}
+	same := &domain.AppliedPriceChange{
+		Change: &domain.PriceChange{ProductID: 2},
+		Before: &domain.Product{ID: 2, Price: decimal.NewFromInt(10)},
+		After:  &domain.Product{ID: 2, Price: decimal.NewFromInt(10)},
+	}
+	revisions := &revisionRecorder{}
+	notifier := &priceNotifier{}
+	uc := NewPriceChangeUseCase(&dueRepository{applied: []*domain.AppliedPriceChange{changed, same}}, nil, revisions, notifier, inlineTransactor{})
+
+	applied, err := uc.ApplyDue(context.Background(), time.Now())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, applied)
+	assert.Equal(t, []int32{1}, notifier.notified)
+	if assert.Len(t, revisions.revisions, 1) {
+		assert.Equal(t, domain.RevisionActionPriceChange, revisions.revisions[0].Action)
+		assert.Equal(t, &userID, revisions.revisions[0].UserID)
+	}
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Nzyazin/zadnik.store/internal/broker"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const maxQuoteQuantity = 1000000
+
+func (puc *productUseCase) GetPriceTiers(ctx context.Context, productID int32) ([]*domain.PriceTier, error) {
+	if _, err := puc.repo.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return puc.tiers.GetByProduct(ctx, productID)
+}
+
+func (puc *productUseCase) SetPriceTiers(ctx context.Context, productID int32, tiers []*domain.PriceTier) ([]*domain.PriceTier, error) {
+	if err := normalizePriceTiers(tiers); err != nil {
+		return nil, err
+	}
+	if _, err := puc.repo.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return puc.tiers.ReplaceForProduct(ctx, productID, tiers)
+}
+
+// Quote считает стоимость партии. Оптовые ступени задаются на товар и действуют для вариантов без своей цены;
+// вариант со своей ценой всегда продаётся по ней
+func (puc *productUseCase) Quote(ctx context.Context, productID int32, variantID *int32, quantity int32) (*domain.PriceQuote, error) {
+	if quantity < 1 || quantity > maxQuoteQuantity {
+		return nil, fmt.Errorf("%w: quantity must be between 1 and %d", domain.ErrPriceTierInvalid, maxQuoteQuantity)
+	}
+
+	product, err := puc.repo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	if product.Status != domain.ProductStatusActive {
+		return nil, fmt.Errorf("product %d is %s: %w", productID, product.Status, sql.ErrNoRows)
+	}
+
+	quote := &domain.PriceQuote{
+		ProductID: productID,
+		VariantID: variantID,
+		Quantity:  quantity,
+		UnitPrice: product.Price,
+	}
+
+	if variantID != nil {
+		variants, err := puc.variants.GetByProduct(ctx, productID)
+		if err != nil {
+			return nil, err
+		}
+		var variant *domain.ProductVariant
+		for _, candidate := range variants {
+			if candidate.ID == *variantID && candidate.IsActive {
+				variant = candidate
+				break
+			}
+		}
+		if variant == nil {
+			return nil, fmt.Errorf("%w: variant %d is not sold for product %d", domain.ErrPriceTierInvalid, *variantID, productID)
+		}
+		if variant.Price.Valid {
+			quote.UnitPrice = variant.Price.Decimal
+			return puc.priceQuote(quote, product.TaxClass), nil
+		}
+	}
+
+	tiers, err := puc.tiers.GetByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if tier := domain.TierFor(tiers, quantity); tier != nil {
+		quote.MinQuantity = tier.MinQuantity
+		quote.UnitPrice = tier.UnitPrice
+	}
+	return puc.priceQuote(quote, product.TaxClass), nil
+}
+
+// priceQuote считает сумму партии и НДС в ней
+func (puc *productUseCase) priceQuote(quote *domain.PriceQuote, class domain.TaxClass) *domain.PriceQuote {
+	quote.Total = quote.UnitPrice.Mul(decimal.NewFromInt32(quote.Quantity))
+	pricing := puc.taxMode.Price(quote.Total, class)
+	quote.TotalVAT = pricing.VAT
+	quote.VATRate = pricing.VATRate
+	return quote
+}
+
+// normalizePriceTiers сортирует ступени по порогу и проверяет пороги и цены
+func normalizePriceTiers(tiers []*domain.PriceTier) error {
+	sort.SliceStable(tiers, func(i, j int) bool {
+		return tiers[i].MinQuantity < tiers[j].MinQuantity
+	})
+
+	for i, tier := range tiers {
+		if tier.MinQuantity < 1 {
+			return fmt.Errorf("%w: minimum quantity must be at least 1", domain.ErrPriceTierInvalid)
+		}
+		if !tier.UnitPrice.IsPositive() {
+			return fmt.Errorf("%w: price from %d pairs must be positive", domain.ErrPriceTierInvalid, tier.MinQuantity)
+		}
+		if i > 0 && tiers[i-1].MinQuantity == tier.MinQuantity {
+			return fmt.Errorf("%w: duplicate minimum quantity %d", domain.ErrPriceTierInvalid, tier.MinQuantity)
+		}
+		tier.UnitPrice = tier.UnitPrice.Round(2)
+	}
+	return nil
+}
+
+// PriceTiersFromEvent переводит ступени из события; nil означает, что оптовые цены не менялись
+func PriceTiersFromEvent(events []broker.ProductPriceTier) []*domain.PriceTier {
+	if events == nil {
+		return nil
+	}
+
+	tiers := make([]*domain.PriceTier, len(events))
+	for i, event := range events {
+		tiers[i] = &domain.PriceTier{
+			MinQuantity: event.MinQuantity,
+			UnitPrice:   event.UnitPrice,
+		}
+	}
+	return tiers
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePriceTiers(t *testing.T) {
+	t.Run("sorts by minimum quantity", func(t *testing.T) {
+		tiers := []*domain.PriceTier{
+			{MinQuantity: 5000, UnitPrice: decimal.RequireFromString("9.5")},
+			{MinQuantity: 1000, UnitPrice: decimal.RequireFromString("11.255")},
+		}
+
+		assert.NoError(t, normalizePriceTiers(tiers))
+		assert.Equal(t, int32(1000), tiers[0].MinQuantity)
+		assert.Equal(t, "11.26", tiers[0].UnitPrice.StringFixed(2))
+	})
+
+	t.Run("duplicate minimum quantity", func(t *testing.T) {
+		tiers := []*domain.PriceTier{
+			{MinQuantity: 1000, UnitPrice: decimal.NewFromInt(11)},
+			{MinQuantity: 1000, UnitPrice: decimal.NewFromInt(10)},
+		}
+
+		assert.ErrorIs(t, normalizePriceTiers(tiers), domain.ErrPriceTierInvalid)
+	})
+
+	t.Run("zero price", func(t *testing.T) {
+		tiers := []*domain.PriceTier{{MinQuantity: 1, UnitPrice: decimal.Zero}}
+
+		assert.ErrorIs(t, normalizePriceTiers(tiers), domain.ErrPriceTierInvalid)
+	})
+}
+
+func TestTierFor(t *testing.T) {
+	tiers := []*domain.PriceTier{
+		{MinQuantity: 1000, UnitPrice: decimal.NewFromInt(11)},
+		{MinQuantity: 5000, UnitPrice: decimal.NewFromInt(10)},
+	}
+
+	t.Run("below first tier", func(t *testing.T) {
+		assert.Nil(t, domain.TierFor(tiers, 999))
+	})
+
+	t.Run("on tier boundary", func(t *testing.T) {
+		assert.Equal(t, int32(5000), domain.TierFor(tiers, 5000).MinQuantity)
+	})
+
+	t.Run("between tiers", func(t *testing.T) {
+		assert.Equal(t, int32(1000), domain.TierFor(tiers, 4999).MinQuantity)
+	})
+}
@@ -0,0 +1,298 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/broker"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+// changeBuffer - сколько изменений может ждать отправки одному подписчику
+const changeBuffer = 64
+
+// ProductChanges раздаёт изменения товаров подписчикам WatchProducts и notifier. Подписчик, который не успевает
+// читать, отключается, а не задерживает запись товаров: его канал закрывается, и клиент подписывается заново
+type ProductChanges struct {
+	mu          sync.Mutex
+	subscribers map[*changeSubscriber]struct{}
+	// notifier получает каждое изменение, даже если подписчиков нет; nil - только подписчики
+	notifier domain.ProductChangeNotifier
+}
+
+type changeSubscriber struct {
+	// productIDs == nil - подписка на все товары
+	productIDs map[int32]bool
+	ch         chan domain.ProductChange
+}
+
+func NewProductChanges(notifier domain.ProductChangeNotifier) *ProductChanges {
+	return &ProductChanges{subscribers: make(map[*changeSubscriber]struct{}), notifier: notifier}
+}
+
+// Subscribe возвращает канал изменений товаров productIDs (пустой список - всех товаров) и функцию отписки
+func (c *ProductChanges) Subscribe(productIDs []int32) (<-chan domain.ProductChange, func()) {
+	sub := &changeSubscriber{ch: make(chan domain.ProductChange, changeBuffer)}
+	if len(productIDs) > 0 {
+		sub.productIDs = make(map[int32]bool, len(productIDs))
+		for _, id := range productIDs {
+			sub.productIDs[id] = true
+		}
+	}
+
+	c.mu.Lock()
+	c.subscribers[sub] = struct{}{}
+	c.mu.Unlock()
+
+	return sub.ch, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.remove(sub)
+	}
+}
+
+func (c *ProductChanges) Publish(change domain.ProductChange) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for sub := range c.subscribers {
+		if sub.productIDs != nil && !sub.productIDs[change.ProductID] {
+			continue
+		}
+		select {
+		case sub.ch <- change:
+		default:
+			c.remove(sub)
+		}
+	}
+}
+
+// watching сообщает, есть ли подписчики на товар; без них товар после изменения не перечитывается
+func (c *ProductChanges) watching(productID int32) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for sub := range c.subscribers {
+		if sub.productIDs == nil || sub.productIDs[productID] {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ProductChanges) remove(sub *changeSubscriber) {
+	if _, ok := c.subscribers[sub]; ok {
+		delete(c.subscribers, sub)
+		close(sub.ch)
+	}
+}
+
+// publishChange перечитывает товар целиком, чтобы подписчик получил варианты, остатки и цены с НДС
+func publishChange(ctx context.Context, changes *ProductChanges, products ProductUseCase, changeType domain.ProductChangeType, productID int32) {
+	if changes.notifier != nil {
+		changes.notifier.NotifyProductChanged(ctx, changeType, productID)
+	}
+	if !changes.watching(productID) {
+		return
+	}
+	change := domain.ProductChange{Type: changeType, ProductID: productID, ChangedAt: time.Now()}
+	if changeType != domain.ProductChangeDeleted {
+		if product, err := products.GetByID(ctx, productID); err == nil {
+			change.Product = product
+		}
+	}
+	changes.Publish(change)
+}
+
+type watchedProductUseCase struct {
+	ProductUseCase
+	changes *ProductChanges
+}
+
+// WatchProductUseCase публикует в changes успешные изменения товаров, сделанные через useCase.
+// Остатки меняются часто и в поток не попадают
+func WatchProductUseCase(useCase ProductUseCase, changes *ProductChanges) ProductUseCase {
+	return &watchedProductUseCase{ProductUseCase: useCase, changes: changes}
+}
+
+func (w *watchedProductUseCase) publish(ctx context.Context, changeType domain.ProductChangeType, productID int32, err error) {
+	if err == nil {
+		publishChange(ctx, w.changes, w.ProductUseCase, changeType, productID)
+	}
+}
+
+func (w *watchedProductUseCase) Create(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	created, err := w.ProductUseCase.Create(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+	w.publish(ctx, domain.ProductChangeCreated, created.ID, nil)
+	return created, nil
+}
+
+func (w *watchedProductUseCase) CreateFromEvent(ctx context.Context, event *broker.ProductEvent) (*domain.Product, error) {
+	return w.Create(ctx, productFromEvent(event))
+}
+
+// CompleteCreate - товар из саги создания становится активным; до этого подписчики его не видят
+func (w *watchedProductUseCase) CompleteCreate(ctx context.Context, productID int32, imageURL, alt string) error {
+	err := w.ProductUseCase.CompleteCreate(ctx, productID, imageURL, alt)
+	w.publish(ctx, domain.ProductChangeCreated, productID, err)
+	return err
+}
+
+func (w *watchedProductUseCase) Update(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	updated, err := w.ProductUseCase.Update(ctx, product)
+	w.publish(ctx, domain.ProductChangeUpdated, product.ID, err)
+	return updated, err
+}
+
+func (w *watchedProductUseCase) RestoreRevision(ctx context.Context, productID, revisionID, version int32) (*domain.Product, error) {
+	restored, err := w.ProductUseCase.RestoreRevision(ctx, productID, revisionID, version)
+	w.publish(ctx, domain.ProductChangeUpdated, productID, err)
+	return restored, err
+}
+
+func (w *watchedProductUseCase) SetVariants(ctx context.Context, productID int32, variants []*domain.ProductVariant) ([]*domain.ProductVariant, error) {
+	result, err := w.ProductUseCase.SetVariants(ctx, productID, variants)
+	w.publish(ctx, domain.ProductChangeUpdated, productID, err)
+	return result, err
+}
+
+func (w *watchedProductUseCase) AddImage(ctx context.Context, productID int32, url, alt string) (*domain.ProductImage, error) {
+	image, err := w.ProductUseCase.AddImage(ctx, productID, url, alt)
+	w.publish(ctx, domain.ProductChangeUpdated, productID, err)
+	return image, err
+}
+
+func (w *watchedProductUseCase) RemoveImage(ctx context.Context, productID, imageID int32) error {
+	err := w.ProductUseCase.RemoveImage(ctx, productID, imageID)
+	w.publish(ctx, domain.ProductChangeUpdated, productID, err)
+	return err
+}
+
+func (w *watchedProductUseCase) ArrangeImages(ctx context.Context, productID int32, images []*domain.ProductImage) ([]*domain.ProductImage, error) {
+	result, err := w.ProductUseCase.ArrangeImages(ctx, productID, images)
+	w.publish(ctx, domain.ProductChangeUpdated, productID, err)
+	return result, err
+}
+
+func (w *watchedProductUseCase) SetPriceTiers(ctx context.Context, productID int32, tiers []*domain.PriceTier) ([]*domain.PriceTier, error) {
+	result, err := w.ProductUseCase.SetPriceTiers(ctx, productID, tiers)
+	w.publish(ctx, domain.ProductChangeUpdated, productID, err)
+	return result, err
+}
+
+func (w *watchedProductUseCase) SetVisibility(ctx context.Context, change *domain.VisibilityChange) (*domain.Product, error) {
+	product, err := w.ProductUseCase.SetVisibility(ctx, change)
+	w.publish(ctx, domain.ProductChangeUpdated, change.ProductID, err)
+	return product, err
+}
+
+// ApplyVisibilitySchedule публикует изменения и при ошибке записи ревизий: сами переходы уже сохранены
+func (w *watchedProductUseCase) ApplyVisibilitySchedule(ctx context.Context, now time.Time) ([]*domain.Product, error) {
+	products, err := w.ProductUseCase.ApplyVisibilitySchedule(ctx, now)
+	for _, product := range products {
+		w.publish(ctx, domain.ProductChangeUpdated, product.ID, nil)
+	}
+	return products, err
+}
+
+// BeginDelete и RollbackDelete меняют только статус товара
+func (w *watchedProductUseCase) BeginDelete(ctx context.Context, productID int32) error {
+	err := w.ProductUseCase.BeginDelete(ctx, productID)
+	w.publish(ctx, domain.ProductChangeUpdated, productID, err)
+	return err
+}
+
+func (w *watchedProductUseCase) RollbackDelete(ctx context.Context, productID int32) error {
+	err := w.ProductUseCase.RollbackDelete(ctx, productID)
+	w.publish(ctx, domain.ProductChangeUpdated, productID, err)
+	return err
+}
+
+func (w *watchedProductUseCase) CompleteDelete(ctx context.Context, productID int32) error {
+	err := w.ProductUseCase.CompleteDelete(ctx, productID)
+	w.publish(ctx, domain.ProductChangeDeleted, productID, err)
+	return err
+}
+
+type watchedPriceNotifier struct {
+	domain.PriceChangeNotifier
+	products ProductUseCase
+	changes  *ProductChanges
+}
+
+// WatchPriceChanges дополняет уведомление о вступившей в силу цене изменением товара для подписчиков changes
+func WatchPriceChanges(notifier domain.PriceChangeNotifier, products ProductUseCase, changes *ProductChanges) domain.PriceChangeNotifier {
+	return &watchedPriceNotifier{PriceChangeNotifier: notifier, products: products, changes: changes}
+}
+
+func (w *watchedPriceNotifier) NotifyPriceChanged(ctx context.Context, applied *domain.AppliedPriceChange) {
+	w.PriceChangeNotifier.NotifyPriceChanged(ctx, applied)
+	publishChange(ctx, w.changes, w.products, domain.ProductChangeUpdated, applied.Change.ProductID)
+}
+
+type watchedCategoryUseCase struct {
+	CategoryUseCase
+	products ProductUseCase
+	changes  *ProductChanges
+	notifier domain.CategoryChangeNotifier
+}
+
+// WatchCategoryUseCase публикует в changes смену разделов товара: от неё зависят фиды и крошки витрины.
+// Изменения самих разделов уходят в notifier: разделы есть в фидах и карте сайта
+func WatchCategoryUseCase(useCase CategoryUseCase, products ProductUseCase, changes *ProductChanges, notifier domain.CategoryChangeNotifier) CategoryUseCase {
+	return &watchedCategoryUseCase{CategoryUseCase: useCase, products: products, changes: changes, notifier: notifier}
+}
+
+func (w *watchedCategoryUseCase) Create(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	created, err := w.CategoryUseCase.Create(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	w.notifier.NotifyCategoryChanged(ctx, created.ID)
+	return created, nil
+}
+
+func (w *watchedCategoryUseCase) Update(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	updated, err := w.CategoryUseCase.Update(ctx, category)
+	if err == nil {
+		w.notifier.NotifyCategoryChanged(ctx, category.ID)
+	}
+	return updated, err
+}
+
+func (w *watchedCategoryUseCase) Delete(ctx context.Context, id int32) error {
+	err := w.CategoryUseCase.Delete(ctx, id)
+	if err == nil {
+		w.notifier.NotifyCategoryChanged(ctx, id)
+	}
+	return err
+}
+
+func (w *watchedCategoryUseCase) SetProductCategories(ctx context.Context, productID int32, version *int32, categoryIDs []int32) error {
+	err := w.CategoryUseCase.SetProductCategories(ctx, productID, version, categoryIDs)
+	if err == nil {
+		publishChange(ctx, w.changes, w.products, domain.ProductChangeUpdated, productID)
+	}
+	return err
+}
+
+type watchedAttributeUseCase struct {
+	AttributeUseCase
+	products ProductUseCase
+	changes  *ProductChanges
+}
+
+// WatchAttributeUseCase публикует в changes новые значения характеристик товара
+func WatchAttributeUseCase(useCase AttributeUseCase, products ProductUseCase, changes *ProductChanges) AttributeUseCase {
+	return &watchedAttributeUseCase{AttributeUseCase: useCase, products: products, changes: changes}
+}
+
+func (w *watchedAttributeUseCase) SetProductValues(ctx context.Context, productID int32, version *int32, values []*domain.ProductAttributeValue) ([]*domain.ProductAttributeValue, error) {
+	result, err := w.AttributeUseCase.SetProductValues(ctx, productID, version, values)
+	if err == nil {
+		publishChange(ctx, w.changes, w.products, domain.ProductChangeUpdated, productID)
+	}
+	return result, err
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductChanges(t *testing.T) {
+	t.Run("filters by product", func(t *testing.T) {
+		changes := NewProductChanges(nil)
+		all, unsubscribeAll := changes.Subscribe(nil)
+		defer unsubscribeAll()
+		one, unsubscribeOne := changes.Subscribe([]int32{2})
+		defer unsubscribeOne()
+
+		assert.True(t, changes.watching(1))
+		changes.Publish(domain.ProductChange{Type: domain.ProductChangeUpdated, ProductID: 1})
+		changes.Publish(domain.ProductChange{Type: domain.ProductChangeDeleted, ProductID: 2})
+
+		assert.Equal(t, int32(1), (<-all).ProductID)
+		assert.Equal(t, int32(2), (<-all).ProductID)
+		assert.Equal(t, domain.ProductChangeDeleted, (<-one).Type)
+		assert.Empty(t, one)
+	})
+
+	t.Run("disconnects slow subscriber", func(t *testing.T) {
+		changes := NewProductChanges(nil)
+		ch, unsubscribe := changes.Subscribe([]int32{1})
+
+		for i := 0; i <= changeBuffer; i++ {
+			changes.Publish(domain.ProductChange{Type: domain.ProductChangeUpdated, ProductID: 1})
+		}
+		for range ch {
+		}
+		assert.False(t, changes.watching(1))
+		unsubscribe()
+	})
+}
+
+// recordedChanges запоминает изменения товаров и разделов, о которых сообщили другим сервисам
+type recordedChanges struct {
+	ids         []int32
+	categoryIDs []int32
+}
+
+func (r *recordedChanges) NotifyCategoryChanged(ctx context.Context, categoryID int32) {
+	r.categoryIDs = append(r.categoryIDs, categoryID)
+}
+
+func (r *recordedChanges) NotifyProductChanged(ctx context.Context, changeType domain.ProductChangeType, productID int32) {
+	r.ids = append(r.ids, productID)
+}
+
+// categoriesStub сохраняет разделы товара или отвечает ошибкой err
+type categoriesStub struct {
+	CategoryUseCase
+	err error
+}
+
+func (s *categoriesStub) SetProductCategories(ctx context.Context, productID int32, version *int32, categoryIDs []int32) error {
+	return s.err
+}
+
+func (s *categoriesStub) Update(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	return category, s.err
+}
+
+func (s *categoriesStub) Delete(ctx context.Context, id int32) error {
+	return s.err
+}
+
+func TestProductChangesNotifier(t *testing.T) {
+	t.Run("notifies without subscribers", func(t *testing.T) {
+		notifier := &recordedChanges{}
+		changes := NewProductChanges(notifier)
+
+		publishChange(context.Background(), changes, nil, domain.ProductChangeUpdated, 3)
+
+		assert.Equal(t, []int32{3}, notifier.ids)
+	})
+
+	t.Run("category change of product", func(t *testing.T) {
+		notifier := &recordedChanges{}
+		categories := WatchCategoryUseCase(&categoriesStub{}, nil, NewProductChanges(notifier), notifier)
+
+		assert.NoError(t, categories.SetProductCategories(context.Background(), 5, nil, []int32{1}))
+		assert.Equal(t, []int32{5}, notifier.ids)
+		assert.Empty(t, notifier.categoryIDs)
+	})
+
+	t.Run("category edits", func(t *testing.T) {
+		notifier := &recordedChanges{}
+		categories := WatchCategoryUseCase(&categoriesStub{}, nil, NewProductChanges(notifier), notifier)
+
+		_, err := categories.Update(context.Background(), &domain.Category{ID: 2, Name: "Детские", Slug: "detskie"})
+		assert.NoError(t, err)
+		assert.NoError(t, categories.Delete(context.Background(), 3))
+		assert.Equal(t, []int32{2, 3}, notifier.categoryIDs)
+		assert.Empty(t, notifier.ids)
+	})
+
+	t.Run("rejected change is not announced", func(t *testing.T) {
+		notifier := &recordedChanges{}
+		categories := WatchCategoryUseCase(&categoriesStub{err: domain.ErrVersionConflict}, nil, NewProductChanges(notifier), notifier)
+
+		err := categories.SetProductCategories(context.Background(), 5, nil, []int32{1})
+		assert.True(t, errors.Is(err, domain.ErrVersionConflict))
+		assert.Error(t, categories.Delete(context.Background(), 3))
+		assert.Empty(t, notifier.ids)
+		assert.Empty(t, notifier.categoryIDs)
+	})
+}
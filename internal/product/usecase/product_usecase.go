@@ -2,6 +2,15 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx/types"
+	"github.com/shopspring/decimal"
 
 	"github.com/Nzyazin/zadnik.store/internal/common"
 	"github.com/Nzyazin/zadnik.store/internal/broker"
@@ -9,42 +18,576 @@ import (
 )
 
 type ProductUseCase interface {
-	GetAll(ctx context.Context) ([]*domain.Product, error)
+	GetAll(ctx context.Context, query domain.ProductQuery) (*domain.ProductPage, error)
+	Search(ctx context.Context, query domain.ProductSearchQuery) (*domain.ProductSearchPage, error)
 	GetByID(ctx context.Context, id int32) (*domain.Product, error)
-	UpdateProductImage(ctx context.Context, productID int32, imageURL string) error
+	GetBySlug(ctx context.Context, slug string) (*domain.Product, error)
 	Update(ctx context.Context, product *domain.Product) (*domain.Product, error)
 	BeginDelete(ctx context.Context, productID int32) error
 	CompleteDelete(ctx context.Context, productID int32) error
 	RollbackDelete(ctx context.Context, productID int32) error
 	RollbackCreate(ctx context.Context, productID int32) error
 	BeginCreate(ctx context.Context, event *broker.ProductEvent) (*domain.Product, error)
-	CreateFromEvent(ctx context.Context, event *broker.ProductEvent) error
-	CompleteCreate(ctx context.Context, productID int32, imageURL string) error
+	Create(ctx context.Context, product *domain.Product) (*domain.Product, error)
+	CreateFromEvent(ctx context.Context, event *broker.ProductEvent) (*domain.Product, error)
+	CompleteCreate(ctx context.Context, productID int32, imageURL, alt string) error
+	GetRevisions(ctx context.Context, productID int32) ([]*domain.ProductRevision, error)
+	RestoreRevision(ctx context.Context, productID, revisionID, version int32) (*domain.Product, error)
+	GetVariants(ctx context.Context, productID int32) ([]*domain.ProductVariant, error)
+	SetVariants(ctx context.Context, productID int32, variants []*domain.ProductVariant) ([]*domain.ProductVariant, error)
+	GetImages(ctx context.Context, productID int32) ([]*domain.ProductImage, error)
+	AddImage(ctx context.Context, productID int32, url, alt string) (*domain.ProductImage, error)
+	RemoveImage(ctx context.Context, productID, imageID int32) error
+	ArrangeImages(ctx context.Context, productID int32, images []*domain.ProductImage) ([]*domain.ProductImage, error)
+	GetPriceTiers(ctx context.Context, productID int32) ([]*domain.PriceTier, error)
+	SetPriceTiers(ctx context.Context, productID int32, tiers []*domain.PriceTier) ([]*domain.PriceTier, error)
+	Quote(ctx context.Context, productID int32, variantID *int32, quantity int32) (*domain.PriceQuote, error)
+	SetVisibility(ctx context.Context, change *domain.VisibilityChange) (*domain.Product, error)
+	// ApplyVisibilitySchedule публикует и скрывает товары, чьи даты наступили к now
+	ApplyVisibilitySchedule(ctx context.Context, now time.Time) ([]*domain.Product, error)
+	GetRelations(ctx context.Context, productID int32) ([]*domain.ProductRelation, error)
+	SetRelations(ctx context.Context, productID int32, relations []*domain.ProductRelation) ([]*domain.ProductRelation, error)
+	// GetRecommendations - ручные связи и похожие товары для витрины; limit 0 - значение по умолчанию
+	GetRecommendations(ctx context.Context, productID int32, limit int) ([]*domain.Recommendation, error)
 }
 
 type productUseCase struct {
-	repo domain.ProductRepository
+	repo       domain.ProductRepository
+	revisions  domain.ProductRevisionRepository
+	variants   domain.ProductVariantRepository
+	images     domain.ProductImageRepository
+	stock      domain.StockRepository
+	tiers      domain.ProductPriceTierRepository
+	slugs      domain.ProductSlugRepository
+	attributes domain.AttributeRepository
+	categories domain.CategoryRepository
+	visibility domain.ProductVisibilityRepository
+	relations  domain.ProductRelationRepository
+	reviews    domain.ProductReviewRepository
+	// tx объединяет изменение товара и его ревизию в одну транзакцию
+	tx         domain.Transactor
+	taxMode    domain.TaxMode
+}
+
+func NewProductUseCase(repo domain.ProductRepository, revisions domain.ProductRevisionRepository, variants domain.ProductVariantRepository, images domain.ProductImageRepository, stock domain.StockRepository, tiers domain.ProductPriceTierRepository, slugs domain.ProductSlugRepository, attributes domain.AttributeRepository, categories domain.CategoryRepository, visibility domain.ProductVisibilityRepository, relations domain.ProductRelationRepository, reviews domain.ProductReviewRepository, tx domain.Transactor, taxMode domain.TaxMode) ProductUseCase {
+	return &productUseCase{repo: repo, revisions: revisions, variants: variants, images: images, stock: stock, tiers: tiers, slugs: slugs, attributes: attributes, categories: categories, visibility: visibility, relations: relations, reviews: reviews, tx: tx, taxMode: taxMode}
+}
+
+func (puc *productUseCase) GetAll(ctx context.Context, query domain.ProductQuery) (*domain.ProductPage, error) {
+	if err := normalizeQuery(&query); err != nil {
+		return nil, err
+	}
+
+	products, err := puc.repo.GetAll(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+
+	total, err := puc.repo.Count(ctx, query.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count products: %w", err)
+	}
+
+	if err := puc.attachDetails(ctx, products); err != nil {
+		return nil, err
+	}
+
+	return &domain.ProductPage{
+		Items:  products,
+		Total:  total,
+		Limit:  query.Limit,
+		Offset: query.Offset,
+	}, nil
+}
+
+// normalizeQuery проверяет параметры выборки и подставляет значения по умолчанию
+func normalizeQuery(query *domain.ProductQuery) error {
+	if err := validateFilter(query.Filter); err != nil {
+		return err
+	}
+
+	if query.Sort == "" {
+		query.Sort = domain.ProductSortID
+	}
+	if !query.Sort.IsValid() {
+		return fmt.Errorf("%w: unknown sort %q", domain.ErrInvalidQuery, query.Sort)
+	}
+
+	return normalizePage(&query.Limit, query.Offset)
+}
+
+func validateFilter(filter domain.ProductFilter) error {
+	for _, status := range filter.Statuses {
+		if !status.IsValid() {
+			return fmt.Errorf("%w: %q", domain.ErrInvalidStatus, status)
+		}
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && filter.MinPrice.GreaterThan(*filter.MaxPrice) {
+		return fmt.Errorf("%w: min_price is greater than max_price", domain.ErrInvalidQuery)
+	}
+
+	for _, attribute := range filter.Attributes {
+		if !attributeCodePattern.MatchString(attribute.Code) {
+			return fmt.Errorf("%w: invalid attribute code %q", domain.ErrInvalidQuery, attribute.Code)
+		}
+		if len(attribute.Values) == 0 && attribute.Min == nil && attribute.Max == nil {
+			return fmt.Errorf("%w: attribute %q filter has no value", domain.ErrInvalidQuery, attribute.Code)
+		}
+		if attribute.Min != nil && attribute.Max != nil && attribute.Min.GreaterThan(*attribute.Max) {
+			return fmt.Errorf("%w: attribute %q min is greater than max", domain.ErrInvalidQuery, attribute.Code)
+		}
+	}
+
+	return nil
 }
 
-func NewProductUseCase(repo domain.ProductRepository) ProductUseCase {
-	return &productUseCase{repo: repo}
+func normalizePage(limit *int, offset int) error {
+	if *limit == 0 {
+		*limit = domain.DefaultProductLimit
+	}
+	if *limit < 0 || *limit > domain.MaxProductLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrInvalidQuery, domain.MaxProductLimit)
+	}
+	if offset < 0 {
+		return fmt.Errorf("%w: offset must not be negative", domain.ErrInvalidQuery)
+	}
+	return nil
 }
 
-func (puc *productUseCase) GetAll(ctx context.Context) ([]*domain.Product, error) {
-	return puc.repo.GetAll(ctx)
+func (puc *productUseCase) Search(ctx context.Context, query domain.ProductSearchQuery) (*domain.ProductSearchPage, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	if query.Text == "" {
+		return nil, fmt.Errorf("%w: search text is empty", domain.ErrInvalidQuery)
+	}
+	if err := validateFilter(query.Filter); err != nil {
+		return nil, err
+	}
+	if err := normalizePage(&query.Limit, query.Offset); err != nil {
+		return nil, err
+	}
+
+	results, total, err := puc.repo.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		result.NameHighlight = highlightMarkup(result.NameHighlight)
+		result.Snippet = highlightMarkup(result.Snippet)
+		puc.attachPricing(&result.Product)
+	}
+
+	return &domain.ProductSearchPage{
+		Items:  results,
+		Total:  total,
+		Limit:  query.Limit,
+		Offset: query.Offset,
+	}, nil
+}
+
+var highlightReplacer = strings.NewReplacer(
+	domain.HighlightStart, "<mark>",
+	domain.HighlightStop, "</mark>",
+)
+
+// highlightMarkup экранирует текст и превращает маркеры ts_headline в теги <mark>
+func highlightMarkup(s string) string {
+	return highlightReplacer.Replace(html.EscapeString(s))
 }
 
 func (puc *productUseCase) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
-	return puc.repo.GetByID(ctx, id)
+	product, err := puc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.Variants, err = puc.variants.GetByProduct(ctx, id); err != nil {
+		return nil, err
+	}
+	if product.Images, err = puc.images.GetByProduct(ctx, id); err != nil {
+		return nil, err
+	}
+	if product.Stock, err = puc.stock.GetByProduct(ctx, id); err != nil {
+		return nil, err
+	}
+	if product.PriceTiers, err = puc.tiers.GetByProduct(ctx, id); err != nil {
+		return nil, err
+	}
+	if product.Attributes, err = puc.attributes.GetValues(ctx, id); err != nil {
+		return nil, err
+	}
+	ratings, err := puc.reviews.GetRatings(ctx, []int32{id})
+	if err != nil {
+		return nil, err
+	}
+	product.Rating = ratings[id]
+	puc.attachPricing(product)
+	return product, nil
+}
+
+// attachDetails подгружает варианты, галереи, остатки, оптовые цены и оценки для страницы товаров одним запросом на каждую таблицу
+func (puc *productUseCase) attachDetails(ctx context.Context, products []*domain.Product) error {
+	ids := make([]int32, len(products))
+	for i, product := range products {
+		ids[i] = product.ID
+	}
+
+	variants, err := puc.variants.GetByProducts(ctx, ids)
+	if err != nil {
+		return err
+	}
+	images, err := puc.images.GetByProducts(ctx, ids)
+	if err != nil {
+		return err
+	}
+	stock, err := puc.stock.GetByProducts(ctx, ids)
+	if err != nil {
+		return err
+	}
+	tiers, err := puc.tiers.GetByProducts(ctx, ids)
+	if err != nil {
+		return err
+	}
+	attributes, err := puc.attributes.GetValuesByProducts(ctx, ids)
+	if err != nil {
+		return err
+	}
+	ratings, err := puc.reviews.GetRatings(ctx, ids)
+	if err != nil {
+		return err
+	}
+	categories, err := puc.categories.GetIDsByProducts(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, product := range products {
+		product.Variants = variants[product.ID]
+		product.Images = images[product.ID]
+		product.Stock = stock[product.ID]
+		product.PriceTiers = tiers[product.ID]
+		product.Attributes = attributes[product.ID]
+		product.Rating = ratings[product.ID]
+		product.CategoryIDs = categories[product.ID]
+		puc.attachPricing(product)
+	}
+	return nil
+}
+
+// attachPricing выделяет НДС из цен товара, его вариантов и оптовых ступеней
+func (puc *productUseCase) attachPricing(product *domain.Product) {
+	pricing := puc.taxMode.Price(product.Price, product.TaxClass)
+	product.Pricing = &pricing
+
+	for _, variant := range product.Variants {
+		pricing := puc.taxMode.Price(variant.EffectivePrice(product.Price), product.TaxClass)
+		variant.Pricing = &pricing
+	}
+	for _, tier := range product.PriceTiers {
+		pricing := puc.taxMode.Price(tier.UnitPrice, product.TaxClass)
+		tier.Pricing = &pricing
+	}
+}
+
+func (puc *productUseCase) GetVariants(ctx context.Context, productID int32) ([]*domain.ProductVariant, error) {
+	if _, err := puc.repo.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return puc.variants.GetByProduct(ctx, productID)
+}
+
+func (puc *productUseCase) SetVariants(ctx context.Context, productID int32, variants []*domain.ProductVariant) ([]*domain.ProductVariant, error) {
+	if err := normalizeVariants(variants); err != nil {
+		return nil, err
+	}
+	if _, err := puc.repo.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return puc.variants.ReplaceForProduct(ctx, productID, variants)
+}
+
+const maxImageAltLength = 255
+
+const maxProductNameLength = 255
+
+// maxProductPrice - верхняя граница столбца price NUMERIC(10,2)
+var maxProductPrice = decimal.RequireFromString("99999999.99")
+
+// validateProduct чистит название и проверяет поля, общие для создания и изменения товара
+func validateProduct(product *domain.Product) error {
+	product.Name = strings.TrimSpace(product.Name)
+	if product.Name == "" {
+		return fmt.Errorf("%w: name is required", domain.ErrProductInvalid)
+	}
+	if len([]rune(product.Name)) > maxProductNameLength {
+		return fmt.Errorf("%w: name is longer than %d characters", domain.ErrProductInvalid, maxProductNameLength)
+	}
+	if !product.Price.IsPositive() || product.Price.GreaterThan(maxProductPrice) {
+		return fmt.Errorf("%w: price must be between 0.01 and %s", domain.ErrProductInvalid, maxProductPrice)
+	}
+	if !product.Price.Equal(product.Price.Round(2)) {
+		return fmt.Errorf("%w: price must have at most 2 decimal places", domain.ErrProductInvalid)
+	}
+	return nil
+}
+
+var variantSKUPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+// normalizeVariants чистит поля вариантов и проверяет артикулы, цены и вес
+func normalizeVariants(variants []*domain.ProductVariant) error {
+	seen := make(map[string]bool, len(variants))
+	for _, variant := range variants {
+		variant.SKU = strings.TrimSpace(variant.SKU)
+		if !variantSKUPattern.MatchString(variant.SKU) {
+			return fmt.Errorf("%w: sku %q must contain latin letters, digits, dots, dashes or underscores", domain.ErrVariantInvalid, variant.SKU)
+		}
+
+		key := strings.ToUpper(variant.SKU)
+		if seen[key] {
+			return fmt.Errorf("%w: duplicate sku %q", domain.ErrVariantInvalid, variant.SKU)
+		}
+		seen[key] = true
+
+		if variant.Price.Valid && !variant.Price.Decimal.IsPositive() {
+			return fmt.Errorf("%w: price of %s must be positive", domain.ErrVariantInvalid, variant.SKU)
+		}
+		if variant.WeightGrams != nil && *variant.WeightGrams < 0 {
+			return fmt.Errorf("%w: weight of %s must not be negative", domain.ErrVariantInvalid, variant.SKU)
+		}
+
+		options := make(domain.VariantOptions, len(variant.Options))
+		for name, value := range variant.Options {
+			name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+			if name != "" && value != "" {
+				options[name] = value
+			}
+		}
+		variant.Options = options
+	}
+	return nil
 }
 
-func (puc *productUseCase) UpdateProductImage(ctx context.Context, productID int32, imageURL string) error {
-	return puc.repo.UpdateProductImage(ctx, productID, imageURL)
+// taxClassFromEvent подставляет основную ставку, если в событии она не указана
+func taxClassFromEvent(value string) domain.TaxClass {
+	if value == "" {
+		return domain.TaxClassStandard
+	}
+	return domain.TaxClass(value)
+}
+
+// VariantsFromEvent переводит варианты из события; nil означает, что варианты не менялись
+func VariantsFromEvent(events []broker.ProductVariant) []*domain.ProductVariant {
+	if events == nil {
+		return nil
+	}
+
+	variants := make([]*domain.ProductVariant, len(events))
+	for i, event := range events {
+		variants[i] = &domain.ProductVariant{
+			ID:          event.ID,
+			SKU:         event.SKU,
+			Options:     domain.VariantOptions(event.Options),
+			WeightGrams: event.WeightGrams,
+			IsActive:    event.IsActive,
+		}
+		if event.Price != nil {
+			variants[i].Price = decimal.NullDecimal{Decimal: *event.Price, Valid: true}
+		}
+	}
+	return variants
+}
+
+func (puc *productUseCase) GetImages(ctx context.Context, productID int32) ([]*domain.ProductImage, error) {
+	if _, err := puc.repo.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return puc.images.GetByProduct(ctx, productID)
+}
+
+func (puc *productUseCase) AddImage(ctx context.Context, productID int32, url, alt string) (*domain.ProductImage, error) {
+	var added *domain.ProductImage
+	err := puc.changeImages(ctx, productID, func(ctx context.Context) error {
+		var err error
+		added, err = puc.images.Add(ctx, &domain.ProductImage{
+			ProductID: productID,
+			URL:       url,
+			Alt:       strings.TrimSpace(alt),
+		})
+		return err
+	})
+	return added, err
+}
+
+func (puc *productUseCase) RemoveImage(ctx context.Context, productID, imageID int32) error {
+	return puc.changeImages(ctx, productID, func(ctx context.Context) error {
+		return puc.images.Remove(ctx, productID, imageID)
+	})
+}
+
+func (puc *productUseCase) ArrangeImages(ctx context.Context, productID int32, images []*domain.ProductImage) ([]*domain.ProductImage, error) {
+	current, err := puc.images.GetByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if err := normalizeImages(current, images); err != nil {
+		return nil, err
+	}
+
+	var arranged []*domain.ProductImage
+	err = puc.changeImages(ctx, productID, func(ctx context.Context) error {
+		var err error
+		arranged, err = puc.images.Arrange(ctx, productID, images)
+		return err
+	})
+	return arranged, err
+}
+
+// changeImages выполняет изменение галереи и в той же транзакции пишет ревизию, если сменилось основное изображение
+func (puc *productUseCase) changeImages(ctx context.Context, productID int32, change func(ctx context.Context) error) error {
+	return puc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := puc.repo.GetByID(ctx, productID)
+		if err != nil {
+			return fmt.Errorf("failed to get product %d: %w", productID, err)
+		}
+
+		if err := change(ctx); err != nil {
+			return err
+		}
+
+		after, err := puc.repo.GetByID(ctx, productID)
+		if err != nil {
+			return fmt.Errorf("failed to get product %d: %w", productID, err)
+		}
+		if before.ImageURL == after.ImageURL {
+			return nil
+		}
+
+		return puc.recordRevision(ctx, productID, domain.RevisionActionImageChange, before, after)
+	})
+}
+
+// normalizeImages проверяет, что новая раскладка описывает всю галерею и в ней одно основное изображение
+func normalizeImages(current, images []*domain.ProductImage) error {
+	known := make(map[int32]bool, len(current))
+	for _, image := range current {
+		known[image.ID] = true
+	}
+	if len(images) != len(current) {
+		return fmt.Errorf("%w: expected %d images, got %d", domain.ErrImageInvalid, len(current), len(images))
+	}
+
+	primary := 0
+	for _, image := range images {
+		if !known[image.ID] {
+			return fmt.Errorf("%w: %d", domain.ErrImageNotFound, image.ID)
+		}
+		delete(known, image.ID)
+
+		image.Alt = strings.TrimSpace(image.Alt)
+		if len([]rune(image.Alt)) > maxImageAltLength {
+			return fmt.Errorf("%w: alt of image %d is longer than %d characters", domain.ErrImageInvalid, image.ID, maxImageAltLength)
+		}
+		if image.IsPrimary {
+			primary++
+		}
+	}
+
+	if len(images) > 0 && primary != 1 {
+		return fmt.Errorf("%w: exactly one primary image is required", domain.ErrImageInvalid)
+	}
+	return nil
 }
 
 func (puc *productUseCase) Update(ctx context.Context, product *domain.Product) (*domain.Product, error) {
-	product.Slug = common.GenerateSlug(product.Name)
-	return puc.repo.Update(ctx, product)
+	return puc.update(ctx, product, updateAction)
+}
+
+// update сохраняет товар; action выбирает действие ревизии по состоянию до и после
+func (puc *productUseCase) update(ctx context.Context, product *domain.Product, action func(before, after *domain.Product) domain.RevisionAction) (*domain.Product, error) {
+	before, err := puc.repo.GetByID(ctx, product.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", product.ID, err)
+	}
+
+	if err := validateProduct(product); err != nil {
+		return nil, err
+	}
+	if product.Variants != nil {
+		if err := normalizeVariants(product.Variants); err != nil {
+			return nil, err
+		}
+	}
+	if product.PriceTiers != nil {
+		if err := normalizePriceTiers(product.PriceTiers); err != nil {
+			return nil, err
+		}
+	}
+
+	if product.TaxClass == "" {
+		product.TaxClass = before.TaxClass
+	}
+	if !product.TaxClass.IsValid() {
+		return nil, fmt.Errorf("%w: %q", domain.ErrTaxClassInvalid, product.TaxClass)
+	}
+
+	// адрес, товар, прежний адрес, варианты, оптовые цены и ревизия сохраняются вместе: ошибка в любом из них откатывает всё
+	var after *domain.Product
+	err = puc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := puc.updateSlug(ctx, product, before); err != nil {
+			return err
+		}
+
+		var err error
+		if after, err = puc.repo.Update(ctx, product); err != nil {
+			return err
+		}
+
+		if after.Slug != before.Slug {
+			if err := puc.slugs.Record(ctx, product.ID, before.Slug, after.Slug); err != nil {
+				return err
+			}
+		}
+
+		// варианты и оптовые цены попадают в снимки ревизии, даже если не менялись: по ним товар восстанавливается целиком
+		if before.Variants, err = puc.variants.GetByProduct(ctx, product.ID); err != nil {
+			return err
+		}
+		after.Variants = before.Variants
+		if product.Variants != nil {
+			if after.Variants, err = puc.variants.ReplaceForProduct(ctx, product.ID, product.Variants); err != nil {
+				return err
+			}
+		}
+		if before.PriceTiers, err = puc.tiers.GetByProduct(ctx, product.ID); err != nil {
+			return err
+		}
+		after.PriceTiers = before.PriceTiers
+		if product.PriceTiers != nil {
+			if after.PriceTiers, err = puc.tiers.ReplaceForProduct(ctx, product.ID, product.PriceTiers); err != nil {
+				return err
+			}
+		}
+
+		return puc.recordRevision(ctx, product.ID, action(before, after), before, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// withProductVersion выполняет запись связанных с товаром данных. Если version задана, запись идёт
+// в одной транзакции с блокировкой товара по этой версии, иначе - как есть
+func withProductVersion(ctx context.Context, tx domain.Transactor, products domain.ProductRepository, productID int32, version *int32, write func(ctx context.Context) error) error {
+	if version == nil {
+		return write(ctx)
+	}
+	return tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := products.LockVersion(ctx, productID, *version); err != nil {
+			return err
+		}
+		return write(ctx)
+	})
 }
 
 func (puc *productUseCase) BeginDelete(ctx context.Context, productID int32) error {
@@ -52,7 +595,17 @@ func (puc *productUseCase) BeginDelete(ctx context.Context, productID int32) err
 }
 
 func (puc *productUseCase) CompleteDelete(ctx context.Context, productID int32) error {
-	return puc.repo.CompleteDelete(ctx, productID)
+	before, err := puc.repo.GetByID(ctx, productID)
+	if err != nil {
+		return fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+
+	return puc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := puc.repo.CompleteDelete(ctx, productID); err != nil {
+			return err
+		}
+		return puc.recordRevision(ctx, productID, domain.RevisionActionDelete, before, nil)
+	})
 }
 
 func (puc *productUseCase) RollbackDelete(ctx context.Context, productID int32) error {
@@ -63,16 +616,74 @@ func (puc *productUseCase) RollbackCreate(ctx context.Context, productID int32)
 	return puc.repo.RollbackCreate(ctx, productID)
 }
 
-func (puc *productUseCase) CreateFromEvent(ctx context.Context, event *broker.ProductEvent) error {
-	product := &domain.Product{
-		ID:          event.ProductID,
+func (puc *productUseCase) CreateFromEvent(ctx context.Context, event *broker.ProductEvent) (*domain.Product, error) {
+	return puc.Create(ctx, productFromEvent(event))
+}
+
+func productFromEvent(event *broker.ProductEvent) *domain.Product {
+	return &domain.Product{
 		Name:        event.Name,
 		Description: event.Description,
 		Price:       event.Price,
-		Status:      domain.ProductStatusPending,
-		Slug:        common.GenerateSlug(event.Name),
+		TaxClass:    taxClassFromEvent(event.TaxClass),
+		Visibility:  domain.ProductVisibility(event.Visibility),
+		PublishAt:   event.PublishAt,
+		UnpublishAt: event.UnpublishAt,
+		Variants:    VariantsFromEvent(event.Variants),
+		PriceTiers:  PriceTiersFromEvent(event.PriceTiers),
 	}
-	return puc.repo.Create(ctx, product)
+}
+
+// Create сразу создаёт активный товар без изображения; адрес строится из Slug, а если он пуст - из названия
+func (puc *productUseCase) Create(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	if err := validateProduct(product); err != nil {
+		return nil, err
+	}
+	product.Status = domain.ProductStatusActive
+	if product.TaxClass == "" {
+		product.TaxClass = domain.TaxClassStandard
+	}
+	base := common.GenerateSlug(product.Slug)
+	if base == "" {
+		base = common.GenerateSlug(product.Name)
+	}
+	if !product.TaxClass.IsValid() {
+		return nil, fmt.Errorf("%w: %q", domain.ErrTaxClassInvalid, product.TaxClass)
+	}
+	if err := normalizeProductVisibility(product, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := normalizeVariants(product.Variants); err != nil {
+		return nil, err
+	}
+	if err := normalizePriceTiers(product.PriceTiers); err != nil {
+		return nil, err
+	}
+	err := puc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if product.Slug, err = puc.uniqueSlug(ctx, base, 0); err != nil {
+			return err
+		}
+		if err := puc.repo.Create(ctx, product); err != nil {
+			return err
+		}
+		if len(product.Variants) > 0 {
+			if _, err := puc.variants.ReplaceForProduct(ctx, product.ID, product.Variants); err != nil {
+				return err
+			}
+		}
+		if len(product.PriceTiers) > 0 {
+			if _, err := puc.tiers.ReplaceForProduct(ctx, product.ID, product.PriceTiers); err != nil {
+				return err
+			}
+		}
+
+		return puc.recordCreated(ctx, product.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return puc.GetByID(ctx, product.ID)
 }
 
 func (puc *productUseCase) BeginCreate(ctx context.Context, event *broker.ProductEvent) (*domain.Product, error) {
@@ -81,12 +692,207 @@ func (puc *productUseCase) BeginCreate(ctx context.Context, event *broker.Produc
 		Name:        event.Name,
 		Description: event.Description,
 		Price:       event.Price,
+		TaxClass:    taxClassFromEvent(event.TaxClass),
 		Status:      domain.ProductStatusPending,
-		Slug:        common.GenerateSlug(event.Name),
+		Visibility:  domain.ProductVisibility(event.Visibility),
+		PublishAt:   event.PublishAt,
+		UnpublishAt: event.UnpublishAt,
+	}
+	if !product.TaxClass.IsValid() {
+		return nil, fmt.Errorf("%w: %q", domain.ErrTaxClassInvalid, product.TaxClass)
+	}
+	if err := normalizeProductVisibility(product, time.Now()); err != nil {
+		return nil, err
 	}
-	return puc.repo.BeginCreate(ctx, product)
+	variants := VariantsFromEvent(event.Variants)
+	if err := normalizeVariants(variants); err != nil {
+		return nil, err
+	}
+	tiers := PriceTiersFromEvent(event.PriceTiers)
+	if err := normalizePriceTiers(tiers); err != nil {
+		return nil, err
+	}
+
+	var created *domain.Product
+	err := puc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if product.Slug, err = puc.uniqueSlug(ctx, common.GenerateSlug(event.Name), 0); err != nil {
+			return err
+		}
+		if created, err = puc.repo.BeginCreate(ctx, product); err != nil {
+			return err
+		}
+		if len(variants) > 0 {
+			if created.Variants, err = puc.variants.ReplaceForProduct(ctx, created.ID, variants); err != nil {
+				return err
+			}
+		}
+		if len(tiers) > 0 {
+			if created.PriceTiers, err = puc.tiers.ReplaceForProduct(ctx, created.ID, tiers); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (puc *productUseCase) CompleteCreate(ctx context.Context, productID int32, imageURL, alt string) error {
+	return puc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := puc.repo.CompleteCreate(ctx, productID, imageURL); err != nil {
+			return err
+		}
+		if _, err := puc.images.Add(ctx, &domain.ProductImage{ProductID: productID, URL: imageURL, Alt: strings.TrimSpace(alt)}); err != nil {
+			return err
+		}
+
+		return puc.recordCreated(ctx, productID)
+	})
 }
 
-func (puc *productUseCase) CompleteCreate(ctx context.Context, productID int32, imageURL string) error {
-	return puc.repo.CompleteCreate(ctx, productID, imageURL)
-}
\ No newline at end of file
+func (puc *productUseCase) GetRevisions(ctx context.Context, productID int32) ([]*domain.ProductRevision, error) {
+	return puc.revisions.ListByProduct(ctx, productID)
+}
+
+// RestoreRevision возвращает товар к состоянию после указанной ревизии: название, описание, адрес, цену, ставку НДС,
+// а также варианты и оптовые цены, если снимок их содержит. Видимость, изображения, остатки и характеристики
+// ведутся отдельно и не откатываются
+func (puc *productUseCase) RestoreRevision(ctx context.Context, productID, revisionID, version int32) (*domain.Product, error) {
+	revision, err := puc.revisions.GetByID(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	if revision.ProductID != productID {
+		return nil, domain.ErrRevisionNotFound
+	}
+	if !revision.After.Valid {
+		return nil, domain.ErrRevisionNotRestorable
+	}
+
+	snapshot := productSnapshot{Product: &domain.Product{}}
+	if err := json.Unmarshal(revision.After.JSONText, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode revision %d: %w", revisionID, err)
+	}
+
+	current, err := puc.repo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+
+	restored := *current
+	restored.Name = snapshot.Name
+	restored.Description = snapshot.Description
+	restored.Price = snapshot.Price
+	if snapshot.TaxClass != "" {
+		restored.TaxClass = snapshot.TaxClass
+	}
+	// прежний адрес остаётся в истории, поэтому ссылки на текущий после отката перенаправляются
+	if snapshot.Slug != "" {
+		restored.Slug = snapshot.Slug
+	}
+	restored.Variants, restored.PriceTiers = nil, nil
+	if snapshot.Variants != nil {
+		existing, err := puc.variants.GetByProduct(ctx, productID)
+		if err != nil {
+			return nil, err
+		}
+		restored.Variants = restorableVariants(snapshot.Variants, existing)
+	}
+	if snapshot.PriceTiers != nil {
+		restored.PriceTiers = snapshot.PriceTiers
+	}
+	restored.Version = version
+
+	return puc.update(ctx, &restored, func(before, after *domain.Product) domain.RevisionAction {
+		return domain.RevisionActionRollback
+	})
+}
+
+// restorableVariants возвращает варианты снимка; удалённые с тех пор варианты создаются заново
+func restorableVariants(snapshot, existing []*domain.ProductVariant) []*domain.ProductVariant {
+	ids := make(map[int32]bool, len(existing))
+	for _, variant := range existing {
+		ids[variant.ID] = true
+	}
+
+	variants := make([]*domain.ProductVariant, 0, len(snapshot))
+	for _, variant := range snapshot {
+		restored := *variant
+		if !ids[restored.ID] {
+			restored.ID = 0
+		}
+		variants = append(variants, &restored)
+	}
+	return variants
+}
+
+func (puc *productUseCase) recordCreated(ctx context.Context, productID int32) error {
+	product, err := puc.repo.GetByID(ctx, productID)
+	if err != nil {
+		return fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+
+	if product.Variants, err = puc.variants.GetByProduct(ctx, productID); err != nil {
+		return err
+	}
+	if product.PriceTiers, err = puc.tiers.GetByProduct(ctx, productID); err != nil {
+		return err
+	}
+
+	return puc.recordRevision(ctx, productID, domain.RevisionActionCreate, nil, product)
+}
+
+func (puc *productUseCase) recordRevision(ctx context.Context, productID int32, action domain.RevisionAction, before, after *domain.Product) error {
+	revision := &domain.ProductRevision{
+		ProductID: productID,
+		UserID:    domain.UserIDFromContext(ctx),
+		Action:    action,
+	}
+
+	var err error
+	if revision.Before, err = snapshotOf(before); err != nil {
+		return err
+	}
+	if revision.After, err = snapshotOf(after); err != nil {
+		return err
+	}
+
+	if err := puc.revisions.Create(ctx, revision); err != nil {
+		return fmt.Errorf("failed to record %s revision for product %d: %w", action, productID, err)
+	}
+	return nil
+}
+
+// productSnapshot - снимок товара в ревизии. Варианты и оптовые цены пишутся и пустыми списками,
+// чтобы при откате отличить «не было» от «не записано»: null в снимке означает, что их не загружали
+type productSnapshot struct {
+	*domain.Product
+	Variants   []*domain.ProductVariant `json:"variants"`
+	PriceTiers []*domain.PriceTier      `json:"price_tiers"`
+}
+
+func snapshotOf(product *domain.Product) (types.NullJSONText, error) {
+	if product == nil {
+		return types.NullJSONText{}, nil
+	}
+
+	data, err := json.Marshal(productSnapshot{Product: product, Variants: product.Variants, PriceTiers: product.PriceTiers})
+	if err != nil {
+		return types.NullJSONText{}, fmt.Errorf("failed to encode product snapshot: %w", err)
+	}
+	return types.NullJSONText{JSONText: data, Valid: true}, nil
+}
+
+// updateAction выделяет изменение цены в отдельное действие, если больше ничего не менялось
+func updateAction(before, after *domain.Product) domain.RevisionAction {
+	if !before.Price.Equal(after.Price) &&
+		before.Name == after.Name &&
+		before.Description == after.Description &&
+		before.ImageURL == after.ImageURL {
+		return domain.RevisionActionPriceChange
+	}
+	return domain.RevisionActionUpdate
+}
@@ -0,0 +1,336 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		query := domain.ProductQuery{}
+
+		err := normalizeQuery(&query)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ProductSortID, query.Sort)
+		assert.Equal(t, domain.DefaultProductLimit, query.Limit)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		query := domain.ProductQuery{
+			Filter: domain.ProductFilter{Statuses: []domain.ProductStatus{"archived"}},
+		}
+
+		err := normalizeQuery(&query)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidStatus)
+	})
+
+	t.Run("unknown sort", func(t *testing.T) {
+		query := domain.ProductQuery{Sort: "slug; DROP TABLE products"}
+
+		err := normalizeQuery(&query)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidQuery)
+	})
+
+	t.Run("limit above maximum", func(t *testing.T) {
+		query := domain.ProductQuery{Limit: domain.MaxProductLimit + 1}
+
+		err := normalizeQuery(&query)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidQuery)
+	})
+
+	t.Run("inverted price range", func(t *testing.T) {
+		minPrice := decimal.NewFromInt(500)
+		maxPrice := decimal.NewFromInt(100)
+		query := domain.ProductQuery{
+			Filter: domain.ProductFilter{MinPrice: &minPrice, MaxPrice: &maxPrice},
+		}
+
+		err := normalizeQuery(&query)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidQuery)
+	})
+}
+
+func TestHighlightMarkup(t *testing.T) {
+	snippet := "Задник " + domain.HighlightStart + "7780" + domain.HighlightStop + " <b>из кожкартона</b>"
+
+	result := highlightMarkup(snippet)
+
+	assert.Equal(t, "Задник <mark>7780</mark> &lt;b&gt;из кожкартона&lt;/b&gt;", result)
+}
+
+func TestValidateProduct(t *testing.T) {
+	t.Run("trims name", func(t *testing.T) {
+		product := &domain.Product{Name: "  Задник 7780 ", Price: decimal.RequireFromString("150.50")}
+
+		err := validateProduct(product)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Задник 7780", product.Name)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		err := validateProduct(&domain.Product{Name: " ", Price: decimal.NewFromInt(100)})
+
+		assert.ErrorIs(t, err, domain.ErrProductInvalid)
+	})
+
+	t.Run("price out of range", func(t *testing.T) {
+		for _, price := range []string{"0", "-1", "100000000"} {
+			err := validateProduct(&domain.Product{Name: "Задник", Price: decimal.RequireFromString(price)})
+
+			assert.ErrorIs(t, err, domain.ErrProductInvalid, price)
+		}
+	})
+
+	t.Run("fractional kopecks", func(t *testing.T) {
+		err := validateProduct(&domain.Product{Name: "Задник", Price: decimal.RequireFromString("10.005")})
+
+		assert.ErrorIs(t, err, domain.ErrProductInvalid)
+	})
+}
+
+// storedProducts - товары в памяти; Update проверяет версию так же, как postgres
+type storedProducts struct {
+	domain.ProductRepository
+	products map[int32]*domain.Product
+}
+
+func (r *storedProducts) GetByID(ctx context.Context, id int32) (*domain.Product, error) {
+	product, ok := r.products[id]
+	if !ok {
+		return nil, fmt.Errorf("product %d not found", id)
+	}
+	stored := *product
+	return &stored, nil
+}
+
+func (r *storedProducts) Update(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	stored, ok := r.products[product.ID]
+	if !ok {
+		return nil, fmt.Errorf("product %d not found", product.ID)
+	}
+	if stored.Version != product.Version {
+		return nil, fmt.Errorf("product %d version %d is stale: %w", product.ID, product.Version, domain.ErrVersionConflict)
+	}
+	updated := *product
+	updated.Variants, updated.PriceTiers = nil, nil
+	updated.Version++
+	r.products[product.ID] = &updated
+	return r.GetByID(ctx, product.ID)
+}
+
+func (r *storedProducts) LockVersion(ctx context.Context, id, version int32) error {
+	stored, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if stored.Version != version {
+		return fmt.Errorf("product %d version %d is stale: %w", id, version, domain.ErrVersionConflict)
+	}
+	return nil
+}
+
+// savedVariants сохраняет варианты как есть или отвечает ошибкой err
+type savedVariants struct {
+	domain.ProductVariantRepository
+	current []*domain.ProductVariant
+	err     error
+}
+
+func (r *savedVariants) GetByProduct(ctx context.Context, productID int32) ([]*domain.ProductVariant, error) {
+	return r.current, nil
+}
+
+func (r *savedVariants) ReplaceForProduct(ctx context.Context, productID int32, variants []*domain.ProductVariant) ([]*domain.ProductVariant, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.current = variants
+	return variants, nil
+}
+
+// savedTiers сохраняет оптовые цены как есть
+type savedTiers struct {
+	domain.ProductPriceTierRepository
+	current []*domain.PriceTier
+}
+
+func (r *savedTiers) GetByProduct(ctx context.Context, productID int32) ([]*domain.PriceTier, error) {
+	return r.current, nil
+}
+
+func (r *savedTiers) ReplaceForProduct(ctx context.Context, productID int32, tiers []*domain.PriceTier) ([]*domain.PriceTier, error) {
+	r.current = tiers
+	return tiers, nil
+}
+
+func TestUpdate(t *testing.T) {
+	product := func() *domain.Product {
+		return &domain.Product{ID: 1, Name: "Задник 7780", Slug: "zadnik-7780", Price: decimal.NewFromInt(150), TaxClass: domain.TaxClassStandard, Version: 3}
+	}
+
+	t.Run("revision includes saved variants", func(t *testing.T) {
+		revisions := &revisionRecorder{}
+		variants := &savedVariants{current: []*domain.ProductVariant{{ID: 5, SKU: "7780-S"}}}
+		puc := &productUseCase{repo: &storedProducts{products: map[int32]*domain.Product{1: product()}}, revisions: revisions, variants: variants, tiers: &savedTiers{}, tx: inlineTransactor{}}
+		update := product()
+		update.Variants = []*domain.ProductVariant{{ID: 5, SKU: "7780-M"}}
+
+		after, err := puc.Update(context.Background(), update)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(4), after.Version)
+		if assert.Len(t, revisions.revisions, 1) {
+			assert.Contains(t, string(revisions.revisions[0].Before.JSONText), "7780-S")
+			assert.Contains(t, string(revisions.revisions[0].After.JSONText), "7780-M")
+		}
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		revisions := &revisionRecorder{}
+		products := &storedProducts{products: map[int32]*domain.Product{1: product()}}
+		puc := &productUseCase{repo: products, revisions: revisions, tx: inlineTransactor{}}
+		update := product()
+		update.Name = "Задник 7780 Люкс"
+		update.Version = 2
+
+		_, err := puc.Update(context.Background(), update)
+
+		assert.ErrorIs(t, err, domain.ErrVersionConflict)
+		assert.Empty(t, revisions.revisions)
+		assert.Equal(t, "Задник 7780", products.products[1].Name)
+	})
+
+	t.Run("variant error skips revision", func(t *testing.T) {
+		revisions := &revisionRecorder{}
+		variants := &savedVariants{err: domain.ErrVariantSKUTaken}
+		puc := &productUseCase{repo: &storedProducts{products: map[int32]*domain.Product{1: product()}}, revisions: revisions, variants: variants, tiers: &savedTiers{}, tx: inlineTransactor{}}
+		update := product()
+		update.Variants = []*domain.ProductVariant{{SKU: "7780-M"}}
+
+		_, err := puc.Update(context.Background(), update)
+
+		assert.ErrorIs(t, err, domain.ErrVariantSKUTaken)
+		assert.Empty(t, revisions.revisions)
+	})
+}
+
+// storedRevisions - ревизии в памяти; новые ревизии записываются в recorder
+type storedRevisions struct {
+	revisionRecorder
+	stored map[int32]*domain.ProductRevision
+}
+
+func (r *storedRevisions) GetByID(ctx context.Context, id int32) (*domain.ProductRevision, error) {
+	revision, ok := r.stored[id]
+	if !ok {
+		return nil, domain.ErrRevisionNotFound
+	}
+	return revision, nil
+}
+
+func TestRestoreRevision(t *testing.T) {
+	current := func() *domain.Product {
+		return &domain.Product{ID: 1, Name: "Задник 7780 новый", Slug: "zadnik-7780-novyi", Description: "Новое описание", Price: decimal.NewFromInt(200), TaxClass: domain.TaxClassStandard, Version: 5}
+	}
+	full := `{"id":1,"name":"Задник 7780","slug":"zadnik-7780","description":"Старое описание","price":"150","tax_class":"reduced","version":2,` +
+		`"variants":[{"id":5,"sku":"7780-S"},{"id":6,"sku":"7780-M"}],"price_tiers":[{"min_quantity":100,"unit_price":"140"}]}`
+	// снимки до productSnapshot не содержали вариантов и оптовых цен
+	legacy := `{"id":1,"name":"Задник 7780","slug":"zadnik-7780","description":"Старое описание","price":"150","version":2}`
+	revisions := func() *storedRevisions {
+		return &storedRevisions{stored: map[int32]*domain.ProductRevision{
+			10: {ID: 10, ProductID: 1, Action: domain.RevisionActionUpdate, After: types.NullJSONText{JSONText: types.JSONText(full), Valid: true}},
+			11: {ID: 11, ProductID: 2, Action: domain.RevisionActionUpdate, After: types.NullJSONText{JSONText: types.JSONText(full), Valid: true}},
+			12: {ID: 12, ProductID: 1, Action: domain.RevisionActionDelete, Before: types.NullJSONText{JSONText: types.JSONText(full), Valid: true}},
+			13: {ID: 13, ProductID: 1, Action: domain.RevisionActionUpdate, After: types.NullJSONText{JSONText: types.JSONText(legacy), Valid: true}},
+		}}
+	}
+	lines := func() (*savedVariants, *savedTiers) {
+		variants := &savedVariants{current: []*domain.ProductVariant{{ID: 5, SKU: "7780-S-new"}, {ID: 7, SKU: "7780-L"}}}
+		tiers := &savedTiers{current: []*domain.PriceTier{{MinQuantity: 50, UnitPrice: decimal.NewFromInt(190)}}}
+		return variants, tiers
+	}
+
+	t.Run("restores the full snapshot", func(t *testing.T) {
+		revisions := revisions()
+		variants, tiers := lines()
+		slugs := &recordedSlugs{}
+		puc := &productUseCase{repo: &storedProducts{products: map[int32]*domain.Product{1: current()}}, revisions: revisions, variants: variants, tiers: tiers, slugs: slugs, tx: inlineTransactor{}}
+
+		restored, err := puc.RestoreRevision(context.Background(), 1, 10, 5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Задник 7780", restored.Name)
+		assert.Equal(t, "Старое описание", restored.Description)
+		assert.True(t, decimal.NewFromInt(150).Equal(restored.Price))
+		assert.Equal(t, domain.TaxClassReduced, restored.TaxClass)
+		assert.Equal(t, "zadnik-7780", restored.Slug)
+		assert.Equal(t, []string{"zadnik-7780-novyi"}, slugs.recorded)
+		assert.Equal(t, int32(6), restored.Version)
+		// вариант 5 существует и обновляется, удалённый вариант 6 создаётся заново
+		if assert.Len(t, variants.current, 2) {
+			assert.Equal(t, int32(5), variants.current[0].ID)
+			assert.Equal(t, "7780-S", variants.current[0].SKU)
+			assert.Equal(t, int32(0), variants.current[1].ID)
+		}
+		if assert.Len(t, tiers.current, 1) {
+			assert.Equal(t, int32(100), tiers.current[0].MinQuantity)
+		}
+		if assert.Len(t, revisions.revisions, 1) {
+			assert.Equal(t, domain.RevisionActionRollback, revisions.revisions[0].Action)
+			assert.Contains(t, string(revisions.revisions[0].Before.JSONText), "7780-L")
+			assert.Contains(t, string(revisions.revisions[0].After.JSONText), "Старое описание")
+		}
+	})
+
+	t.Run("snapshot without lines keeps variants and tiers", func(t *testing.T) {
+		variants, tiers := lines()
+		puc := &productUseCase{repo: &storedProducts{products: map[int32]*domain.Product{1: current()}}, revisions: revisions(), variants: variants, tiers: tiers, slugs: &recordedSlugs{}, tx: inlineTransactor{}}
+
+		restored, err := puc.RestoreRevision(context.Background(), 1, 13, 5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Задник 7780", restored.Name)
+		assert.Equal(t, domain.TaxClassStandard, restored.TaxClass)
+		assert.Equal(t, "7780-L", variants.current[1].SKU)
+		assert.Equal(t, int32(50), tiers.current[0].MinQuantity)
+	})
+
+	tests := []struct {
+		name       string
+		revisionID int32
+		version    int32
+		wantErr    error
+	}{
+		{name: "revision of another product", revisionID: 11, version: 5, wantErr: domain.ErrRevisionNotFound},
+		{name: "unknown revision", revisionID: 99, version: 5, wantErr: domain.ErrRevisionNotFound},
+		{name: "deleted state", revisionID: 12, version: 5, wantErr: domain.ErrRevisionNotRestorable},
+		{name: "stale version", revisionID: 10, version: 4, wantErr: domain.ErrVersionConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revisions := revisions()
+			variants, tiers := lines()
+			products := &storedProducts{products: map[int32]*domain.Product{1: current()}}
+			puc := &productUseCase{repo: products, revisions: revisions, variants: variants, tiers: tiers, slugs: &recordedSlugs{}, tx: inlineTransactor{}}
+
+			_, err := puc.RestoreRevision(context.Background(), 1, tt.revisionID, tt.version)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, revisions.revisions)
+			assert.Equal(t, current(), products.products[1])
+		})
+	}
+}
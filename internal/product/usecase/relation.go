@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const (
+	maxProductRelations        = 50
+	defaultRecommendationLimit = 8
+	maxRecommendationLimit     = 24
+)
+
+func (puc *productUseCase) GetRelations(ctx context.Context, productID int32) ([]*domain.ProductRelation, error) {
+	if _, err := puc.repo.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return puc.relations.GetByProduct(ctx, productID)
+}
+
+// SetRelations заменяет ручные связи товара; порядок в списке становится порядком вывода
+func (puc *productUseCase) SetRelations(ctx context.Context, productID int32, relations []*domain.ProductRelation) ([]*domain.ProductRelation, error) {
+	if _, err := puc.repo.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	if err := normalizeRelations(productID, relations); err != nil {
+		return nil, err
+	}
+
+	for _, relation := range relations {
+		_, err := puc.repo.GetByID(ctx, relation.RelatedID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: product %d not found", domain.ErrRelationInvalid, relation.RelatedID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get related product %d: %w", relation.RelatedID, err)
+		}
+	}
+
+	if err := puc.relations.Replace(ctx, productID, relations); err != nil {
+		return nil, err
+	}
+	return puc.relations.GetByProduct(ctx, productID)
+}
+
+// GetRecommendations возвращает опубликованные товары для страницы productID: сначала ручные связи,
+// а оставшиеся до limit места заполняет похожими товарами
+func (puc *productUseCase) GetRecommendations(ctx context.Context, productID int32, limit int) ([]*domain.Recommendation, error) {
+	if limit == 0 {
+		limit = defaultRecommendationLimit
+	}
+	if limit < 1 || limit > maxRecommendationLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrInvalidQuery, maxRecommendationLimit)
+	}
+	if _, err := puc.repo.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+
+	relations, err := puc.relations.GetByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	kinds := make(map[int32]domain.RelationKind, len(relations))
+	// скрытый сейчас товар из ручных связей не должен вернуться через похожие
+	exclude := make([]int32, len(relations))
+	for i, relation := range relations {
+		kinds[relation.RelatedID] = relation.Kind
+		exclude[i] = relation.RelatedID
+	}
+
+	related, err := puc.relations.GetRelatedProducts(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if len(related) > limit {
+		related = related[:limit]
+	}
+
+	var similar []*domain.Product
+	if len(related) < limit {
+		if similar, err = puc.relations.GetSimilar(ctx, productID, exclude, limit-len(related)); err != nil {
+			return nil, err
+		}
+	}
+
+	products := append(related, similar...)
+	if err := puc.attachDetails(ctx, products); err != nil {
+		return nil, err
+	}
+
+	recommendations := make([]*domain.Recommendation, len(products))
+	for i, product := range products {
+		if i < len(related) {
+			recommendations[i] = &domain.Recommendation{Kind: kinds[product.ID], Source: domain.RecommendationSourceManual, Product: product}
+		} else {
+			recommendations[i] = &domain.Recommendation{Kind: domain.RelationKindRelated, Source: domain.RecommendationSourceSimilar, Product: product}
+		}
+	}
+	return recommendations, nil
+}
+
+// normalizeRelations проверяет ручные связи и нумерует их по порядку
+func normalizeRelations(productID int32, relations []*domain.ProductRelation) error {
+	if len(relations) > maxProductRelations {
+		return fmt.Errorf("%w: at most %d relations per product", domain.ErrRelationInvalid, maxProductRelations)
+	}
+
+	seen := make(map[int32]bool, len(relations))
+	for i, relation := range relations {
+		if !relation.Kind.IsValid() {
+			return fmt.Errorf("%w: unknown kind %q", domain.ErrRelationInvalid, relation.Kind)
+		}
+		if relation.RelatedID == productID {
+			return fmt.Errorf("%w: product cannot be related to itself", domain.ErrRelationInvalid)
+		}
+		if seen[relation.RelatedID] {
+			return fmt.Errorf("%w: duplicate product %d", domain.ErrRelationInvalid, relation.RelatedID)
+		}
+		seen[relation.RelatedID] = true
+
+		relation.ProductID = productID
+		relation.Position = int32(i)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeRelations(t *testing.T) {
+	t.Run("numbers relations in order", func(t *testing.T) {
+		relations := []*domain.ProductRelation{
+			{RelatedID: 5, Kind: domain.RelationKindAccessory},
+			{RelatedID: 3, Kind: domain.RelationKindAlternative},
+		}
+
+		assert.NoError(t, normalizeRelations(1, relations))
+		assert.Equal(t, int32(1), relations[1].ProductID)
+		assert.Equal(t, int32(0), relations[0].Position)
+		assert.Equal(t, int32(1), relations[1].Position)
+	})
+
+	t.Run("related to itself", func(t *testing.T) {
+		relations := []*domain.ProductRelation{{RelatedID: 1, Kind: domain.RelationKindRelated}}
+
+		assert.ErrorIs(t, normalizeRelations(1, relations), domain.ErrRelationInvalid)
+	})
+
+	t.Run("duplicate product", func(t *testing.T) {
+		relations := []*domain.ProductRelation{
+			{RelatedID: 2, Kind: domain.RelationKindRelated},
+			{RelatedID: 2, Kind: domain.RelationKindAccessory},
+		}
+
+		assert.ErrorIs(t, normalizeRelations(1, relations), domain.ErrRelationInvalid)
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		relations := []*domain.ProductRelation{{RelatedID: 2, Kind: "upsell"}}
+
+		assert.ErrorIs(t, normalizeRelations(1, relations), domain.ErrRelationInvalid)
+	})
+}
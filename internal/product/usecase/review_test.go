@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeReview(t *testing.T) {
+	t.Run("trims fields", func(t *testing.T) {
+		review := &domain.Review{AuthorName: "  Ирина ", Company: " ООО Обувь ", Rating: 5, Text: "  Задники держат форму  "}
+
+		assert.NoError(t, normalizeReview(review))
+		assert.Equal(t, "Ирина", review.AuthorName)
+		assert.Equal(t, "ООО Обувь", review.Company)
+		assert.Equal(t, "Задники держат форму", review.Text)
+	})
+
+	t.Run("rating out of range", func(t *testing.T) {
+		for _, rating := range []int32{0, 6} {
+			review := &domain.Review{AuthorName: "Ирина", Rating: rating, Text: "Задники держат форму"}
+
+			assert.ErrorIs(t, normalizeReview(review), domain.ErrReviewInvalid)
+		}
+	})
+
+	t.Run("empty author", func(t *testing.T) {
+		review := &domain.Review{AuthorName: "   ", Rating: 4, Text: "Задники держат форму"}
+
+		assert.ErrorIs(t, normalizeReview(review), domain.ErrReviewInvalid)
+	})
+
+	t.Run("text length counts characters", func(t *testing.T) {
+		short := &domain.Review{AuthorName: "Ирина", Rating: 4, Text: "Хорошо"}
+		long := &domain.Review{AuthorName: "Ирина", Rating: 4, Text: strings.Repeat("я", maxReviewTextLength+1)}
+		exact := &domain.Review{AuthorName: "Ирина", Rating: 4, Text: strings.Repeat("я", maxReviewTextLength)}
+
+		assert.ErrorIs(t, normalizeReview(short), domain.ErrReviewInvalid)
+		assert.ErrorIs(t, normalizeReview(long), domain.ErrReviewInvalid)
+		assert.NoError(t, normalizeReview(exact))
+	})
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const (
+	maxReviewAuthorLength  = 100
+	maxReviewCompanyLength = 200
+	minReviewTextLength    = 10
+	maxReviewTextLength    = 3000
+	maxReviewReplyLength   = 3000
+	// reviewRepeatInterval - с одного адреса принимается один отзыв на товар за это время
+	reviewRepeatInterval = 24 * time.Hour
+)
+
+type ReviewUseCase interface {
+	// Create принимает отзыв покупателя на опубликованный товар; отзыв ждёт модерации
+	Create(ctx context.Context, review *domain.Review) (*domain.Review, error)
+	GetAll(ctx context.Context, query domain.ReviewQuery) (*domain.ReviewPage, error)
+	Moderate(ctx context.Context, moderation *domain.ReviewModeration) (*domain.Review, error)
+}
+
+type reviewUseCase struct {
+	repo     domain.ProductReviewRepository
+	products domain.ProductRepository
+}
+
+func NewReviewUseCase(repo domain.ProductReviewRepository, products domain.ProductRepository) ReviewUseCase {
+	return &reviewUseCase{repo: repo, products: products}
+}
+
+func (ruc *reviewUseCase) Create(ctx context.Context, review *domain.Review) (*domain.Review, error) {
+	if err := normalizeReview(review); err != nil {
+		return nil, err
+	}
+
+	product, err := ruc.products.GetByID(ctx, review.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", review.ProductID, err)
+	}
+	now := time.Now()
+	if !product.IsPublished(now) {
+		return nil, fmt.Errorf("%w: product %d is not published", domain.ErrReviewInvalid, review.ProductID)
+	}
+
+	if review.AuthorKey != "" {
+		count, err := ruc.repo.CountRecent(ctx, review.ProductID, review.AuthorKey, now.Add(-reviewRepeatInterval))
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, domain.ErrReviewTooFrequent
+		}
+	}
+
+	review.Status = domain.ReviewStatusPending
+	return ruc.repo.Create(ctx, review)
+}
+
+func (ruc *reviewUseCase) GetAll(ctx context.Context, query domain.ReviewQuery) (*domain.ReviewPage, error) {
+	if query.Status != "" && !query.Status.IsValid() {
+		return nil, fmt.Errorf("%w: unknown status %q", domain.ErrInvalidQuery, query.Status)
+	}
+	if err := normalizePage(&query.Limit, query.Offset); err != nil {
+		return nil, err
+	}
+
+	reviews, total, err := ruc.repo.GetAll(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ReviewPage{Items: reviews, Total: total, Limit: query.Limit, Offset: query.Offset}, nil
+}
+
+// Moderate одобряет или отклоняет отзыв и сохраняет ответ магазина
+func (ruc *reviewUseCase) Moderate(ctx context.Context, moderation *domain.ReviewModeration) (*domain.Review, error) {
+	moderation.Reply = strings.TrimSpace(moderation.Reply)
+	if moderation.Status != domain.ReviewStatusApproved && moderation.Status != domain.ReviewStatusRejected {
+		return nil, fmt.Errorf("%w: status must be approved or rejected", domain.ErrReviewInvalid)
+	}
+	if utf8.RuneCountInString(moderation.Reply) > maxReviewReplyLength {
+		return nil, fmt.Errorf("%w: reply is longer than %d characters", domain.ErrReviewInvalid, maxReviewReplyLength)
+	}
+
+	moderation.UserID = domain.UserIDFromContext(ctx)
+	return ruc.repo.Moderate(ctx, moderation)
+}
+
+// normalizeReview обрезает пробелы и проверяет поля отзыва покупателя
+func normalizeReview(review *domain.Review) error {
+	review.AuthorName = strings.TrimSpace(review.AuthorName)
+	review.Company = strings.TrimSpace(review.Company)
+	review.Text = strings.TrimSpace(review.Text)
+
+	switch {
+	case review.AuthorName == "":
+		return fmt.Errorf("%w: author name is empty", domain.ErrReviewInvalid)
+	case utf8.RuneCountInString(review.AuthorName) > maxReviewAuthorLength:
+		return fmt.Errorf("%w: author name is longer than %d characters", domain.ErrReviewInvalid, maxReviewAuthorLength)
+	case utf8.RuneCountInString(review.Company) > maxReviewCompanyLength:
+		return fmt.Errorf("%w: company is longer than %d characters", domain.ErrReviewInvalid, maxReviewCompanyLength)
+	case review.Rating < domain.MinReviewRating || review.Rating > domain.MaxReviewRating:
+		return fmt.Errorf("%w: rating must be between %d and %d", domain.ErrReviewInvalid, domain.MinReviewRating, domain.MaxReviewRating)
+	case utf8.RuneCountInString(review.Text) < minReviewTextLength:
+		return fmt.Errorf("%w: text is shorter than %d characters", domain.ErrReviewInvalid, minReviewTextLength)
+	case utf8.RuneCountInString(review.Text) > maxReviewTextLength:
+		return fmt.Errorf("%w: text is longer than %d characters", domain.ErrReviewInvalid, maxReviewTextLength)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+// maxSlugSuffix ограничивает перебор суффиксов -2, -3, ... при поиске свободного адреса
+const maxSlugSuffix = 1000
+
+// defaultSlug - основа адреса для названий без букв и цифр
+const defaultSlug = "product"
+
+// GetBySlug возвращает товар по текущему или прежнему адресу; по Slug ответа видно, нужен ли редирект
+func (puc *productUseCase) GetBySlug(ctx context.Context, slug string) (*domain.Product, error) {
+	productID, err := puc.slugs.Resolve(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	return puc.GetByID(ctx, productID)
+}
+
+// uniqueSlug добавляет к адресу числовой суффикс, пока он занят другим товаром; productID == 0 - новый товар
+func (puc *productUseCase) uniqueSlug(ctx context.Context, base string, productID int32) (string, error) {
+	if base == "" {
+		base = defaultSlug
+	}
+
+	slug := base
+	for suffix := 2; suffix <= maxSlugSuffix; suffix++ {
+		taken, err := puc.slugs.IsTaken(ctx, slug, productID)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return slug, nil
+		}
+		slug = fmt.Sprintf("%s-%d", base, suffix)
+	}
+	return "", fmt.Errorf("no free slug for %q", base)
+}
+
+// updateSlug меняет адрес только по явному запросу: пустой Slug - сформировать заново из названия,
+// иной, чем before.Slug, - привести к допустимому виду и занять
+func (puc *productUseCase) updateSlug(ctx context.Context, product, before *domain.Product) error {
+	if product.Slug == before.Slug {
+		return nil
+	}
+
+	base := common.GenerateSlug(product.Slug)
+	if base == "" {
+		base = common.GenerateSlug(product.Name)
+	}
+	if base == before.Slug {
+		product.Slug = before.Slug
+		return nil
+	}
+
+	slug, err := puc.uniqueSlug(ctx, base, product.ID)
+	if err != nil {
+		return err
+	}
+	product.Slug = slug
+	return nil
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// takenSlugs - адреса, занятые другими товарами
+type takenSlugs struct {
+	domain.ProductSlugRepository
+	taken map[string]bool
+}
+
+func (r *takenSlugs) IsTaken(ctx context.Context, slug string, productID int32) (bool, error) {
+	return r.taken[slug], nil
+}
+
+// recordedSlugs запоминает прежние адреса товаров или отвечает ошибкой err
+type recordedSlugs struct {
+	takenSlugs
+	recorded []string
+	err      error
+}
+
+func (r *recordedSlugs) Record(ctx context.Context, productID int32, oldSlug, newSlug string) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.recorded = append(r.recorded, oldSlug)
+	return nil
+}
+
+func TestGenerateSlug(t *testing.T) {
+	cases := map[string]string{
+		"90-И":  "90-i",
+		"Ботос": "botos",
+		"Стелька + полустелька":     "stelka-polustelka",
+		"Щётка для обуви":           "shhyotka-dlya-obuvi",
+		"Цинк и кольцо":             "cink-i-kolczo",
+		"Задник 1.5 мм (кожкартон)": "zadnik-1-5-mm-kozhkarton",
+		"  ---  ": "",
+	}
+	for name, want := range cases {
+		assert.Equal(t, want, common.GenerateSlug(name), name)
+	}
+}
+
+func TestUpdateSlug(t *testing.T) {
+	before := &domain.Product{ID: 7, Name: "Ботос", Slug: "botos"}
+	puc := &productUseCase{slugs: &takenSlugs{taken: map[string]bool{"malaj": true, "malaj-2": true}}}
+
+	t.Run("unchanged slug is kept", func(t *testing.T) {
+		product := &domain.Product{ID: 7, Name: "Малай", Slug: "botos"}
+
+		assert.NoError(t, puc.updateSlug(context.Background(), product, before))
+		assert.Equal(t, "botos", product.Slug)
+	})
+
+	t.Run("regenerated slug gets numeric suffix", func(t *testing.T) {
+		product := &domain.Product{ID: 7, Name: "Малай", Slug: ""}
+
+		assert.NoError(t, puc.updateSlug(context.Background(), product, before))
+		assert.Equal(t, "malaj-3", product.Slug)
+	})
+
+	t.Run("explicit slug is normalized", func(t *testing.T) {
+		product := &domain.Product{ID: 7, Name: "Ботос", Slug: "Ботос Люкс"}
+
+		assert.NoError(t, puc.updateSlug(context.Background(), product, before))
+		assert.Equal(t, "botos-lyuks", product.Slug)
+	})
+}
+
+type inTxKey struct{}
+
+// markingTransactor помечает контекст транзакции, чтобы проверить, что работа идёт внутри неё
+type markingTransactor struct{}
+
+func (markingTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, inTxKey{}, true))
+}
+
+// txCheckedSlugs запоминает, шла ли проверка адресов внутри транзакции
+type txCheckedSlugs struct {
+	recordedSlugs
+	outsideTx int
+}
+
+func (r *txCheckedSlugs) IsTaken(ctx context.Context, slug string, productID int32) (bool, error) {
+	if ctx.Value(inTxKey{}) == nil {
+		r.outsideTx++
+	}
+	return r.takenSlugs.IsTaken(ctx, slug, productID)
+}
+
+func TestUpdateRecordsSlugHistory(t *testing.T) {
+	product := func() *domain.Product {
+		return &domain.Product{ID: 7, Name: "Ботос", Slug: "botos", Price: decimal.NewFromInt(90), TaxClass: domain.TaxClassStandard, Version: 1}
+	}
+
+	t.Run("old slug is kept for redirect", func(t *testing.T) {
+		slugs := &recordedSlugs{}
+		revisions := &revisionRecorder{}
+		puc := &productUseCase{repo: &storedProducts{products: map[int32]*domain.Product{7: product()}}, revisions: revisions, slugs: slugs, variants: &savedVariants{}, tiers: &savedTiers{}, tx: inlineTransactor{}}
+		update := product()
+		update.Slug = "botos-lyuks"
+
+		after, err := puc.Update(context.Background(), update)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "botos-lyuks", after.Slug)
+		assert.Equal(t, []string{"botos"}, slugs.recorded)
+		assert.Len(t, revisions.revisions, 1)
+	})
+
+	t.Run("slug is chosen inside the transaction", func(t *testing.T) {
+		slugs := &txCheckedSlugs{}
+		puc := &productUseCase{repo: &storedProducts{products: map[int32]*domain.Product{7: product()}}, revisions: &revisionRecorder{}, slugs: slugs, variants: &savedVariants{}, tiers: &savedTiers{}, tx: markingTransactor{}}
+		update := product()
+		update.Slug = "botos-lyuks"
+
+		_, err := puc.Update(context.Background(), update)
+
+		assert.NoError(t, err)
+		assert.Zero(t, slugs.outsideTx)
+	})
+
+	t.Run("history error fails update", func(t *testing.T) {
+		slugs := &recordedSlugs{err: errors.New("connection reset")}
+		revisions := &revisionRecorder{}
+		puc := &productUseCase{repo: &storedProducts{products: map[int32]*domain.Product{7: product()}}, revisions: revisions, slugs: slugs, variants: &savedVariants{}, tiers: &savedTiers{}, tx: inlineTransactor{}}
+		update := product()
+		update.Slug = "botos-lyuks"
+
+		_, err := puc.Update(context.Background(), update)
+
+		assert.Error(t, err)
+		assert.Empty(t, revisions.revisions)
+	})
+}
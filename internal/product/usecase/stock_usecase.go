@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+const (
+	defaultStockMovementsLimit = 50
+	maxStockMovementsLimit     = 500
+)
+
+type StockUseCase interface {
+	GetStock(ctx context.Context, productID int32) ([]*domain.StockItem, error)
+	GetMovements(ctx context.Context, productID int32, limit int) ([]*domain.StockMovement, error)
+	Move(ctx context.Context, movement *domain.StockMovement) (*domain.StockItem, error)
+	SetThreshold(ctx context.Context, productID int32, variantID *int32, threshold int32) (*domain.StockItem, error)
+	// Reserve резервирует остаток под заявку на StockReservationTTL
+	Reserve(ctx context.Context, reservation *domain.StockReservation) (*domain.StockReservation, error)
+	GetReservations(ctx context.Context, productID int32) ([]*domain.StockReservation, error)
+	// ConfirmReservation принимает заявку: остаток остаётся в резерве до отгрузки
+	ConfirmReservation(ctx context.Context, productID, reservationID int32) (*domain.StockReservation, error)
+	// ReleaseReservation отклоняет заявку и возвращает остаток из резерва
+	ReleaseReservation(ctx context.Context, productID, reservationID int32) (*domain.StockReservation, error)
+	// ExpireReservations вызывается планировщиком и возвращает снятые резервы
+	ExpireReservations(ctx context.Context, now time.Time) ([]*domain.StockReservation, error)
+}
+
+type stockUseCase struct {
+	repo     domain.StockRepository
+	products domain.ProductRepository
+	variants domain.ProductVariantRepository
+	notifier domain.StockNotifier
+}
+
+func NewStockUseCase(repo domain.StockRepository, products domain.ProductRepository, variants domain.ProductVariantRepository, notifier domain.StockNotifier) StockUseCase {
+	return &stockUseCase{repo: repo, products: products, variants: variants, notifier: notifier}
+}
+
+func (suc *stockUseCase) GetStock(ctx context.Context, productID int32) ([]*domain.StockItem, error) {
+	if _, err := suc.products.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return suc.repo.GetByProduct(ctx, productID)
+}
+
+func (suc *stockUseCase) GetMovements(ctx context.Context, productID int32, limit int) ([]*domain.StockMovement, error) {
+	if limit <= 0 {
+		limit = defaultStockMovementsLimit
+	}
+	if limit > maxStockMovementsLimit {
+		limit = maxStockMovementsLimit
+	}
+	if _, err := suc.products.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return suc.repo.GetMovements(ctx, productID, limit)
+}
+
+// Move проводит движение по остатку и уведомляет администраторов, если доступный остаток опустился до порога
+func (suc *stockUseCase) Move(ctx context.Context, movement *domain.StockMovement) (*domain.StockItem, error) {
+	movement.Reference = strings.TrimSpace(movement.Reference)
+	movement.Comment = strings.TrimSpace(movement.Comment)
+	if !isStockMovementKind(movement.Kind) {
+		return nil, fmt.Errorf("%w: unknown kind %q", domain.ErrStockInvalid, movement.Kind)
+	}
+
+	product, sku, err := suc.stockTarget(ctx, movement.ProductID, movement.VariantID)
+	if err != nil {
+		return nil, err
+	}
+	movement.UserID = domain.UserIDFromContext(ctx)
+
+	before, after, err := suc.repo.Move(ctx, movement)
+	if err != nil {
+		return nil, err
+	}
+
+	if crossedLowStock(before, after) {
+		suc.notifier.NotifyLowStock(ctx, product, sku, after)
+	}
+	return after, nil
+}
+
+func (suc *stockUseCase) SetThreshold(ctx context.Context, productID int32, variantID *int32, threshold int32) (*domain.StockItem, error) {
+	if threshold < 0 {
+		return nil, fmt.Errorf("%w: threshold must not be negative", domain.ErrStockInvalid)
+	}
+	if _, _, err := suc.stockTarget(ctx, productID, variantID); err != nil {
+		return nil, err
+	}
+	return suc.repo.SetThreshold(ctx, productID, variantID, threshold)
+}
+
+func (suc *stockUseCase) Reserve(ctx context.Context, reservation *domain.StockReservation) (*domain.StockReservation, error) {
+	reservation.Reference = strings.TrimSpace(reservation.Reference)
+	if reservation.Quantity <= 0 {
+		return nil, fmt.Errorf("%w: quantity of reservation must be positive", domain.ErrStockInvalid)
+	}
+
+	product, sku, err := suc.stockTarget(ctx, reservation.ProductID, reservation.VariantID)
+	if err != nil {
+		return nil, err
+	}
+	reservation.ExpiresAt = time.Now().Add(domain.StockReservationTTL)
+
+	before, after, err := suc.repo.Reserve(ctx, reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	if crossedLowStock(before, after) {
+		suc.notifier.NotifyLowStock(ctx, product, sku, after)
+	}
+	return reservation, nil
+}
+
+func (suc *stockUseCase) GetReservations(ctx context.Context, productID int32) ([]*domain.StockReservation, error) {
+	if _, err := suc.products.GetByID(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return suc.repo.GetReservations(ctx, productID)
+}
+
+func (suc *stockUseCase) ConfirmReservation(ctx context.Context, productID, reservationID int32) (*domain.StockReservation, error) {
+	return suc.repo.CloseReservation(ctx, productID, reservationID, domain.StockReservationConfirmed, domain.UserIDFromContext(ctx))
+}
+
+func (suc *stockUseCase) ReleaseReservation(ctx context.Context, productID, reservationID int32) (*domain.StockReservation, error) {
+	return suc.repo.CloseReservation(ctx, productID, reservationID, domain.StockReservationReleased, domain.UserIDFromContext(ctx))
+}
+
+func (suc *stockUseCase) ExpireReservations(ctx context.Context, now time.Time) ([]*domain.StockReservation, error) {
+	return suc.repo.ExpireReservations(ctx, now)
+}
+
+// stockTarget проверяет, что товар существует и вариант принадлежит ему; возвращает товар и артикул варианта
+func (suc *stockUseCase) stockTarget(ctx context.Context, productID int32, variantID *int32) (*domain.Product, string, error) {
+	product, err := suc.products.GetByID(ctx, productID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	if variantID == nil {
+		return product, "", nil
+	}
+
+	variants, err := suc.variants.GetByProduct(ctx, productID)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, variant := range variants {
+		if variant.ID == *variantID {
+			return product, variant.SKU, nil
+		}
+	}
+	return nil, "", fmt.Errorf("%w: variant %d does not belong to product %d", domain.ErrStockInvalid, *variantID, productID)
+}
+
+func isStockMovementKind(kind domain.StockMovementKind) bool {
+	for _, known := range domain.StockMovementKinds {
+		if kind == known {
+			return true
+		}
+	}
+	return false
+}
+
+// crossedLowStock срабатывает один раз при переходе через порог, а не на каждом движении ниже него
+func crossedLowStock(before, after *domain.StockItem) bool {
+	if after.LowStockThreshold <= 0 {
+		return false
+	}
+	return before.Available() > after.LowStockThreshold && after.Available() <= after.LowStockThreshold
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStockItemApply(t *testing.T) {
+	item := domain.StockItem{OnHand: 10, Reserved: 4}
+
+	t.Run("reservation within available", func(t *testing.T) {
+		after, err := item.Apply(domain.StockMovementReservation, 6)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(10), after.Reserved)
+		assert.Equal(t, int32(0), after.Available())
+	})
+
+	t.Run("reservation over available", func(t *testing.T) {
+		_, err := item.Apply(domain.StockMovementReservation, 7)
+
+		assert.ErrorIs(t, err, domain.ErrStockInsufficient)
+	})
+
+	t.Run("shipment takes from reserve", func(t *testing.T) {
+		after, err := item.Apply(domain.StockMovementShipment, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(7), after.OnHand)
+		assert.Equal(t, int32(1), after.Reserved)
+	})
+
+	t.Run("negative adjustment below reserve", func(t *testing.T) {
+		_, err := item.Apply(domain.StockMovementAdjustment, -7)
+
+		assert.ErrorIs(t, err, domain.ErrStockInsufficient)
+	})
+
+	t.Run("negative receipt", func(t *testing.T) {
+		_, err := item.Apply(domain.StockMovementReceipt, -1)
+
+		assert.ErrorIs(t, err, domain.ErrStockInvalid)
+	})
+}
+
+func TestCrossedLowStock(t *testing.T) {
+	t.Run("crosses threshold", func(t *testing.T) {
+		before := &domain.StockItem{OnHand: 10, LowStockThreshold: 5}
+		after := &domain.StockItem{OnHand: 10, Reserved: 5, LowStockThreshold: 5}
+
+		assert.True(t, crossedLowStock(before, after))
+	})
+
+	t.Run("already low", func(t *testing.T) {
+		before := &domain.StockItem{OnHand: 4, LowStockThreshold: 5}
+		after := &domain.StockItem{OnHand: 3, LowStockThreshold: 5}
+
+		assert.False(t, crossedLowStock(before, after))
+	})
+
+	t.Run("threshold disabled", func(t *testing.T) {
+		before := &domain.StockItem{OnHand: 10}
+		after := &domain.StockItem{OnHand: 0}
+
+		assert.False(t, crossedLowStock(before, after))
+	})
+}
+
+// reservingStock резервирует с заданного остатка без базы
+type reservingStock struct {
+	domain.StockRepository
+	item     domain.StockItem
+	reserved *domain.StockReservation
+}
+
+func (r *reservingStock) Reserve(ctx context.Context, reservation *domain.StockReservation) (*domain.StockItem, *domain.StockItem, error) {
+	before := r.item
+	after, err := before.Apply(domain.StockMovementReservation, reservation.Quantity)
+	if err != nil {
+		return nil, nil, err
+	}
+	r.item, r.reserved = after, reservation
+	reservation.Status = domain.StockReservationActive
+	return &before, &after, nil
+}
+
+type lowStockNotifier struct {
+	notified []*domain.StockItem
+}
+
+func (n *lowStockNotifier) NotifyLowStock(ctx context.Context, product *domain.Product, sku string, item *domain.StockItem) {
+	n.notified = append(n.notified, item)
+}
+
+func TestReserve(t *testing.T) {
+	products := &storedProducts{products: map[int32]*domain.Product{1: {ID: 1, Name: "Кровать"}}}
+
+	t.Run("reservation expires after ttl", func(t *testing.T) {
+		stock := &reservingStock{item: domain.StockItem{OnHand: 10}}
+		uc := NewStockUseCase(stock, products, nil, &lowStockNotifier{})
+
+		reservation, err := uc.Reserve(context.Background(), &domain.StockReservation{ProductID: 1, Quantity: 2, Reference: " заявка "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "заявка", reservation.Reference)
+		assert.Equal(t, domain.StockReservationActive, reservation.Status)
+		assert.WithinDuration(t, time.Now().Add(domain.StockReservationTTL), reservation.ExpiresAt, time.Minute)
+		assert.Equal(t, int32(2), stock.item.Reserved)
+	})
+
+	t.Run("notifies when reservation crosses threshold", func(t *testing.T) {
+		stock := &reservingStock{item: domain.StockItem{OnHand: 10, LowStockThreshold: 5}}
+		notifier := &lowStockNotifier{}
+		uc := NewStockUseCase(stock, products, nil, notifier)
+
+		_, err := uc.Reserve(context.Background(), &domain.StockReservation{ProductID: 1, Quantity: 6})
+
+		assert.NoError(t, err)
+		assert.Len(t, notifier.notified, 1)
+	})
+
+	t.Run("rejects non-positive quantity", func(t *testing.T) {
+		stock := &reservingStock{item: domain.StockItem{OnHand: 10}}
+		uc := NewStockUseCase(stock, products, nil, &lowStockNotifier{})
+
+		_, err := uc.Reserve(context.Background(), &domain.StockReservation{ProductID: 1})
+
+		assert.ErrorIs(t, err, domain.ErrStockInvalid)
+		assert.Nil(t, stock.reserved)
+	})
+
+	t.Run("more than available", func(t *testing.T) {
+		stock := &reservingStock{item: domain.StockItem{OnHand: 1}}
+		uc := NewStockUseCase(stock, products, nil, &lowStockNotifier{})
+
+		_, err := uc.Reserve(context.Background(), &domain.StockReservation{ProductID: 1, Quantity: 2})
+
+		assert.ErrorIs(t, err, domain.ErrStockInsufficient)
+	})
+}
+
+func TestReservationReleaseQuantity(t *testing.T) {
+	reservation := domain.StockReservation{Quantity: 3}
+
+	t.Run("whole reservation", func(t *testing.T) {
+		assert.Equal(t, int32(3), reservation.ReleaseQuantity(domain.StockItem{OnHand: 10, Reserved: 5}))
+	})
+
+	t.Run("reserve already shipped in part", func(t *testing.T) {
+		assert.Equal(t, int32(1), reservation.ReleaseQuantity(domain.StockItem{OnHand: 10, Reserved: 1}))
+	})
+
+	t.Run("nothing left in reserve", func(t *testing.T) {
+		assert.Equal(t, int32(0), reservation.ReleaseQuantity(domain.StockItem{OnHand: 10}))
+	})
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxModePrice(t *testing.T) {
+	t.Run("standard rate", func(t *testing.T) {
+		pricing := domain.TaxModeVAT.Price(decimal.NewFromInt(1200), domain.TaxClassStandard)
+
+		assert.Equal(t, int32(20), pricing.VATRate)
+		assert.Equal(t, "1000.00", pricing.Net.StringFixed(2))
+		assert.Equal(t, "200.00", pricing.VAT.StringFixed(2))
+	})
+
+	t.Run("net is rounded to kopecks", func(t *testing.T) {
+		pricing := domain.TaxModeVAT.Price(decimal.NewFromInt(100), domain.TaxClassStandard)
+
+		assert.Equal(t, "83.33", pricing.Net.StringFixed(2))
+		assert.Equal(t, "16.67", pricing.VAT.StringFixed(2))
+	})
+
+	t.Run("reduced rate", func(t *testing.T) {
+		pricing := domain.TaxModeVAT.Price(decimal.NewFromInt(110), domain.TaxClassReduced)
+
+		assert.Equal(t, int32(10), pricing.VATRate)
+		assert.Equal(t, "100.00", pricing.Net.StringFixed(2))
+	})
+
+	t.Run("exempt product and seller without VAT", func(t *testing.T) {
+		for _, pricing := range []domain.Pricing{
+			domain.TaxModeVAT.Price(decimal.NewFromInt(500), domain.TaxClassExempt),
+			domain.TaxModeNone.Price(decimal.NewFromInt(500), domain.TaxClassStandard),
+		} {
+			assert.Equal(t, int32(0), pricing.VATRate)
+			assert.True(t, pricing.VAT.IsZero())
+			assert.Equal(t, "500", pricing.Net.String())
+		}
+	})
+}
+
+func TestFormatRubles(t *testing.T) {
+	assert.Equal(t, "950\u00a0₽", common.FormatRubles(decimal.NewFromInt(950)))
+	assert.Equal(t, "1500\u00a0₽", common.FormatRubles(decimal.NewFromInt(1500)))
+	assert.Equal(t, "12\u2009345,50\u00a0₽", common.FormatRubles(decimal.RequireFromString("12345.5")))
+	assert.Equal(t, "1\u2009234\u2009567\u00a0₽", common.FormatRubles(decimal.NewFromInt(1234567)))
+	assert.Equal(t, "−16,67\u00a0₽", common.FormatRubles(decimal.RequireFromString("-16.666")))
+}
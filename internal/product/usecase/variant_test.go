@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/Nzyazin/zadnik.store/internal/broker"
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeVariants(t *testing.T) {
+	t.Run("trims sku and drops empty options", func(t *testing.T) {
+		variants := []*domain.ProductVariant{
+			{SKU: " ZD-15-38 ", Options: domain.VariantOptions{"толщина": " 1.5 мм ", "цвет": ""}},
+		}
+
+		err := normalizeVariants(variants)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ZD-15-38", variants[0].SKU)
+		assert.Equal(t, domain.VariantOptions{"толщина": "1.5 мм"}, variants[0].Options)
+	})
+
+	t.Run("duplicate sku", func(t *testing.T) {
+		variants := []*domain.ProductVariant{{SKU: "zd-1"}, {SKU: "ZD-1"}}
+
+		assert.ErrorIs(t, normalizeVariants(variants), domain.ErrVariantInvalid)
+	})
+
+	t.Run("invalid sku", func(t *testing.T) {
+		variants := []*domain.ProductVariant{{SKU: "задник 1"}}
+
+		assert.ErrorIs(t, normalizeVariants(variants), domain.ErrVariantInvalid)
+	})
+
+	t.Run("non-positive price", func(t *testing.T) {
+		variants := []*domain.ProductVariant{
+			{SKU: "ZD-1", Price: decimal.NullDecimal{Decimal: decimal.Zero, Valid: true}},
+		}
+
+		assert.ErrorIs(t, normalizeVariants(variants), domain.ErrVariantInvalid)
+	})
+}
+
+func TestVariantsFromEvent(t *testing.T) {
+	t.Run("nil means unchanged", func(t *testing.T) {
+		assert.Nil(t, VariantsFromEvent(nil))
+	})
+
+	t.Run("empty means remove all", func(t *testing.T) {
+		variants := VariantsFromEvent([]broker.ProductVariant{})
+
+		assert.NotNil(t, variants)
+		assert.Empty(t, variants)
+	})
+
+	t.Run("price override", func(t *testing.T) {
+		price := decimal.RequireFromString("12.50")
+
+		variants := VariantsFromEvent([]broker.ProductVariant{{SKU: "ZD-1", Price: &price}, {SKU: "ZD-2"}})
+
+		assert.True(t, variants[0].Price.Valid)
+		assert.True(t, variants[0].EffectivePrice(decimal.NewFromInt(10)).Equal(price))
+		assert.True(t, variants[1].EffectivePrice(decimal.NewFromInt(10)).Equal(decimal.NewFromInt(10)))
+	})
+}
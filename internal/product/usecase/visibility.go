@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+)
+
+func (puc *productUseCase) SetVisibility(ctx context.Context, change *domain.VisibilityChange) (*domain.Product, error) {
+	if err := normalizeVisibility(change, time.Now()); err != nil {
+		return nil, err
+	}
+
+	before, err := puc.repo.GetByID(ctx, change.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", change.ProductID, err)
+	}
+
+	var after *domain.Product
+	err = puc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if after, err = puc.visibility.Set(ctx, change); err != nil {
+			return err
+		}
+		return puc.recordRevision(ctx, change.ProductID, domain.RevisionActionVisibilityChange, before, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// ApplyVisibilitySchedule вызывается планировщиком и возвращает товары после перехода.
+// Переходы и их ревизии пишутся одной транзакцией: если ревизия не записалась, пакет повторится при следующем запуске
+func (puc *productUseCase) ApplyVisibilitySchedule(ctx context.Context, now time.Time) ([]*domain.Product, error) {
+	var products []*domain.Product
+	err := puc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		applied, err := puc.visibility.ApplyDue(ctx, now)
+		if err != nil {
+			return err
+		}
+
+		products = make([]*domain.Product, len(applied))
+		for i, change := range applied {
+			products[i] = change.After
+			if err := puc.recordRevision(ctx, change.After.ID, domain.RevisionActionVisibilityChange, change.Before, change.After); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// normalizeVisibility проверяет даты публикации: publish_at нужна только запланированному товару,
+// unpublish_at - запланированному или опубликованному. Лишние даты сбрасываются
+func normalizeVisibility(change *domain.VisibilityChange, now time.Time) error {
+	if !change.Visibility.IsValid() {
+		return fmt.Errorf("%w: %q", domain.ErrVisibilityInvalid, change.Visibility)
+	}
+
+	switch change.Visibility {
+	case domain.ProductVisibilityScheduled:
+		if change.PublishAt == nil {
+			return fmt.Errorf("%w: publish date is required for scheduled product", domain.ErrVisibilityInvalid)
+		}
+		if !change.PublishAt.After(now) {
+			return fmt.Errorf("%w: publish date must be in the future", domain.ErrVisibilityInvalid)
+		}
+	case domain.ProductVisibilityPublished:
+		change.PublishAt = nil
+	default:
+		change.PublishAt = nil
+		change.UnpublishAt = nil
+	}
+
+	if change.UnpublishAt != nil {
+		if !change.UnpublishAt.After(now) {
+			return fmt.Errorf("%w: unpublish date must be in the future", domain.ErrVisibilityInvalid)
+		}
+		if change.PublishAt != nil && !change.UnpublishAt.After(*change.PublishAt) {
+			return fmt.Errorf("%w: unpublish date must be after publish date", domain.ErrVisibilityInvalid)
+		}
+	}
+	return nil
+}
+
+// normalizeProductVisibility проверяет видимость нового товара; без неё товар сразу опубликован,
+// как было до появления черновиков
+func normalizeProductVisibility(product *domain.Product, now time.Time) error {
+	change := &domain.VisibilityChange{
+		Visibility:  product.Visibility,
+		PublishAt:   product.PublishAt,
+		UnpublishAt: product.UnpublishAt,
+	}
+	if change.Visibility == "" {
+		change.Visibility = domain.ProductVisibilityPublished
+	}
+	if err := normalizeVisibility(change, now); err != nil {
+		return err
+	}
+
+	product.Visibility = change.Visibility
+	product.PublishAt = change.PublishAt
+	product.UnpublishAt = change.UnpublishAt
+	return nil
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Nzyazin/zadnik.store/internal/product/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+type dueVisibilityRepository struct {
+	domain.ProductVisibilityRepository
+	applied []*domain.AppliedVisibilityChange
+}
+
+func (r *dueVisibilityRepository) ApplyDue(ctx context.Context, now time.Time) ([]*domain.AppliedVisibilityChange, error) {
+	return r.applied, nil
+}
+
+func TestNormalizeVisibility(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	muchLater := now.Add(24 * time.Hour)
+
+	t.Run("scheduled without publish date", func(t *testing.T) {
+		change := &domain.VisibilityChange{Visibility: domain.ProductVisibilityScheduled}
+
+		assert.ErrorIs(t, normalizeVisibility(change, now), domain.ErrVisibilityInvalid)
+	})
+
+	t.Run("scheduled in the past", func(t *testing.T) {
+		past := now.Add(-time.Hour)
+		change := &domain.VisibilityChange{Visibility: domain.ProductVisibilityScheduled, PublishAt: &past}
+
+		assert.ErrorIs(t, normalizeVisibility(change, now), domain.ErrVisibilityInvalid)
+	})
+
+	t.Run("unpublish before publish", func(t *testing.T) {
+		change := &domain.VisibilityChange{Visibility: domain.ProductVisibilityScheduled, PublishAt: &muchLater, UnpublishAt: &later}
+
+		assert.ErrorIs(t, normalizeVisibility(change, now), domain.ErrVisibilityInvalid)
+	})
+
+	t.Run("published keeps only unpublish date", func(t *testing.T) {
+		change := &domain.VisibilityChange{Visibility: domain.ProductVisibilityPublished, PublishAt: &later, UnpublishAt: &muchLater}
+
+		assert.NoError(t, normalizeVisibility(change, now))
+		assert.Nil(t, change.PublishAt)
+		assert.Equal(t, &muchLater, change.UnpublishAt)
+	})
+
+	t.Run("draft drops dates", func(t *testing.T) {
+		change := &domain.VisibilityChange{Visibility: domain.ProductVisibilityDraft, PublishAt: &later, UnpublishAt: &muchLater}
+
+		assert.NoError(t, normalizeVisibility(change, now))
+		assert.Nil(t, change.PublishAt)
+		assert.Nil(t, change.UnpublishAt)
+	})
+
+	t.Run("unknown visibility", func(t *testing.T) {
+		change := &domain.VisibilityChange{Visibility: "archived"}
+
+		assert.ErrorIs(t, normalizeVisibility(change, now), domain.ErrVisibilityInvalid)
+	})
+}
+
+func TestApplyVisibilitySchedule(t *testing.T) {
+	published := &domain.AppliedVisibilityChange{
+		Before: &domain.Product{ID: 1, Visibility: domain.ProductVisibilityScheduled},
+		After:  &domain.Product{ID: 1, Visibility: domain.ProductVisibilityPublished},
+	}
+	hidden := &domain.AppliedVisibilityChange{
+		Before: &domain.Product{ID: 2, Visibility: domain.ProductVisibilityPublished},
+		After:  &domain.Product{ID: 2, Visibility: domain.ProductVisibilityHidden},
+	}
+	revisions := &revisionRecorder{}
+	uc := NewProductUseCase(nil, revisions, nil, nil, nil, nil, nil, nil, nil, &dueVisibilityRepository{applied: []*domain.AppliedVisibilityChange{published, hidden}}, nil, nil, inlineTransactor{}, domain.TaxModeVAT)
+
+	products, err := uc.ApplyVisibilitySchedule(context.Background(), time.Now())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*domain.Product{published.After, hidden.After}, products)
+	if assert.Len(t, revisions.revisions, 2) {
+		assert.Equal(t, domain.RevisionActionVisibilityChange, revisions.revisions[0].Action)
+		assert.Equal(t, int32(2), revisions.revisions[1].ProductID)
+	}
+}
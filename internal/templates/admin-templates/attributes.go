@@ -0,0 +1,100 @@
+package admin_templates
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Attribute - характеристика товара в ответе сервиса товаров
+type Attribute struct {
+	ID int32 `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Unit string `json:"unit"`
+	Options []string `json:"options"`
+	Position int32 `json:"position"`
+	CategoryIDs []int32 `json:"category_ids"`
+}
+
+// AttributeTypeOption - тип характеристики для выпадающего списка
+type AttributeTypeOption struct {
+	Value string
+	Label string
+}
+
+var AttributeTypes = []AttributeTypeOption{
+	{Value: "number", Label: "Число"},
+	{Value: "enum", Label: "Список значений"},
+	{Value: "text", Label: "Текст"},
+}
+
+// TypeLabel возвращает название типа характеристики
+func (a Attribute) TypeLabel() string {
+	for _, option := range AttributeTypes {
+		if option.Value == a.Type {
+			return option.Label
+		}
+	}
+	return a.Type
+}
+
+// OptionsText возвращает значения перечисления по одному в строке для textarea
+func (a Attribute) OptionsText() string {
+	return strings.Join(a.Options, "\n")
+}
+
+// Scope перечисляет разделы, товарам которых задаётся характеристика
+func (a Attribute) Scope(categoryNames map[int32]string) string {
+	if len(a.CategoryIDs) == 0 {
+		return "Все разделы"
+	}
+	names := make([]string, 0, len(a.CategoryIDs))
+	for _, id := range a.CategoryIDs {
+		if name, ok := categoryNames[id]; ok {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// CategoryNames собирает названия разделов по идентификатору
+func CategoryNames(options []CategoryOption) map[int32]string {
+	names := make(map[int32]string, len(options))
+	for _, option := range options {
+		names[option.ID] = option.Name
+	}
+	return names
+}
+
+// ProductAttributeValue - значение характеристики товара
+type ProductAttributeValue struct {
+	AttributeID int32 `json:"attribute_id"`
+	Number decimal.NullDecimal `json:"number"`
+	Text string `json:"text"`
+}
+
+// AttributeInput - поле характеристики в форме товара
+type AttributeInput struct {
+	Attribute
+	Value string
+}
+
+// AttributeInputs готовит поля формы товара: все характеристики со значениями товара
+func AttributeInputs(attributes []Attribute, values []ProductAttributeValue) []AttributeInput {
+	byID := make(map[int32]string, len(values))
+	for _, value := range values {
+		if value.Number.Valid {
+			byID[value.AttributeID] = value.Number.Decimal.String()
+		} else {
+			byID[value.AttributeID] = value.Text
+		}
+	}
+
+	inputs := make([]AttributeInput, len(attributes))
+	for i, attribute := range attributes {
+		inputs[i] = AttributeInput{Attribute: attribute, Value: byID[attribute.ID]}
+	}
+	return inputs
+}
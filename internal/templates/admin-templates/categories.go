@@ -0,0 +1,48 @@
+package admin_templates
+
+// Category - раздел каталога в ответе сервиса товаров
+type Category struct {
+	ID int32 `json:"id"`
+	ParentID *int32 `json:"parent_id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+	Description string `json:"description"`
+	SEOTitle string `json:"seo_title"`
+	SEODescription string `json:"seo_description"`
+	Position int32 `json:"position"`
+	Children []Category `json:"children"`
+}
+
+// CategoryOption - строка дерева разделов для списков и чекбоксов
+type CategoryOption struct {
+	Category
+	Depth int
+	Checked bool
+}
+
+// Indent возвращает отступ для вывода вложенного раздела
+func (o CategoryOption) Indent() string {
+	indent := ""
+	for i := 0; i < o.Depth; i++ {
+		indent += "— "
+	}
+	return indent
+}
+
+// FlattenCategories раскладывает дерево разделов в список с глубиной вложенности
+func FlattenCategories(tree []Category, checked map[int32]bool) []CategoryOption {
+	var options []CategoryOption
+	var walk func(categories []Category, depth int)
+	walk = func(categories []Category, depth int) {
+		for _, category := range categories {
+			options = append(options, CategoryOption{
+				Category: category,
+				Depth:    depth,
+				Checked:  checked[category.ID],
+			})
+			walk(category.Children, depth+1)
+		}
+	}
+	walk(tree, 0)
+	return options
+}
@@ -0,0 +1,38 @@
+package admin_templates
+
+// ImportRow - строка отчёта импорта каталога из сервиса товаров
+type ImportRow struct {
+	Line int `json:"line"`
+	Action string `json:"action"`
+	ProductID int32 `json:"product_id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+	Changes []string `json:"changes"`
+	Errors []string `json:"errors"`
+	Images []string `json:"images"`
+}
+
+// ActionLabel - что импорт делает со строкой, для таблицы отчёта
+func (r ImportRow) ActionLabel() string {
+	switch r.Action {
+	case "create":
+		return "Создание"
+	case "update":
+		return "Изменение"
+	case "skip":
+		return "Без изменений"
+	case "error":
+		return "Ошибка"
+	}
+	return r.Action
+}
+
+// ImportReport - итог проверки или применения импорта
+type ImportReport struct {
+	Rows []ImportRow `json:"rows"`
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped"`
+	Failed int `json:"failed"`
+	Applied bool `json:"applied"`
+}
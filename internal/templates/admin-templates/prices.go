@@ -0,0 +1,42 @@
+package admin_templates
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PriceChange - запланированное изменение цены из сервиса товаров
+type PriceChange struct {
+	ID int32 `json:"id"`
+	Price decimal.Decimal `json:"price"`
+	EffectiveAt time.Time `json:"effective_at"`
+	Status string `json:"status"`
+	Comment string `json:"comment"`
+	UserID *int64 `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+	AppliedAt *time.Time `json:"applied_at"`
+}
+
+var priceChangeStatusLabels = map[string]string{
+	"scheduled": "Запланировано",
+	"applied": "Применено",
+	"cancelled": "Отменено",
+}
+
+func (c PriceChange) StatusLabel() string {
+	if label, ok := priceChangeStatusLabels[c.Status]; ok {
+		return label
+	}
+	return c.Status
+}
+
+func (c PriceChange) IsScheduled() bool {
+	return c.Status == "scheduled"
+}
+
+// PriceHistoryEntry - цена, действовавшая с EffectiveFrom
+type PriceHistoryEntry struct {
+	Price decimal.Decimal `json:"price"`
+	EffectiveFrom time.Time `json:"effective_from"`
+}
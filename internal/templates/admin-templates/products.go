@@ -3,6 +3,9 @@ package admin_templates
 import (
 	"github.com/shopspring/decimal"
 	"database/sql"
+	"sort"
+	"strings"
+	"time"
 )
 
 type Product struct {
@@ -12,4 +15,219 @@ type Product struct {
 	Price decimal.Decimal `json:"price"` 
 	Description string `json:"description"`
 	ImageURL sql.NullString `json:"image_url"`
+	Version int32 `json:"version"`
+	Status string `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	Variants []ProductVariant `json:"variants"`
+	Images []ProductImage `json:"images"`
+	PriceTiers []PriceTier `json:"price_tiers"`
+	TaxClass string `json:"tax_class"`
+	Pricing *Pricing `json:"pricing"`
+	Attributes []ProductAttributeValue `json:"attributes"`
+	Visibility string `json:"visibility"`
+	PublishAt *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+	// Published - товар сейчас виден покупателям
+	Published bool `json:"-"`
+}
+
+// Pricing - цена с выделенным НДС; VATRate == 0 - без НДС
+type Pricing struct {
+	Gross decimal.Decimal `json:"gross"`
+	Net decimal.Decimal `json:"net"`
+	VAT decimal.Decimal `json:"vat"`
+	VATRate int32 `json:"vat_rate"`
+}
+
+// TaxClassOption - пункт выбора ставки НДС в форме товара
+type TaxClassOption struct {
+	Value string
+	Label string
+}
+
+var TaxClassOptions = []TaxClassOption{
+	{Value: "standard", Label: "Основная, НДС 20%"},
+	{Value: "reduced", Label: "Льготная, НДС 10%"},
+	{Value: "exempt", Label: "Без НДС"},
+}
+
+// FormTaxClass - ставка НДС для формы; у нового товара - основная
+func (p Product) FormTaxClass() string {
+	if p.TaxClass == "" {
+		return "standard"
+	}
+	return p.TaxClass
+}
+
+// PriceTier - оптовая цена за пару от MinQuantity пар
+type PriceTier struct {
+	MinQuantity int32 `json:"min_quantity"`
+	UnitPrice decimal.Decimal `json:"unit_price"`
+}
+
+// PriceTierFormRows - строки таблицы оптовых цен: существующие и пустые для добавления новых
+func PriceTierFormRows(tiers []PriceTier) []PriceTier {
+	rows := append([]PriceTier{}, tiers...)
+	for i := 0; i < 2; i++ {
+		rows = append(rows, PriceTier{})
+	}
+	return rows
+}
+
+type ProductImage struct {
+	ID int32 `json:"id"`
+	URL string `json:"url"`
+	Alt string `json:"alt"`
+	Position int32 `json:"position"`
+	IsPrimary bool `json:"is_primary"`
+}
+
+type ProductVariant struct {
+	ID int32 `json:"id"`
+	SKU string `json:"sku"`
+	Options map[string]string `json:"options"`
+	Price decimal.NullDecimal `json:"price"`
+	WeightGrams *int32 `json:"weight_grams"`
+	IsActive bool `json:"is_active"`
+}
+
+// OptionsText выводит опции варианта в формате поля формы: "толщина: 1.5 мм; размер: 38"
+func (v ProductVariant) OptionsText() string {
+	keys := make([]string, 0, len(v.Options))
+	for key := range v.Options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = key + ": " + v.Options[key]
+	}
+	return strings.Join(parts, "; ")
+}
+
+// VariantFormRows - строки таблицы вариантов: существующие и пустые для добавления новых
+func VariantFormRows(variants []ProductVariant) []ProductVariant {
+	rows := append([]ProductVariant{}, variants...)
+	for i := 0; i < 2; i++ {
+		rows = append(rows, ProductVariant{IsActive: true})
+	}
+	return rows
+}
+
+// ProductPage - ответ сервиса товаров на запрос списка
+type ProductPage struct {
+	Items []Product `json:"items"`
+	Total int `json:"total"`
+	Limit int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+type Pagination struct {
+	Page int
+	TotalPages int
+	Total int
+	Offset int
+	PrevURL string
+	NextURL string
+}
+
+type SortLink struct {
+	URL string
+	Active bool
+	Desc bool
+}
+
+// ProductStatuses перечисляет статусы товара в порядке вывода фильтров
+var ProductStatuses = []string{"active", "pending", "creating", "deleting", "deleted"}
+
+var productStatusLabels = map[string]string{
+	"active":   "Активен",
+	"pending":  "Ожидает",
+	"creating": "Создаётся",
+	"deleting": "Удаляется",
+	"deleted":  "Удалён",
+}
+
+// VisibilityOption - пункт выбора состояния витрины
+type VisibilityOption struct {
+	Value string
+	Label string
+}
+
+var VisibilityOptions = []VisibilityOption{
+	{Value: "draft", Label: "Черновик"},
+	{Value: "scheduled", Label: "Запланирован"},
+	{Value: "published", Label: "Опубликован"},
+	{Value: "hidden", Label: "Скрыт"},
+}
+
+func (p Product) VisibilityLabel() string {
+	for _, option := range VisibilityOptions {
+		if option.Value == p.Visibility {
+			return option.Label
+		}
+	}
+	return p.Visibility
+}
+
+// FormVisibility - состояние витрины для формы; новый товар по умолчанию создаётся черновиком
+func (p Product) FormVisibility() string {
+	if p.Visibility == "" {
+		return "draft"
+	}
+	return p.Visibility
+}
+
+// VisibilityToggle - состояние, в которое переводит кнопка быстрого переключения в списке
+func (p Product) VisibilityToggle() string {
+	if p.Visibility == "published" || p.Visibility == "scheduled" {
+		return "hidden"
+	}
+	return "published"
+}
+
+func StatusLabel(status string) string {
+	if label, ok := productStatusLabels[status]; ok {
+		return label
+	}
+	return status
+}
+
+func (p Product) StatusLabel() string {
+	return StatusLabel(p.Status)
+}
+
+// ProductFieldConflict описывает поле, которое изменили параллельно с текущей правкой
+type ProductFieldConflict struct {
+	Label string
+	Yours string
+	Current string
+}
+
+// ProductRevision - запись истории изменений товара
+type ProductRevision struct {
+	ID int `json:"id"`
+	UserID *int64 `json:"user_id"`
+	Action string `json:"action"`
+	Before *Product `json:"before"`
+	After *Product `json:"after"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+var revisionActionLabels = map[string]string{
+	"create":       "Создание",
+	"update":       "Изменение",
+	"price_change": "Изменение цены",
+	"image_change": "Смена изображения",
+	"visibility_change": "Смена видимости",
+	"delete":       "Удаление",
+	"rollback":     "Откат",
+}
+
+func (r ProductRevision) ActionLabel() string {
+	if label, ok := revisionActionLabels[r.Action]; ok {
+		return label
+	}
+	return r.Action
 }
@@ -0,0 +1,40 @@
+package admin_templates
+
+// ProductRelation - ручная связь товара из сервиса товаров вместе с данными связанного товара
+type ProductRelation struct {
+	RelatedID int32 `json:"related_id"`
+	Kind string `json:"kind"`
+	Position int32 `json:"position"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+	Status string `json:"status"`
+	Visibility string `json:"visibility"`
+}
+
+// RelationKind - пункт выбора вида связи
+type RelationKind struct {
+	Value string
+	Label string
+}
+
+var RelationKinds = []RelationKind{
+	{Value: "accessory", Label: "Берут вместе"},
+	{Value: "related", Label: "Похожая модель"},
+	{Value: "alternative", Label: "Замена"},
+}
+
+// newRelationRows - сколько пустых строк для новых связей выводит форма
+const newRelationRows = 3
+
+// StateLabel - статус связанного товара, а у активного - состояние витрины
+func (r ProductRelation) StateLabel() string {
+	if r.Status != "active" {
+		return StatusLabel(r.Status)
+	}
+	return Product{Visibility: r.Visibility}.VisibilityLabel()
+}
+
+// NewRows - пустые строки формы для новых связей
+func (p ProductRelationsPageParams) NewRows() []struct{} {
+	return make([]struct{}, newRelationRows)
+}
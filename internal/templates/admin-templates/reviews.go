@@ -0,0 +1,57 @@
+package admin_templates
+
+import (
+	"strings"
+	"time"
+)
+
+// Review - отзыв покупателя из сервиса товаров
+type Review struct {
+	ID int32 `json:"id"`
+	ProductID int32 `json:"product_id"`
+	ProductName string `json:"product_name"`
+	AuthorName string `json:"author_name"`
+	Company string `json:"company"`
+	Rating int32 `json:"rating"`
+	Text string `json:"text"`
+	Status string `json:"status"`
+	Reply string `json:"reply"`
+	RepliedAt *time.Time `json:"replied_at"`
+	ModeratedAt *time.Time `json:"moderated_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ReviewPage struct {
+	Items []Review `json:"items"`
+	Total int `json:"total"`
+}
+
+// ReviewStatus - вкладка очереди модерации
+type ReviewStatus struct {
+	Value string
+	Label string
+}
+
+var ReviewStatuses = []ReviewStatus{
+	{Value: "pending", Label: "На модерации"},
+	{Value: "approved", Label: "Опубликованы"},
+	{Value: "rejected", Label: "Отклонены"},
+}
+
+func (r Review) StatusLabel() string {
+	for _, status := range ReviewStatuses {
+		if status.Value == r.Status {
+			return status.Label
+		}
+	}
+	return r.Status
+}
+
+// Stars рисует оценку звёздами: ★★★★☆
+func (r Review) Stars() string {
+	rating := int(r.Rating)
+	if rating < 0 || rating > 5 {
+		return ""
+	}
+	return strings.Repeat("★", rating) + strings.Repeat("☆", 5-rating)
+}
@@ -0,0 +1,95 @@
+package admin_templates
+
+import "time"
+
+// StockItem - остаток товара (VariantID == nil) или варианта из сервиса товаров
+type StockItem struct {
+	VariantID *int32 `json:"variant_id"`
+	OnHand int32 `json:"on_hand"`
+	Reserved int32 `json:"reserved"`
+	LowStockThreshold int32 `json:"low_stock_threshold"`
+}
+
+func (s StockItem) Available() int32 {
+	return s.OnHand - s.Reserved
+}
+
+// IsLow - доступный остаток опустился до порога
+func (s StockItem) IsLow() bool {
+	return s.LowStockThreshold > 0 && s.Available() <= s.LowStockThreshold
+}
+
+// StockRow - строка таблицы остатков: сам товар или один из его вариантов
+type StockRow struct {
+	StockItem
+	VariantID int32
+	Label string
+}
+
+type StockMovement struct {
+	ID int32 `json:"id"`
+	VariantID *int32 `json:"variant_id"`
+	Kind string `json:"kind"`
+	Quantity int32 `json:"quantity"`
+	OnHandAfter int32 `json:"on_hand_after"`
+	ReservedAfter int32 `json:"reserved_after"`
+	Reference string `json:"reference"`
+	Comment string `json:"comment"`
+	UserID *int64 `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// StockReservation - действующий резерв под заявку с сайта
+type StockReservation struct {
+	ID int32 `json:"id"`
+	VariantID *int32 `json:"variant_id"`
+	Quantity int32 `json:"quantity"`
+	Reference string `json:"reference"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type StockMovementKind struct {
+	Value string
+	Label string
+}
+
+// StockMovementKinds - виды движений для формы и журнала
+var StockMovementKinds = []StockMovementKind{
+	{Value: "receipt", Label: "Приход"},
+	{Value: "reservation", Label: "Резерв"},
+	{Value: "release", Label: "Снятие резерва"},
+	{Value: "shipment", Label: "Отгрузка"},
+	{Value: "adjustment", Label: "Корректировка"},
+}
+
+func (m StockMovement) KindLabel() string {
+	for _, kind := range StockMovementKinds {
+		if kind.Value == m.Kind {
+			return kind.Label
+		}
+	}
+	return m.Kind
+}
+
+// StockRows собирает строки остатков по товару и всем его вариантам, включая ещё не заведённые на склад
+func StockRows(product *Product, items []StockItem) []StockRow {
+	byVariant := make(map[int32]StockItem, len(items))
+	for _, item := range items {
+		var variantID int32
+		if item.VariantID != nil {
+			variantID = *item.VariantID
+		}
+		byVariant[variantID] = item
+	}
+
+	rows := []StockRow{{StockItem: byVariant[0], Label: "Товар целиком"}}
+	for _, variant := range product.Variants {
+		label := variant.SKU
+		if options := variant.OptionsText(); options != "" {
+			label += " (" + options + ")"
+		}
+		rows = append(rows, StockRow{StockItem: byVariant[variant.ID], VariantID: variant.ID, Label: label})
+	}
+	return rows
+}
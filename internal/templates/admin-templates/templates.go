@@ -6,6 +6,8 @@ import (
 	"io"
 	"os"
 	"strings"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
 )
 
 //go:embed templates/*
@@ -30,11 +32,124 @@ type ProductFormPageParams struct {
 	Product *Product
 	ButtonText string
 	Error string
+	Conflicts []ProductFieldConflict
+	Categories []CategoryOption
+	Attributes []AttributeInput
+	CategoryNames map[int32]string
+	Variants []ProductVariant
+	PriceTiers []PriceTier
+}
+
+type ProductHistoryPageParams struct {
+	BaseParams
+	Product *Product
+	Revisions []ProductRevision
+	Error string
+}
+
+type ProductStockPageParams struct {
+	BaseParams
+	Product *Product
+	Rows []StockRow
+	Reservations []StockReservation
+	Movements []StockMovement
+	Kinds []StockMovementKind
+	Error string
+}
+
+type ProductPricesPageParams struct {
+	BaseParams
+	Product *Product
+	Changes []PriceChange
+	History []PriceHistoryEntry
+	// At и PriceAt - дата из формы «Цена на дату» и найденная цена
+	At string
+	PriceAt *PriceHistoryEntry
+	Error string
+}
+
+type ProductRelationsPageParams struct {
+	BaseParams
+	Product *Product
+	Relations []ProductRelation
+	Error string
+}
+
+// RowLabel возвращает подпись строки остатков для записи журнала
+func (p ProductStockPageParams) RowLabel(variantID *int32) string {
+	var id int32
+	if variantID != nil {
+		id = *variantID
+	}
+	for _, row := range p.Rows {
+		if row.VariantID == id {
+			return row.Label
+		}
+	}
+	return "удалённый вариант"
+}
+
+type CategoriesIndexParams struct {
+	BaseParams
+	Categories []CategoryOption
+	Error string
+}
+
+type CategoryFormPageParams struct {
+	BaseParams
+	Action string
+	IsEdit bool
+	Category *Category
+	Parents []CategoryOption
+	ButtonText string
+	Error string
+}
+
+type ReviewsIndexParams struct {
+	BaseParams
+	Reviews []Review
+	// Status - открытая вкладка; пустая строка - все отзывы
+	Status string
+	Statuses []ReviewStatus
+	Pagination Pagination
+	Error string
+}
+
+type AttributesIndexParams struct {
+	BaseParams
+	Attributes []Attribute
+	CategoryNames map[int32]string
+	Error string
+}
+
+type AttributeFormPageParams struct {
+	BaseParams
+	Action string
+	IsEdit bool
+	Attribute *Attribute
+	Categories []CategoryOption
+	ButtonText string
+	Error string
+}
+
+type ProductsImportParams struct {
+	BaseParams
+	Report *ImportReport
+	// Token - загруженные файлы, которые ждут применения после проверки
+	Token string
+	FileName string
+	ImagesName string
+	Error string
 }
 
 type ProductsIndexParams struct {
 	BaseParams
 	Products []Product
+	Statuses []string
+	Status string
+	Search string
+	SortLinks map[string]SortLink
+	Pagination Pagination
 	Error string
 }
 
@@ -50,6 +165,16 @@ type Templates struct {
 	auth     *template.Template
 	products *template.Template
 	productForm *template.Template
+	productHistory *template.Template
+	productStock *template.Template
+	productPrices *template.Template
+	productRelations *template.Template
+	categories *template.Template
+	categoryForm *template.Template
+	attributes *template.Template
+	attributeForm *template.Template
+	reviews *template.Template
+	productsImport *template.Template
 	funcs    template.FuncMap
 }
 
@@ -60,6 +185,12 @@ func NewTemplates(tf TemplateFunctions) (*Templates, error) {
 			"add":           tf.Add,
 			"staticWithHash": tf.StaticWithHash,
 			"dict":          tf.Dict,
+			"statusLabel":   StatusLabel,
+			"money":         common.FormatRubles,
+			"taxClasses":    func() []TaxClassOption { return TaxClassOptions },
+			"visibilities":  func() []VisibilityOption { return VisibilityOptions },
+			"relationKinds": func() []RelationKind { return RelationKinds },
+			"attributeTypes": func() []AttributeTypeOption { return AttributeTypes },
 		},
 	}
 
@@ -97,6 +228,107 @@ func (t *Templates) parseTemplates() error {
 				"templates/pages/product-form-page.html",
 				"templates/components/product-header.html",
 				"templates/components/product-form.html",
+				"templates/components/product-conflict.html",
+				"templates/components/product-tabs.html",
+				"templates/components/product-visibility.html",
+			),
+	)
+
+	t.productHistory = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, 
+				"templates/layout/base.html", 
+				"templates/pages/product-history-page.html",
+				"templates/components/product-header.html",
+				"templates/components/product-tabs.html",
+			),
+	)
+
+	t.productStock = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, 
+				"templates/layout/base.html", 
+				"templates/pages/product-stock-page.html",
+				"templates/components/product-header.html",
+				"templates/components/product-tabs.html",
+			),
+	)
+
+	t.productPrices = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, 
+				"templates/layout/base.html", 
+				"templates/pages/product-prices-page.html",
+				"templates/components/product-header.html",
+				"templates/components/product-tabs.html",
+			),
+	)
+
+	t.productRelations = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, 
+				"templates/layout/base.html", 
+				"templates/pages/product-relations-page.html",
+				"templates/components/product-header.html",
+				"templates/components/product-tabs.html",
+			),
+	)
+
+	t.categories = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, 
+				"templates/layout/base.html", 
+				"templates/pages/categories-index.html",
+			),
+	)
+
+	t.categoryForm = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, 
+				"templates/layout/base.html", 
+				"templates/pages/category-form-page.html",
+			),
+	)
+
+	t.attributes = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, 
+				"templates/layout/base.html", 
+				"templates/pages/attributes-index.html",
+			),
+	)
+
+	t.reviews = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, 
+				"templates/layout/base.html", 
+				"templates/pages/reviews-index.html",
+			),
+	)
+
+	t.attributeForm = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, 
+				"templates/layout/base.html", 
+				"templates/pages/attribute-form-page.html",
+			),
+	)
+
+	t.productsImport = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, 
+				"templates/layout/base.html", 
+				"templates/pages/products-import.html",
 			),
 	)
 	return nil
@@ -114,18 +346,84 @@ func (t *Templates) RenderAuth(w io.Writer, p AuthParams) error {
 
 func (t *Templates) RenderProductFormPage(w io.Writer, p ProductFormPageParams) error {
 	p.View = "product-form"
+	if p.Product != nil {
+		p.Variants = VariantFormRows(p.Product.Variants)
+		p.PriceTiers = PriceTierFormRows(p.Product.PriceTiers)
+	}
 	
 	return t.productForm.Execute(w, p)
 }
 
+func (t *Templates) RenderProductHistoryPage(w io.Writer, p ProductHistoryPageParams) error {
+	p.View = "product-history"
+	
+	return t.productHistory.Execute(w, p)
+}
+
+func (t *Templates) RenderProductStockPage(w io.Writer, p ProductStockPageParams) error {
+	p.View = "product-stock"
+	p.Kinds = StockMovementKinds
+	
+	return t.productStock.Execute(w, p)
+}
+
+func (t *Templates) RenderProductPricesPage(w io.Writer, p ProductPricesPageParams) error {
+	p.View = "product-prices"
+
+	return t.productPrices.Execute(w, p)
+}
+
+func (t *Templates) RenderProductRelationsPage(w io.Writer, p ProductRelationsPageParams) error {
+	p.View = "product-relations"
+
+	return t.productRelations.Execute(w, p)
+}
 
 func (t *Templates) RenderProductsIndex(w io.Writer, p ProductsIndexParams) error {
 	// Установим базовые параметры
 	p.View = "products-index"
+	p.Statuses = ProductStatuses
 	
 	return t.products.Execute(w, p)
 }
 
+func (t *Templates) RenderProductsImport(w io.Writer, p ProductsImportParams) error {
+	p.View = "products-import"
+	
+	return t.productsImport.Execute(w, p)
+}
+
+func (t *Templates) RenderCategoriesIndex(w io.Writer, p CategoriesIndexParams) error {
+	p.View = "categories-index"
+	
+	return t.categories.Execute(w, p)
+}
+
+func (t *Templates) RenderCategoryFormPage(w io.Writer, p CategoryFormPageParams) error {
+	p.View = "category-form"
+	
+	return t.categoryForm.Execute(w, p)
+}
+
+func (t *Templates) RenderAttributesIndex(w io.Writer, p AttributesIndexParams) error {
+	p.View = "attributes-index"
+	
+	return t.attributes.Execute(w, p)
+}
+
+func (t *Templates) RenderReviewsIndex(w io.Writer, p ReviewsIndexParams) error {
+	p.View = "reviews-index"
+	p.Statuses = ReviewStatuses
+	
+	return t.reviews.Execute(w, p)
+}
+
+func (t *Templates) RenderAttributeFormPage(w io.Writer, p AttributeFormPageParams) error {
+	p.View = "attribute-form"
+	
+	return t.attributeForm.Execute(w, p)
+}
+
 var staticHash string
 
 func init() {
@@ -0,0 +1,32 @@
+package client_templates
+
+// FAQItem - вопрос из блока «Вопросы» на главной; из тех же данных строится разметка FAQPage
+type FAQItem struct {
+	Question string
+	Answer string
+}
+
+var FAQ = []FAQItem{
+	{
+		Question: "Что такое задник из кожкартона и зачем он нужен в обуви?",
+		Answer: "Задник из кожкартона — это уплотнённый элемент, который вставляется в заднюю часть обуви для удержания формы пятки. Он предотвращает деформацию обуви, улучшает посадку и делает ношение более комфортным.",
+	},
+	{
+		Question: "Чем кожкартон лучше других материалов?",
+		Answer: "Кожкартон прочный, хорошо держит форму, при этом остаётся гибким. В отличие от пластиковых вставок, он «дышит» и более экологичен. Отличный выбор для ремонта обуви или при пошиве новой пары.",
+	},
+	{
+		Question: "Подходит ли этот задник для ремонта любой обуви?",
+		Answer: "Да, задники из кожкартона универсальны и подойдут для большинства видов обуви — будь то кроссовки, ботинки, туфли или рабочая обувь. Важно правильно подобрать размер и аккуратно установить.",
+	},
+	{
+		Question: "Как установить задник самостоятельно?",
+		Answer: "Задник можно установить вручную при помощи обувного клея. Для надёжной фиксации желательно немного разогреть кожкартон и плотно прижать его к внутренней части задника обуви. При необходимости — обратиться к мастеру.",
+	},
+}
+
+// FAQColumns делит вопросы на две колонки блока
+func (p IndexParams) FAQColumns() [][]FAQItem {
+	half := (len(p.FAQ) + 1) / 2
+	return [][]FAQItem{p.FAQ[:half], p.FAQ[half:]}
+}
@@ -3,13 +3,287 @@ package client_templates
 import (
 	"github.com/shopspring/decimal"
 	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
 )
 
 type Product struct {
 	ID int `json:"id"`
 	Name string `json:"name"`
 	Slug string `json:"slug"`
+	Status string `json:"status"`
 	Price decimal.Decimal `json:"price"` 
 	Description string `json:"description"`
 	ImageURL sql.NullString `json:"image_url"`
+	Variants []ProductVariant `json:"variants"`
+	Images []ProductImage `json:"images"`
+	Stock []StockItem `json:"stock"`
+	PriceTiers []PriceTier `json:"price_tiers"`
+	Pricing *Pricing `json:"pricing"`
+	Attributes []ProductAttribute `json:"attributes"`
+	// Rating - nil, пока у товара нет одобренных отзывов
+	Rating *ProductRating `json:"rating"`
+}
+
+// ProductAttribute - характеристика товара; у чисел заполнено Number, у остальных - Text
+type ProductAttribute struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	Unit string `json:"unit"`
+	Number decimal.NullDecimal `json:"number"`
+	Text string `json:"text"`
+}
+
+// Value - значение для таблицы характеристик: число с единицей измерения или текст
+func (a ProductAttribute) Value() string {
+	if !a.Number.Valid {
+		return a.Text
+	}
+	value := strings.Replace(a.Number.Decimal.String(), ".", ",", 1)
+	if a.Unit != "" {
+		value += "\u00a0" + a.Unit
+	}
+	return value
+}
+
+// Pricing - цена с выделенным НДС; VATRate == 0 - товар продаётся без НДС
+type Pricing struct {
+	Gross decimal.Decimal `json:"gross"`
+	Net decimal.Decimal `json:"net"`
+	VAT decimal.Decimal `json:"vat"`
+	VATRate int32 `json:"vat_rate"`
+}
+
+// VATNote - подпись к цене: «в т.ч. НДС 20%: 200 ₽» или «без НДС»
+func VATNote(pricing *Pricing) string {
+	if pricing == nil {
+		return ""
+	}
+	if pricing.VATRate == 0 {
+		return "без НДС"
+	}
+	return fmt.Sprintf("в т.ч. НДС %d%%: %s", pricing.VATRate, common.FormatRubles(pricing.VAT))
+}
+
+// PriceTier - оптовая цена за пару от MinQuantity пар
+type PriceTier struct {
+	MinQuantity int32 `json:"min_quantity"`
+	UnitPrice decimal.Decimal `json:"unit_price"`
+	Pricing *Pricing `json:"pricing"`
+}
+
+// PriceTierRow - строка таблицы оптовых цен; MaxQuantity == 0 - без верхней границы
+type PriceTierRow struct {
+	MinQuantity int32
+	MaxQuantity int32
+	UnitPrice decimal.Decimal
+}
+
+// StockItem - остаток товара (VariantID == nil) или варианта
+type StockItem struct {
+	VariantID *int32 `json:"variant_id"`
+	OnHand int32 `json:"on_hand"`
+	Reserved int32 `json:"reserved"`
+	LowStockThreshold int32 `json:"low_stock_threshold"`
+}
+
+type ProductImage struct {
+	ID int32 `json:"id"`
+	URL string `json:"url"`
+	Alt string `json:"alt"`
+	IsPrimary bool `json:"is_primary"`
+}
+
+// GalleryImages возвращает изображения галереи, начиная с основного;
+// для товаров без галереи - единственное изображение из image_url
+func (p Product) GalleryImages() []ProductImage {
+	if len(p.Images) == 0 {
+		if p.ImageURL.Valid && p.ImageURL.String != "" {
+			return []ProductImage{{URL: p.ImageURL.String, IsPrimary: true}}
+		}
+		return nil
+	}
+
+	images := make([]ProductImage, 0, len(p.Images))
+	for _, image := range p.Images {
+		if image.IsPrimary {
+			images = append(images, image)
+		}
+	}
+	for _, image := range p.Images {
+		if !image.IsPrimary {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+// CardImage - основное изображение для карточки; у товара без фото URL пустой
+func (p Product) CardImage() ProductImage {
+	if images := p.GalleryImages(); len(images) > 0 {
+		return images[0]
+	}
+	return ProductImage{}
+}
+
+// ImageAlt - подпись изображения, а без неё - название товара
+func (p Product) ImageAlt(image ProductImage) string {
+	if image.Alt != "" {
+		return image.Alt
+	}
+	return p.Name + " - фото"
+}
+
+type ProductVariant struct {
+	ID int32 `json:"id"`
+	SKU string `json:"sku"`
+	Options map[string]string `json:"options"`
+	Price decimal.NullDecimal `json:"price"`
+	IsActive bool `json:"is_active"`
+	Pricing *Pricing `json:"pricing"`
+}
+
+// DescriptionParagraphs разбивает описание на абзацы по переводам строк, пропуская пустые
+func (p Product) DescriptionParagraphs() []string {
+	var paragraphs []string
+	for _, line := range strings.Split(p.Description, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			paragraphs = append(paragraphs, line)
+		}
+	}
+	return paragraphs
+}
+
+// ActiveVariants возвращает варианты, доступные для заказа
+func (p Product) ActiveVariants() []ProductVariant {
+	var variants []ProductVariant
+	for _, variant := range p.Variants {
+		if variant.IsActive {
+			variants = append(variants, variant)
+		}
+	}
+	return variants
+}
+
+// VariantPrice возвращает цену варианта или базовую цену товара
+func (p Product) VariantPrice(variant ProductVariant) decimal.Decimal {
+	if variant.Price.Valid {
+		return variant.Price.Decimal
+	}
+	return p.Price
+}
+
+// DisplayPrice - цена, показываемая в карточке: цена первого доступного варианта или цена товара
+func (p Product) DisplayPrice() decimal.Decimal {
+	if variants := p.ActiveVariants(); len(variants) > 0 {
+		return p.VariantPrice(variants[0])
+	}
+	return p.Price
+}
+
+// DisplayPricing - НДС в цене из карточки, см. DisplayPrice
+func (p Product) DisplayPricing() *Pricing {
+	if variants := p.ActiveVariants(); len(variants) > 0 && variants[0].Pricing != nil {
+		return variants[0].Pricing
+	}
+	return p.Pricing
+}
+
+// PriceTierRows собирает таблицу оптовых цен с диапазонами; до первой ступени действует цена товара.
+// Сервис товаров отдаёт ступени отсортированными по порогу
+func (p Product) PriceTierRows() []PriceTierRow {
+	if len(p.PriceTiers) == 0 {
+		return nil
+	}
+
+	rows := make([]PriceTierRow, 0, len(p.PriceTiers)+1)
+	if p.PriceTiers[0].MinQuantity > 1 {
+		rows = append(rows, PriceTierRow{MinQuantity: 1, MaxQuantity: p.PriceTiers[0].MinQuantity - 1, UnitPrice: p.Price})
+	}
+	for i, tier := range p.PriceTiers {
+		row := PriceTierRow{MinQuantity: tier.MinQuantity, UnitPrice: tier.UnitPrice}
+		if i+1 < len(p.PriceTiers) {
+			row.MaxQuantity = p.PriceTiers[i+1].MinQuantity - 1
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// StockStatus возвращает ключ наличия товара или варианта (variantID == 0 - сам товар):
+// in_stock, low_stock, made_to_order; пустая строка - остатки по товару не ведутся
+func (p Product) StockStatus(variantID int32) string {
+	if len(p.Stock) == 0 {
+		return ""
+	}
+	for _, item := range p.Stock {
+		if (item.VariantID == nil && variantID == 0) || (item.VariantID != nil && *item.VariantID == variantID) {
+			switch available := item.OnHand - item.Reserved; {
+			case available <= 0:
+				return "made_to_order"
+			case available <= item.LowStockThreshold:
+				return "low_stock"
+			default:
+				return "in_stock"
+			}
+		}
+	}
+	return "made_to_order"
+}
+
+// CardStockStatus - наличие, показываемое в карточке: первого доступного варианта или самого товара
+func (p Product) CardStockStatus() string {
+	if variants := p.ActiveVariants(); len(variants) > 0 {
+		return p.StockStatus(variants[0].ID)
+	}
+	return p.StockStatus(0)
+}
+
+var stockStatusLabels = map[string]string{
+	"in_stock": "В наличии",
+	"low_stock": "Осталось мало",
+	"made_to_order": "Под заказ",
+}
+
+func StockStatusLabel(status string) string {
+	return stockStatusLabels[status]
+}
+
+// Label выводит опции варианта для выпадающего списка, а без опций - артикул
+func (v ProductVariant) Label() string {
+	keys := make([]string, 0, len(v.Options))
+	for key := range v.Options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = key + " " + v.Options[key]
+	}
+	if len(parts) == 0 {
+		return v.SKU
+	}
+	return strings.Join(parts, ", ")
+}
+
+// SearchResult - товар из результатов поиска с подсвеченными совпадениями
+type SearchResult struct {
+	Product
+	NameHighlight string `json:"name_highlight"`
+	Snippet string `json:"snippet"`
+}
+
+// Category - раздел каталога с SEO-полями для посадочной страницы
+type Category struct {
+	ID int32 `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+	Description string `json:"description"`
+	SEOTitle string `json:"seo_title"`
+	SEODescription string `json:"seo_description"`
+	Children []Category `json:"children"`
 }
@@ -0,0 +1,71 @@
+package client_templates
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+const maxRating = 5
+
+// ProductRating - средняя оценка товара по одобренным отзывам
+type ProductRating struct {
+	Average float64
+	Count   int32
+}
+
+// Review - одобренный отзыв покупателя; Reply - ответ магазина
+type Review struct {
+	AuthorName string
+	Company    string
+	Rating     int32
+	Text       string
+	Reply      string
+	CreatedAt  time.Time
+}
+
+// ReviewForm - состояние формы отзыва: StartedAt - время показа страницы, Notice - итог прошлой отправки
+type ReviewForm struct {
+	StartedAt int64
+	Notice    string
+	Sent      bool
+}
+
+// RatingStars возвращает пять звёзд, из которых закрашены округлённые до целого rating
+func RatingStars(rating float64) []bool {
+	filled := int(math.Round(rating))
+	stars := make([]bool, maxRating)
+	for i := range stars {
+		stars[i] = i < filled
+	}
+	return stars
+}
+
+// Stars - звёзды оценки отзыва
+func (r Review) Stars() []bool {
+	return RatingStars(float64(r.Rating))
+}
+
+// FormatRating печатает среднюю оценку с одним знаком после запятой: 4,5
+func FormatRating(rating float64) string {
+	return strings.Replace(fmt.Sprintf("%.1f", rating), ".", ",", 1)
+}
+
+// ReviewsCount склоняет число отзывов: 1 отзыв, 3 отзыва, 11 отзывов
+func ReviewsCount(count int32) string {
+	word := "отзывов"
+	switch n := count % 100; {
+	case n >= 11 && n <= 14:
+	case n%10 == 1:
+		word = "отзыв"
+	case n%10 >= 2 && n%10 <= 4:
+		word = "отзыва"
+	}
+	return fmt.Sprintf("%d %s", count, word)
+}
+
+// ReviewDate печатает дату отзыва: 19.10.2026
+func ReviewDate(t time.Time) string {
+	return t.Format("02.01.2006")
+}
@@ -0,0 +1,220 @@
+package client_templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const schemaContext = "https://schema.org"
+
+// JSONLD кодирует разметку schema.org для <script type="application/ld+json">.
+// json.Marshal экранирует <, > и &, поэтому текст из данных товара не закроет тег script
+func JSONLD(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode json-ld: %w", err)
+	}
+	return string(data), nil
+}
+
+type OrganizationLD struct {
+	Context   string `json:"@context"`
+	Type      string `json:"@type"`
+	Name      string `json:"name"`
+	URL       string `json:"url"`
+	Logo      string `json:"logo,omitempty"`
+	Telephone string `json:"telephone,omitempty"`
+}
+
+func NewOrganizationLD(name, siteURL, logo, telephone string) OrganizationLD {
+	return OrganizationLD{Context: schemaContext, Type: "Organization", Name: name, URL: siteURL, Logo: logo, Telephone: telephone}
+}
+
+type ProductLD struct {
+	Context            string             `json:"@context"`
+	Type               string             `json:"@type"`
+	Name               string             `json:"name"`
+	Description        string             `json:"description,omitempty"`
+	URL                string             `json:"url"`
+	Image              []string           `json:"image,omitempty"`
+	SKU                string             `json:"sku"`
+	Brand              *BrandLD           `json:"brand,omitempty"`
+	AdditionalProperty []PropertyValueLD  `json:"additionalProperty,omitempty"`
+	Offers             []OfferLD          `json:"offers"`
+	AggregateRating    *AggregateRatingLD `json:"aggregateRating,omitempty"`
+	Review             []ReviewLD         `json:"review,omitempty"`
+}
+
+type AggregateRatingLD struct {
+	Type        string  `json:"@type"`
+	RatingValue float64 `json:"ratingValue"`
+	ReviewCount int32   `json:"reviewCount"`
+	BestRating  int     `json:"bestRating"`
+	WorstRating int     `json:"worstRating"`
+}
+
+type ReviewLD struct {
+	Type          string   `json:"@type"`
+	Author        PersonLD `json:"author"`
+	DatePublished string   `json:"datePublished"`
+	ReviewBody    string   `json:"reviewBody"`
+	ReviewRating  RatingLD `json:"reviewRating"`
+}
+
+type PersonLD struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+type RatingLD struct {
+	Type        string `json:"@type"`
+	RatingValue int32  `json:"ratingValue"`
+	BestRating  int    `json:"bestRating"`
+	WorstRating int    `json:"worstRating"`
+}
+
+type BrandLD struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+type PropertyValueLD struct {
+	Type     string `json:"@type"`
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	UnitText string `json:"unitText,omitempty"`
+}
+
+type OfferLD struct {
+	Type          string `json:"@type"`
+	Name          string `json:"name,omitempty"`
+	SKU           string `json:"sku,omitempty"`
+	URL           string `json:"url"`
+	Price         string `json:"price"`
+	PriceCurrency string `json:"priceCurrency"`
+	Availability  string `json:"availability"`
+	ItemCondition string `json:"itemCondition"`
+}
+
+// schemaAvailability переводит ключ наличия из StockStatus в значение schema.org;
+// товар без учёта остатков делается под заказ, но купить его можно сразу
+var schemaAvailability = map[string]string{
+	"":              "https://schema.org/InStock",
+	"in_stock":      "https://schema.org/InStock",
+	"low_stock":     "https://schema.org/LimitedAvailability",
+	"made_to_order": "https://schema.org/MadeToOrder",
+}
+
+// NewProductLD строит Product с предложением на каждый доступный вариант, а без вариантов - на сам товар.
+// pageURL и images должны быть абсолютными; reviews - одобренные отзывы, выведенные на странице
+func NewProductLD(p Product, pageURL string, images []string, brand string, reviews []Review) ProductLD {
+	ld := ProductLD{
+		Context:     schemaContext,
+		Type:        "Product",
+		Name:        p.Name,
+		Description: p.Description,
+		URL:         pageURL,
+		Image:       images,
+		SKU:         strconv.Itoa(p.ID),
+	}
+	if brand != "" {
+		ld.Brand = &BrandLD{Type: "Brand", Name: brand}
+	}
+	for _, attribute := range p.Attributes {
+		property := PropertyValueLD{Type: "PropertyValue", Name: attribute.Name, Value: attribute.Text, UnitText: attribute.Unit}
+		if attribute.Number.Valid {
+			property.Value = attribute.Number.Decimal.String()
+		}
+		ld.AdditionalProperty = append(ld.AdditionalProperty, property)
+	}
+	if p.Rating != nil && p.Rating.Count > 0 {
+		ld.AggregateRating = &AggregateRatingLD{Type: "AggregateRating", RatingValue: p.Rating.Average, ReviewCount: p.Rating.Count, BestRating: maxRating, WorstRating: 1}
+		for _, review := range reviews {
+			ld.Review = append(ld.Review, ReviewLD{
+				Type:          "Review",
+				Author:        PersonLD{Type: "Person", Name: review.AuthorName},
+				DatePublished: review.CreatedAt.Format("2006-01-02"),
+				ReviewBody:    review.Text,
+				ReviewRating:  RatingLD{Type: "Rating", RatingValue: review.Rating, BestRating: maxRating, WorstRating: 1},
+			})
+		}
+	}
+
+	offer := OfferLD{
+		Type:          "Offer",
+		URL:           pageURL,
+		Price:         p.Price.StringFixed(2),
+		PriceCurrency: "RUB",
+		Availability:  schemaAvailability[p.StockStatus(0)],
+		ItemCondition: "https://schema.org/NewCondition",
+	}
+	variants := p.ActiveVariants()
+	if len(variants) == 0 {
+		ld.Offers = []OfferLD{offer}
+		return ld
+	}
+	for _, variant := range variants {
+		offer.Name = variant.Label()
+		offer.SKU = variant.SKU
+		offer.Price = p.VariantPrice(variant).StringFixed(2)
+		offer.Availability = schemaAvailability[p.StockStatus(variant.ID)]
+		ld.Offers = append(ld.Offers, offer)
+	}
+	return ld
+}
+
+type BreadcrumbListLD struct {
+	Context         string       `json:"@context"`
+	Type            string       `json:"@type"`
+	ItemListElement []ListItemLD `json:"itemListElement"`
+}
+
+type ListItemLD struct {
+	Type     string `json:"@type"`
+	Position int    `json:"position"`
+	Name     string `json:"name"`
+	Item     string `json:"item,omitempty"`
+}
+
+// NewBreadcrumbListLD строит BreadcrumbList из хлебных крошек страницы; адреса крошек дополняются siteURL
+func NewBreadcrumbListLD(siteURL string, breadcrumbs []Breadcrumb) BreadcrumbListLD {
+	ld := BreadcrumbListLD{Context: schemaContext, Type: "BreadcrumbList"}
+	for i, breadcrumb := range breadcrumbs {
+		item := ListItemLD{Type: "ListItem", Position: i + 1, Name: breadcrumb.Name}
+		if breadcrumb.URL != "" {
+			item.Item = siteURL + breadcrumb.URL
+		}
+		ld.ItemListElement = append(ld.ItemListElement, item)
+	}
+	return ld
+}
+
+type FAQPageLD struct {
+	Context    string       `json:"@context"`
+	Type       string       `json:"@type"`
+	MainEntity []QuestionLD `json:"mainEntity"`
+}
+
+type QuestionLD struct {
+	Type           string   `json:"@type"`
+	Name           string   `json:"name"`
+	AcceptedAnswer AnswerLD `json:"acceptedAnswer"`
+}
+
+type AnswerLD struct {
+	Type string `json:"@type"`
+	Text string `json:"text"`
+}
+
+func NewFAQPageLD(items []FAQItem) FAQPageLD {
+	ld := FAQPageLD{Context: schemaContext, Type: "FAQPage"}
+	for _, item := range items {
+		ld.MainEntity = append(ld.MainEntity, QuestionLD{
+			Type:           "Question",
+			Name:           item.Question,
+			AcceptedAnswer: AnswerLD{Type: "Answer", Text: item.Answer},
+		})
+	}
+	return ld
+}
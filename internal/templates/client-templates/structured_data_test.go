@@ -0,0 +1,168 @@
+package client_templates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProductLD(t *testing.T) {
+	const pageURL = "https://example.ru/products/zadnik"
+	variantID := int32(7)
+	otherVariantID := int32(8)
+
+	tests := []struct {
+		name    string
+		product Product
+		want    []OfferLD
+	}{
+		{
+			name:    "product without variants and stock",
+			product: Product{ID: 1, Price: decimal.NewFromInt(100)},
+			want: []OfferLD{
+				{Price: "100.00", Availability: "https://schema.org/InStock"},
+			},
+		},
+		{
+			name: "product stock below threshold",
+			product: Product{
+				ID:    1,
+				Price: decimal.NewFromInt(100),
+				Stock: []StockItem{{OnHand: 5, Reserved: 2, LowStockThreshold: 3}},
+			},
+			want: []OfferLD{
+				{Price: "100.00", Availability: "https://schema.org/LimitedAvailability"},
+			},
+		},
+		{
+			name: "offer per active variant",
+			product: Product{
+				ID:    1,
+				Price: decimal.NewFromInt(100),
+				Variants: []ProductVariant{
+					{ID: variantID, SKU: "Z-38", Options: map[string]string{"Размер": "38"}, IsActive: true},
+					{ID: otherVariantID, SKU: "Z-40", Options: map[string]string{"Размер": "40"}, Price: decimal.NewNullDecimal(decimal.NewFromFloat(120.5)), IsActive: true},
+					{ID: 9, SKU: "Z-42", IsActive: false},
+				},
+				Stock: []StockItem{
+					{VariantID: &variantID, OnHand: 50, LowStockThreshold: 5},
+					{VariantID: &otherVariantID, OnHand: 3, Reserved: 3},
+				},
+			},
+			want: []OfferLD{
+				{Name: "Размер 38", SKU: "Z-38", Price: "100.00", Availability: "https://schema.org/InStock"},
+				{Name: "Размер 40", SKU: "Z-40", Price: "120.50", Availability: "https://schema.org/MadeToOrder"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ld := NewProductLD(tt.product, pageURL, nil, "", nil)
+
+			require.Len(t, ld.Offers, len(tt.want))
+			for i, want := range tt.want {
+				offer := ld.Offers[i]
+				assert.Equal(t, want.Name, offer.Name)
+				assert.Equal(t, want.SKU, offer.SKU)
+				assert.Equal(t, want.Price, offer.Price)
+				assert.Equal(t, want.Availability, offer.Availability)
+				assert.Equal(t, pageURL, offer.URL)
+				assert.Equal(t, "RUB", offer.PriceCurrency)
+			}
+		})
+	}
+}
+
+func TestNewProductLDAvailability(t *testing.T) {
+	tests := []struct {
+		name  string
+		stock []StockItem
+		want  string
+	}{
+		{name: "stock not tracked", stock: nil, want: "https://schema.org/InStock"},
+		{name: "in stock", stock: []StockItem{{OnHand: 10, LowStockThreshold: 3}}, want: "https://schema.org/InStock"},
+		{name: "low stock", stock: []StockItem{{OnHand: 3, LowStockThreshold: 3}}, want: "https://schema.org/LimitedAvailability"},
+		{name: "all reserved", stock: []StockItem{{OnHand: 4, Reserved: 4}}, want: "https://schema.org/MadeToOrder"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ld := NewProductLD(Product{Stock: tt.stock}, "", nil, "", nil)
+
+			require.Len(t, ld.Offers, 1)
+			assert.Equal(t, tt.want, ld.Offers[0].Availability)
+		})
+	}
+}
+
+func TestNewProductLDRating(t *testing.T) {
+	reviews := []Review{
+		{AuthorName: "Ольга", Rating: 5, Text: "Держат форму", CreatedAt: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		name       string
+		rating     *ProductRating
+		wantRating bool
+	}{
+		{name: "no rating", rating: nil},
+		{name: "no approved reviews", rating: &ProductRating{Count: 0}},
+		{name: "rated", rating: &ProductRating{Average: 4.5, Count: 2}, wantRating: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ld := NewProductLD(Product{Rating: tt.rating}, "", nil, "", reviews)
+
+			if !tt.wantRating {
+				assert.Nil(t, ld.AggregateRating)
+				assert.Empty(t, ld.Review)
+
+				data, err := JSONLD(ld)
+				require.NoError(t, err)
+				assert.NotContains(t, data, "aggregateRating")
+				assert.NotContains(t, data, `"review"`)
+				return
+			}
+
+			require.NotNil(t, ld.AggregateRating)
+			assert.Equal(t, 4.5, ld.AggregateRating.RatingValue)
+			assert.Equal(t, int32(2), ld.AggregateRating.ReviewCount)
+			require.Len(t, ld.Review, 1)
+			assert.Equal(t, "Ольга", ld.Review[0].Author.Name)
+			assert.Equal(t, "2024-03-01", ld.Review[0].DatePublished)
+			assert.Equal(t, int32(5), ld.Review[0].ReviewRating.RatingValue)
+		})
+	}
+}
+
+func TestNewBreadcrumbListLD(t *testing.T) {
+	ld := NewBreadcrumbListLD("https://example.ru", []Breadcrumb{
+		{Name: "Главная", URL: "/"},
+		{Name: "Задники", URL: "/categories/zadniki"},
+		{Name: "Задник из кожкартона"},
+	})
+
+	assert.Equal(t, []ListItemLD{
+		{Type: "ListItem", Position: 1, Name: "Главная", Item: "https://example.ru/"},
+		{Type: "ListItem", Position: 2, Name: "Задники", Item: "https://example.ru/categories/zadniki"},
+		{Type: "ListItem", Position: 3, Name: "Задник из кожкартона"},
+	}, ld.ItemListElement)
+}
+
+func TestNewFAQPageLD(t *testing.T) {
+	ld := NewFAQPageLD([]FAQItem{{Question: "Сколько стоит доставка?", Answer: "Бесплатно от 10 000 ₽"}})
+
+	require.Len(t, ld.MainEntity, 1)
+	assert.Equal(t, "Question", ld.MainEntity[0].Type)
+	assert.Equal(t, "Сколько стоит доставка?", ld.MainEntity[0].Name)
+	assert.Equal(t, AnswerLD{Type: "Answer", Text: "Бесплатно от 10 000 ₽"}, ld.MainEntity[0].AcceptedAnswer)
+
+	data, err := JSONLD(NewFAQPageLD([]FAQItem{{Question: "</script>", Answer: "ok"}}))
+	require.NoError(t, err)
+	assert.NotContains(t, data, "</script>")
+}
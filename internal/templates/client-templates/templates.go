@@ -4,6 +4,8 @@ import (
 	"embed"
 	"text/template"
 	"io"
+
+	"github.com/Nzyazin/zadnik.store/internal/common"
 )
 
 //go:embed templates/**/*
@@ -13,12 +15,54 @@ type BaseParams struct {
 	Title string
 	Description string
 	View string
+	// Canonical - абсолютный адрес страницы для rel=canonical и og:url; пустой - без канонической ссылки
+	Canonical string
+	// Image - абсолютный адрес картинки для Open Graph и Twitter
+	Image string
+	// OGType - og:type, по умолчанию website
+	OGType string
+	// StructuredData - разметка schema.org, подготовленная JSONLD
+	StructuredData []string
 }
 
 type IndexParams struct {
 	BaseParams
 	Error string
 	Products []Product
+	FAQ []FAQItem
+}
+
+type SearchParams struct {
+	BaseParams
+	Error string
+	Query string
+	Results []SearchResult
+	Total int
+}
+
+type CategoryParams struct {
+	BaseParams
+	Error string
+	Category Category
+	Products []Product
+}
+
+// Breadcrumb - звено хлебных крошек; у текущей страницы URL пустой
+type Breadcrumb struct {
+	Name string
+	URL string
+}
+
+type ProductParams struct {
+	BaseParams
+	Product Product
+	Breadcrumbs []Breadcrumb
+	// Accessories - товары, которые берут вместе с этим; Related - похожие модели и замены
+	Accessories []Product
+	Related []Product
+	// Reviews - одобренные отзывы, новые сначала
+	Reviews []Review
+	ReviewForm ReviewForm
 }
 
 type DeliveryParams struct {
@@ -56,6 +100,9 @@ type TemplateFunctions struct {
 
 type Templates struct {
 	index *template.Template
+	search *template.Template
+	category *template.Template
+	product *template.Template
 	delivery *template.Template
 	payment *template.Template
 	guarantee *template.Template
@@ -69,6 +116,13 @@ func NewTemplates(tf TemplateFunctions) (*Templates, error) {
 	t := &Templates{
 		funcs: template.FuncMap{
 			"staticWithHash": tf.StaticWithHash,
+			"stockStatusLabel": StockStatusLabel,
+			"money": common.FormatRubles,
+			"vatNote": VATNote,
+			"ratingStars": RatingStars,
+			"rating": FormatRating,
+			"reviewsCount": ReviewsCount,
+			"reviewDate": ReviewDate,
 		},
 	}
 
@@ -86,6 +140,9 @@ func (t *Templates) parseTemplates() error {
 		"templates/components/_layout/footer.html",
 		"templates/components/_layout/cookies.html",
 		"templates/components/_layout/meta.html",
+		"templates/components/_layout/product-order.html",
+		"templates/components/_layout/product-gallery.html",
+		"templates/components/_layout/breadcrumbs.html",
 	}
 
 	indexTemplates := []string{
@@ -105,6 +162,43 @@ func (t *Templates) parseTemplates() error {
 			ParseFS(files, append(baseTemplates, indexTemplates...)...),
 	)
 
+	searchTemplates := []string{
+		"templates/pages/search.html",
+		"templates/components/search/search.html",
+	}
+
+	t.search = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, append(baseTemplates, searchTemplates...)...),
+	)
+
+	categoryTemplates := []string{
+		"templates/pages/category.html",
+		"templates/components/category/category.html",
+		"templates/components/index/order-form.html",
+	}
+
+	t.category = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, append(baseTemplates, categoryTemplates...)...),
+	)
+
+	productTemplates := []string{
+		"templates/pages/product.html",
+		"templates/components/product/product.html",
+		"templates/components/product/recommendations.html",
+		"templates/components/product/reviews.html",
+		"templates/components/index/order-form.html",
+	}
+
+	t.product = template.Must(
+		template.New("base.html").
+			Funcs(t.funcs).
+			ParseFS(files, append(baseTemplates, productTemplates...)...),
+	)
+
 	deliveryTemplates := []string{
 		"templates/pages/delivery.html",
 		"templates/components/delivery/delivery.html",
@@ -180,6 +274,24 @@ func (t *Templates) RenderIndex(w io.Writer, p IndexParams) error {
 	return t.index.Execute(w, p)
 }
 
+func (t *Templates) RenderSearch(w io.Writer, p SearchParams) error {
+	p.View = "search"
+
+	return t.search.Execute(w, p)
+}
+
+func (t *Templates) RenderCategory(w io.Writer, p CategoryParams) error {
+	p.View = "category"
+
+	return t.category.Execute(w, p)
+}
+
+func (t *Templates) RenderProduct(w io.Writer, p ProductParams) error {
+	p.View = "product"
+
+	return t.product.Execute(w, p)
+}
+
 func (t *Templates) RenderDelivery(w io.Writer, p DeliveryParams) error {
 	p.View = "delivery"
 
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS product_revisions;
+
+ALTER TABLE products
+DROP COLUMN created_at,
+DROP COLUMN updated_at;
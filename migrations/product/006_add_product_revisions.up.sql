@@ -0,0 +1,15 @@
+ALTER TABLE products
+ADD COLUMN created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+CREATE TABLE product_revisions (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL,
+    user_id BIGINT,
+    action VARCHAR(32) NOT NULL,
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_product_revisions_product_id ON product_revisions (product_id, created_at DESC);
@@ -0,0 +1,38 @@
+CREATE TABLE categories (
+    id SERIAL PRIMARY KEY,
+    parent_id INTEGER REFERENCES categories(id) ON DELETE RESTRICT,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) UNIQUE NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    seo_title VARCHAR(255) NOT NULL DEFAULT '',
+    seo_description TEXT NOT NULL DEFAULT '',
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_categories_parent_id ON categories (parent_id);
+
+CREATE TABLE product_categories (
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    category_id INTEGER NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (product_id, category_id)
+);
+
+CREATE INDEX idx_product_categories_category_id ON product_categories (category_id);
+
+INSERT INTO categories (name, slug, description, seo_title, seo_description, position)
+VALUES
+    ('Задники', 'zadniki', 'Задники из кожкартона саламандер для обуви',
+     'Задники из кожкартона саламандер для обуви оптом от производителя',
+     'Задники из кожкартона саламандер для пошива и ремонта обуви. Оптовые цены от производителя, доставка по всей России', 1),
+    ('Стельки', 'stelki', 'Стельки и полустельки для обуви',
+     'Стельки и полустельки для обуви оптом от производителя',
+     'Стельки и полустельки для пошива обуви. Оптовые цены от производителя, доставка по всей России', 2)
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO product_categories (product_id, category_id)
+SELECT p.id, c.id
+FROM products p
+JOIN categories c ON c.slug = CASE WHEN p.name ILIKE '%стельк%' THEN 'stelki' ELSE 'zadniki' END
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,16 @@
+CREATE TABLE product_variants (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    sku VARCHAR(64) NOT NULL,
+    options JSONB NOT NULL DEFAULT '{}',
+    price DECIMAL(10,2) CHECK (price > 0),
+    weight_grams INTEGER CHECK (weight_grams >= 0),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- проверка откладывается до коммита, чтобы варианты могли обменяться артикулами за одно сохранение
+    CONSTRAINT product_variants_sku_key UNIQUE (sku) DEFERRABLE INITIALLY DEFERRED
+);
+
+CREATE INDEX idx_product_variants_product_id ON product_variants (product_id, position);
@@ -0,0 +1,19 @@
+CREATE TABLE product_images (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    url VARCHAR(255) NOT NULL,
+    alt VARCHAR(255) NOT NULL DEFAULT '',
+    position INTEGER NOT NULL DEFAULT 0,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_product_images_product_id ON product_images (product_id, position);
+-- у товара не больше одного основного изображения
+CREATE UNIQUE INDEX idx_product_images_primary ON product_images (product_id) WHERE is_primary;
+
+-- products.image_url остаётся денормализованной ссылкой на основное изображение
+INSERT INTO product_images (product_id, url, alt, position, is_primary)
+SELECT id, image_url, name, 0, TRUE
+FROM products
+WHERE image_url IS NOT NULL AND image_url <> '';
@@ -0,0 +1,29 @@
+-- остаток по товару (variant_id IS NULL) или по его варианту
+CREATE TABLE stock_items (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    variant_id INTEGER REFERENCES product_variants(id) ON DELETE CASCADE,
+    on_hand INTEGER NOT NULL DEFAULT 0 CHECK (on_hand >= 0),
+    reserved INTEGER NOT NULL DEFAULT 0 CHECK (reserved >= 0),
+    low_stock_threshold INTEGER NOT NULL DEFAULT 0 CHECK (low_stock_threshold >= 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT stock_items_reserved_check CHECK (reserved <= on_hand)
+);
+
+CREATE UNIQUE INDEX idx_stock_items_product_variant ON stock_items (product_id, (COALESCE(variant_id, 0)));
+
+-- журнал движений: каждая операция меняет остаток только через запись сюда
+CREATE TABLE stock_movements (
+    id SERIAL PRIMARY KEY,
+    stock_item_id INTEGER NOT NULL REFERENCES stock_items(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('receipt', 'reservation', 'release', 'shipment', 'adjustment')),
+    quantity INTEGER NOT NULL CHECK (quantity <> 0),
+    on_hand_after INTEGER NOT NULL,
+    reserved_after INTEGER NOT NULL,
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    comment TEXT NOT NULL DEFAULT '',
+    user_id BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_stock_movements_item ON stock_movements (stock_item_id, created_at DESC);
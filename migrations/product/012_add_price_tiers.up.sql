@@ -0,0 +1,8 @@
+CREATE TABLE product_price_tiers (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    min_quantity INTEGER NOT NULL CHECK (min_quantity >= 1),
+    unit_price DECIMAL(10,2) NOT NULL CHECK (unit_price > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT product_price_tiers_quantity_key UNIQUE (product_id, min_quantity)
+);
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS products_price_history_trigger ON products;
+DROP FUNCTION IF EXISTS products_price_history_insert();
+
+DROP TABLE IF EXISTS product_price_history;
+DROP TABLE IF EXISTS price_changes;
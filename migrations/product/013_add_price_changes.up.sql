@@ -0,0 +1,41 @@
+CREATE TABLE price_changes (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    price DECIMAL(10,2) NOT NULL CHECK (price > 0),
+    effective_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'scheduled' CHECK (status IN ('scheduled', 'applied', 'cancelled')),
+    comment TEXT NOT NULL DEFAULT '',
+    user_id BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    applied_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_price_changes_product_id ON price_changes (product_id, effective_at DESC);
+CREATE INDEX idx_price_changes_due ON price_changes (effective_at) WHERE status = 'scheduled';
+
+-- product_price_history пополняется триггером при любом изменении цены: из админки, по расписанию или при откате
+CREATE TABLE product_price_history (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    price DECIMAL(10,2) NOT NULL,
+    effective_from TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_product_price_history_product_id ON product_price_history (product_id, effective_from DESC);
+
+CREATE OR REPLACE FUNCTION products_price_history_insert() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.price = NEW.price THEN
+        RETURN NEW;
+    END IF;
+    INSERT INTO product_price_history (product_id, price) VALUES (NEW.id, NEW.price);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER products_price_history_trigger
+AFTER INSERT OR UPDATE OF price ON products
+FOR EACH ROW EXECUTE FUNCTION products_price_history_insert();
+
+INSERT INTO product_price_history (product_id, price, effective_from)
+SELECT id, price, created_at FROM products;
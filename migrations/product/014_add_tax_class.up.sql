@@ -0,0 +1,3 @@
+ALTER TABLE products
+ADD COLUMN tax_class VARCHAR(16) NOT NULL DEFAULT 'standard'
+    CHECK (tax_class IN ('standard', 'reduced', 'exempt'));
@@ -0,0 +1,7 @@
+CREATE TABLE product_slug_history (
+    slug VARCHAR(255) PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_product_slug_history_product_id ON product_slug_history (product_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS product_attribute_values;
+DROP TABLE IF EXISTS attribute_categories;
+DROP TABLE IF EXISTS attributes;
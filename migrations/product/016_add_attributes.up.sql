@@ -0,0 +1,38 @@
+CREATE TABLE attributes (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(64) UNIQUE NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(16) NOT NULL CHECK (type IN ('number', 'enum', 'text')),
+    unit VARCHAR(32) NOT NULL DEFAULT '',
+    options TEXT[] NOT NULL DEFAULT '{}',
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- характеристика без разделов относится ко всем товарам
+CREATE TABLE attribute_categories (
+    attribute_id INTEGER NOT NULL REFERENCES attributes(id) ON DELETE CASCADE,
+    category_id INTEGER NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (attribute_id, category_id)
+);
+
+CREATE TABLE product_attribute_values (
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    attribute_id INTEGER NOT NULL REFERENCES attributes(id) ON DELETE CASCADE,
+    value_number NUMERIC(12,3),
+    value_text TEXT,
+    PRIMARY KEY (product_id, attribute_id),
+    CHECK (value_number IS NOT NULL OR value_text IS NOT NULL)
+);
+
+CREATE INDEX idx_product_attribute_values_number ON product_attribute_values (attribute_id, value_number);
+CREATE INDEX idx_product_attribute_values_text ON product_attribute_values (attribute_id, value_text);
+
+INSERT INTO attributes (code, name, type, unit, options, position)
+VALUES
+    ('material', 'Материал', 'enum', '', '{"кожкартон саламандер", "термопластик", "кожа"}', 1),
+    ('thickness', 'Толщина', 'number', 'мм', '{}', 2),
+    ('height', 'Высота', 'number', 'мм', '{}', 3),
+    ('shoe_type', 'Вид обуви', 'enum', '', '{"мужская", "женская", "детская"}', 4)
+ON CONFLICT (code) DO NOTHING;
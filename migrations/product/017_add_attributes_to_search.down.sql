@@ -0,0 +1,24 @@
+DROP TRIGGER IF EXISTS product_attribute_values_search_trigger ON product_attribute_values;
+DROP FUNCTION IF EXISTS product_attribute_values_search_update();
+DROP FUNCTION IF EXISTS product_attributes_text(INTEGER);
+
+CREATE OR REPLACE FUNCTION products_search_vector_update() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('russian', coalesce(NEW.name, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(NEW.description, '')), 'B');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS products_search_vector_trigger ON products;
+CREATE TRIGGER products_search_vector_trigger
+BEFORE INSERT OR UPDATE OF name, description ON products
+FOR EACH ROW EXECUTE FUNCTION products_search_vector_update();
+
+ALTER TABLE products
+DROP COLUMN attributes_text;
+
+UPDATE products SET search_vector =
+    setweight(to_tsvector('russian', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(description, '')), 'B');
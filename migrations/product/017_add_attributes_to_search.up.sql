@@ -0,0 +1,44 @@
+-- attributes_text - значения характеристик товара одной строкой; его ведёт триггер на product_attribute_values,
+-- а поисковый вектор товара строится из названия, описания и этой строки
+ALTER TABLE products
+ADD COLUMN attributes_text TEXT NOT NULL DEFAULT '';
+
+CREATE OR REPLACE FUNCTION products_search_vector_update() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('russian', coalesce(NEW.name, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(NEW.description, '')), 'B') ||
+        setweight(to_tsvector('russian', NEW.attributes_text), 'C');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS products_search_vector_trigger ON products;
+CREATE TRIGGER products_search_vector_trigger
+BEFORE INSERT OR UPDATE OF name, description, attributes_text ON products
+FOR EACH ROW EXECUTE FUNCTION products_search_vector_update();
+
+-- числа хранятся как NUMERIC(12,3), в поиск они попадают без хвостовых нулей: 1.500 -> 1.5
+CREATE OR REPLACE FUNCTION product_attributes_text(target_product_id INTEGER) RETURNS TEXT AS $$
+    SELECT coalesce(string_agg(coalesce(value_text, rtrim(rtrim(value_number::text, '0'), '.')), ' ' ORDER BY attribute_id), '')
+    FROM product_attribute_values
+    WHERE product_id = target_product_id;
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION product_attribute_values_search_update() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE products SET attributes_text = product_attributes_text(OLD.product_id) WHERE id = OLD.product_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        UPDATE products SET attributes_text = product_attributes_text(NEW.product_id) WHERE id = NEW.product_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER product_attribute_values_search_trigger
+AFTER INSERT OR UPDATE OR DELETE ON product_attribute_values
+FOR EACH ROW EXECUTE FUNCTION product_attribute_values_search_update();
+
+UPDATE products SET attributes_text = product_attributes_text(id);
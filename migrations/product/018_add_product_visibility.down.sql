@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_products_unpublish_due;
+DROP INDEX IF EXISTS idx_products_publish_due;
+
+ALTER TABLE products
+DROP CONSTRAINT IF EXISTS products_unpublish_at_check,
+DROP CONSTRAINT IF EXISTS products_publish_at_check,
+DROP COLUMN unpublish_at,
+DROP COLUMN publish_at,
+DROP COLUMN visibility;
@@ -0,0 +1,11 @@
+-- visibility - редакционное состояние витрины, не связанное со статусом саги; существующие товары уже опубликованы
+ALTER TABLE products
+ADD COLUMN visibility VARCHAR(16) NOT NULL DEFAULT 'published'
+    CHECK (visibility IN ('draft', 'scheduled', 'published', 'hidden')),
+ADD COLUMN publish_at TIMESTAMP WITH TIME ZONE,
+ADD COLUMN unpublish_at TIMESTAMP WITH TIME ZONE,
+ADD CONSTRAINT products_publish_at_check CHECK (visibility <> 'scheduled' OR publish_at IS NOT NULL),
+ADD CONSTRAINT products_unpublish_at_check CHECK (unpublish_at IS NULL OR publish_at IS NULL OR unpublish_at > publish_at);
+
+CREATE INDEX idx_products_publish_due ON products (publish_at) WHERE visibility = 'scheduled';
+CREATE INDEX idx_products_unpublish_due ON products (unpublish_at) WHERE visibility IN ('scheduled', 'published') AND unpublish_at IS NOT NULL;
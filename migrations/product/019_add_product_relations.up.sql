@@ -0,0 +1,12 @@
+-- связи направленные: стельки в аксессуарах задника не делают задник аксессуаром стелек
+CREATE TABLE product_relations (
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    related_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('related', 'accessory', 'alternative')),
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (product_id, related_id),
+    CHECK (product_id <> related_id)
+);
+
+CREATE INDEX idx_product_relations_related_id ON product_relations (related_id);
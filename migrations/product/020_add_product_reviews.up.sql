@@ -0,0 +1,19 @@
+-- author_key - хеш адреса отправителя от витрины: по нему отсекаются повторные отзывы, сам адрес не хранится
+CREATE TABLE product_reviews (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    author_name VARCHAR(100) NOT NULL,
+    company VARCHAR(200) NOT NULL DEFAULT '',
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    text TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    reply TEXT NOT NULL DEFAULT '',
+    replied_at TIMESTAMP WITH TIME ZONE,
+    moderated_by BIGINT,
+    moderated_at TIMESTAMP WITH TIME ZONE,
+    author_key VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_product_reviews_product_status ON product_reviews (product_id, status, created_at DESC);
+CREATE INDEX idx_product_reviews_status_created_at ON product_reviews (status, created_at);
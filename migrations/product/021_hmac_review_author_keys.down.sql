@@ -0,0 +1,2 @@
+-- стёртые ключи не восстановить
+COMMENT ON COLUMN product_reviews.author_key IS NULL;
@@ -0,0 +1,5 @@
+-- author_key теперь HMAC адреса отправителя на секрете витрины. Прежние ключи - простой хеш адреса,
+-- его легко перебрать обратно до адреса, а с новыми ключами он всё равно не совпадёт: стираем
+COMMENT ON COLUMN product_reviews.author_key IS 'HMAC-SHA256 адреса отправителя на секрете витрины';
+
+UPDATE product_reviews SET author_key = '' WHERE author_key <> '';
@@ -0,0 +1,16 @@
+-- резерв под заявку с сайта: держится до подтверждения или отмены менеджером, иначе снимается по expires_at
+CREATE TABLE stock_reservations (
+    id SERIAL PRIMARY KEY,
+    stock_item_id INTEGER NOT NULL REFERENCES stock_items(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(16) NOT NULL DEFAULT 'active'
+        CHECK (status IN ('active', 'confirmed', 'released', 'expired')),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    closed_at TIMESTAMP WITH TIME ZONE,
+    user_id BIGINT
+);
+
+CREATE INDEX idx_stock_reservations_item ON stock_reservations (stock_item_id) WHERE status = 'active';
+CREATE INDEX idx_stock_reservations_expires ON stock_reservations (expires_at) WHERE status = 'active';
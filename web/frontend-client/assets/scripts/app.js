@@ -4,3 +4,5 @@ import "./components/delivery-map.js";
 import "./components/animateScrollToAnchor.js";
 import "./components/order-form.js";
 import "./components/faq.js";
+import "./components/product-order.js";
+import "./components/product-gallery.js";
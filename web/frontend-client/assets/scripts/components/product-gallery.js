@@ -0,0 +1,19 @@
+const galleries = document.querySelectorAll('[data-element="product-gallery"]')
+
+if (galleries.length) setTimeout(productGalleryInit, 0)
+
+function productGalleryInit () {
+  galleries.forEach((gallery) => {
+    const image = gallery.querySelector('[data-role="product-gallery__image"]')
+    const thumbs = gallery.querySelectorAll('[data-role="product-gallery__thumb"]')
+
+    thumbs.forEach((thumb) => {
+      thumb.addEventListener('click', () => {
+        image.src = thumb.dataset.src
+        image.alt = thumb.dataset.alt
+        thumbs.forEach((item) => item.classList.remove('product-gallery__thumb_active'))
+        thumb.classList.add('product-gallery__thumb_active')
+      })
+    })
+  })
+}
@@ -0,0 +1,61 @@
+const productOrders = document.querySelectorAll('[data-role="product-order"]')
+
+if (productOrders.length) setTimeout(productOrderInit, 0)
+
+function productOrderInit () {
+  const itemInput = document.querySelector('[data-role="feedback-form__item"]')
+  const itemText = document.querySelector('[data-role="feedback-form__item-text"]')
+  const productInput = document.querySelector('[data-role="feedback-form__product"]')
+  const variantInput = document.querySelector('[data-role="feedback-form__variant"]')
+  const quantity = document.querySelector('[data-role="feedback-form__quantity"]')
+
+  productOrders.forEach((card) => {
+    const select = card.querySelector('[data-role="product-order__select"]')
+    const price = card.querySelector('[data-role="product-order__price"]')
+    const vat = card.querySelector('[data-role="product-order__vat"]')
+    const stock = card.querySelector('[data-role="product-order__stock"]')
+    const tiers = card.querySelector('[data-role="product-order__tiers"]')
+    const button = card.querySelector('[data-role="product-order__button"]')
+
+    if (select) {
+      select.addEventListener('change', () => {
+        const option = select.selectedOptions[0]
+        if (price) price.textContent = option.dataset.price
+        if (vat) vat.textContent = option.dataset.vat
+        if (stock) {
+          stock.textContent = option.dataset.stockLabel
+          stock.className = stock.className.replace(/product-order__stock_\S+/, 'product-order__stock_' + option.dataset.stock)
+        }
+        // у варианта со своей ценой оптовые ступени не действуют
+        if (tiers) tiers.classList.toggle('product-order__tiers_hide', 'fixedPrice' in option.dataset)
+      })
+      select.dispatchEvent(new Event('change'))
+    }
+
+    if (button && itemInput) {
+      const choose = () => {
+        let item = button.dataset.name
+        let variantID = ''
+        if (select) {
+          const option = select.selectedOptions[0]
+          item += ', ' + option.textContent.trim() + ', артикул ' + option.value
+          variantID = option.dataset.variantId
+        }
+        itemInput.value = item
+        if (productInput) productInput.value = button.dataset.productId
+        if (variantInput) variantInput.value = variantID
+        if (quantity) quantity.classList.remove('order-form__item_hide')
+        if (itemText) {
+          itemText.textContent = 'Вы выбрали: ' + item
+          itemText.classList.remove('order-form__item_hide')
+        }
+      }
+      button.addEventListener('click', choose)
+      if (select) select.addEventListener('change', () => {
+        if (productInput && productInput.value === button.dataset.productId) choose()
+      })
+      // на странице товара заявка сразу заполнена этим товаром
+      if ('preselect' in card.dataset) choose()
+    }
+  })
+}